@@ -31,7 +31,7 @@ func main() {
 	)
 
 	ctx := context.Background()
-	container, err := di.New(ctx, cfg.DatabaseURL, cfg.GRPCPort, cfg.JWTSecret, cfg.JWTDuration, logger)
+	container, err := di.New(ctx, cfg.DatabaseURL, cfg.GRPCPort, cfg.JWTSecret, cfg.JWTDuration, cfg.OperatorToken, cfg.RecurrenceInterval, cfg.DueReminderInterval, cfg.DueReminderWindow, cfg.TrashPurgeInterval, cfg.TrashRetention, cfg.AutoArchiveInterval, logger)
 	if err != nil {
 		logger.Error("failed to initialize dependencies", "error", err)
 		os.Exit(1)
@@ -51,9 +51,13 @@ func main() {
 		}
 	}()
 
+	container.Worker.Start(ctx)
+
 	sig := <-sigCh
 	logger.Info("received shutdown signal", "signal", sig.String())
 
+	container.Worker.Stop()
+
 	if err := container.Server.GracefulShutdown(cfg.ShutdownTimeout); err != nil {
 		logger.Error("shutdown error", "error", err)
 		os.Exit(1)
@@ -29,6 +29,7 @@ const (
 	Visibility_VISIBILITY_UNSPECIFIED  Visibility = 0
 	Visibility_VISIBILITY_ONLY_ME      Visibility = 1
 	Visibility_VISIBILITY_COMPANY_WIDE Visibility = 2
+	Visibility_VISIBILITY_TEAM         Visibility = 3 // Members of the task's team; requires team_id
 )
 
 // Enum value maps for Visibility.
@@ -37,11 +38,13 @@ var (
 		0: "VISIBILITY_UNSPECIFIED",
 		1: "VISIBILITY_ONLY_ME",
 		2: "VISIBILITY_COMPANY_WIDE",
+		3: "VISIBILITY_TEAM",
 	}
 	Visibility_value = map[string]int32{
 		"VISIBILITY_UNSPECIFIED":  0,
 		"VISIBILITY_ONLY_ME":      1,
 		"VISIBILITY_COMPANY_WIDE": 2,
+		"VISIBILITY_TEAM":         3,
 	}
 )
 
@@ -72,11 +75,12 @@ func (Visibility) EnumDescriptor() ([]byte, []int) {
 	return file_todo_v1_service_proto_rawDescGZIP(), []int{0}
 }
 
-// TaskStatus represents the current state of a task
+// TaskStatus names the statuses of the default workflow. Statuses a company
+// adds are only available by key; see Task.status_key.
 type TaskStatus int32
 
 const (
-	TaskStatus_TASK_STATUS_UNSPECIFIED TaskStatus = 0
+	TaskStatus_TASK_STATUS_UNSPECIFIED TaskStatus = 0 // Also used for statuses outside the default workflow
 	TaskStatus_TASK_STATUS_TODO        TaskStatus = 1
 	TaskStatus_TASK_STATUS_IN_PROGRESS TaskStatus = 2
 	TaskStatus_TASK_STATUS_DONE        TaskStatus = 3
@@ -125,23 +129,534 @@ func (TaskStatus) EnumDescriptor() ([]byte, []int) {
 	return file_todo_v1_service_proto_rawDescGZIP(), []int{1}
 }
 
+// StatusCategory groups workflow statuses by how far along a task is
+type StatusCategory int32
+
+const (
+	StatusCategory_STATUS_CATEGORY_UNSPECIFIED StatusCategory = 0
+	StatusCategory_STATUS_CATEGORY_TODO        StatusCategory = 1
+	StatusCategory_STATUS_CATEGORY_ACTIVE      StatusCategory = 2
+	StatusCategory_STATUS_CATEGORY_DONE        StatusCategory = 3 // Finished: unblocks dependents and advances recurring tasks
+)
+
+// Enum value maps for StatusCategory.
+var (
+	StatusCategory_name = map[int32]string{
+		0: "STATUS_CATEGORY_UNSPECIFIED",
+		1: "STATUS_CATEGORY_TODO",
+		2: "STATUS_CATEGORY_ACTIVE",
+		3: "STATUS_CATEGORY_DONE",
+	}
+	StatusCategory_value = map[string]int32{
+		"STATUS_CATEGORY_UNSPECIFIED": 0,
+		"STATUS_CATEGORY_TODO":        1,
+		"STATUS_CATEGORY_ACTIVE":      2,
+		"STATUS_CATEGORY_DONE":        3,
+	}
+)
+
+func (x StatusCategory) Enum() *StatusCategory {
+	p := new(StatusCategory)
+	*p = x
+	return p
+}
+
+func (x StatusCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatusCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_todo_v1_service_proto_enumTypes[2].Descriptor()
+}
+
+func (StatusCategory) Type() protoreflect.EnumType {
+	return &file_todo_v1_service_proto_enumTypes[2]
+}
+
+func (x StatusCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatusCategory.Descriptor instead.
+func (StatusCategory) EnumDescriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+// TaskPriority ranks how urgent a task is
+type TaskPriority int32
+
+const (
+	TaskPriority_TASK_PRIORITY_UNSPECIFIED TaskPriority = 0 // Treated as none
+	TaskPriority_TASK_PRIORITY_NONE        TaskPriority = 1
+	TaskPriority_TASK_PRIORITY_LOW         TaskPriority = 2
+	TaskPriority_TASK_PRIORITY_MEDIUM      TaskPriority = 3
+	TaskPriority_TASK_PRIORITY_HIGH        TaskPriority = 4
+	TaskPriority_TASK_PRIORITY_URGENT      TaskPriority = 5
+)
+
+// Enum value maps for TaskPriority.
+var (
+	TaskPriority_name = map[int32]string{
+		0: "TASK_PRIORITY_UNSPECIFIED",
+		1: "TASK_PRIORITY_NONE",
+		2: "TASK_PRIORITY_LOW",
+		3: "TASK_PRIORITY_MEDIUM",
+		4: "TASK_PRIORITY_HIGH",
+		5: "TASK_PRIORITY_URGENT",
+	}
+	TaskPriority_value = map[string]int32{
+		"TASK_PRIORITY_UNSPECIFIED": 0,
+		"TASK_PRIORITY_NONE":        1,
+		"TASK_PRIORITY_LOW":         2,
+		"TASK_PRIORITY_MEDIUM":      3,
+		"TASK_PRIORITY_HIGH":        4,
+		"TASK_PRIORITY_URGENT":      5,
+	}
+)
+
+func (x TaskPriority) Enum() *TaskPriority {
+	p := new(TaskPriority)
+	*p = x
+	return p
+}
+
+func (x TaskPriority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskPriority) Descriptor() protoreflect.EnumDescriptor {
+	return file_todo_v1_service_proto_enumTypes[3].Descriptor()
+}
+
+func (TaskPriority) Type() protoreflect.EnumType {
+	return &file_todo_v1_service_proto_enumTypes[3]
+}
+
+func (x TaskPriority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskPriority.Descriptor instead.
+func (TaskPriority) EnumDescriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+// TaskSortField selects the key a task listing is ordered by
+type TaskSortField int32
+
+const (
+	TaskSortField_TASK_SORT_FIELD_UNSPECIFIED TaskSortField = 0 // Defaults to created_at
+	TaskSortField_TASK_SORT_FIELD_CREATED_AT  TaskSortField = 1
+	TaskSortField_TASK_SORT_FIELD_DUE_DATE    TaskSortField = 2 // Tasks without a due date sort last
+	TaskSortField_TASK_SORT_FIELD_UPDATED_AT  TaskSortField = 3
+	TaskSortField_TASK_SORT_FIELD_TITLE       TaskSortField = 4
+	TaskSortField_TASK_SORT_FIELD_STATUS      TaskSortField = 5 // Order of the statuses in the company workflow
+	TaskSortField_TASK_SORT_FIELD_PRIORITY    TaskSortField = 6 // Severity order: none to urgent; urgent first by default
+)
+
+// Enum value maps for TaskSortField.
+var (
+	TaskSortField_name = map[int32]string{
+		0: "TASK_SORT_FIELD_UNSPECIFIED",
+		1: "TASK_SORT_FIELD_CREATED_AT",
+		2: "TASK_SORT_FIELD_DUE_DATE",
+		3: "TASK_SORT_FIELD_UPDATED_AT",
+		4: "TASK_SORT_FIELD_TITLE",
+		5: "TASK_SORT_FIELD_STATUS",
+		6: "TASK_SORT_FIELD_PRIORITY",
+	}
+	TaskSortField_value = map[string]int32{
+		"TASK_SORT_FIELD_UNSPECIFIED": 0,
+		"TASK_SORT_FIELD_CREATED_AT":  1,
+		"TASK_SORT_FIELD_DUE_DATE":    2,
+		"TASK_SORT_FIELD_UPDATED_AT":  3,
+		"TASK_SORT_FIELD_TITLE":       4,
+		"TASK_SORT_FIELD_STATUS":      5,
+		"TASK_SORT_FIELD_PRIORITY":    6,
+	}
+)
+
+func (x TaskSortField) Enum() *TaskSortField {
+	p := new(TaskSortField)
+	*p = x
+	return p
+}
+
+func (x TaskSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_todo_v1_service_proto_enumTypes[4].Descriptor()
+}
+
+func (TaskSortField) Type() protoreflect.EnumType {
+	return &file_todo_v1_service_proto_enumTypes[4]
+}
+
+func (x TaskSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskSortField.Descriptor instead.
+func (TaskSortField) EnumDescriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+// SortDirection orders a listing ascending or descending
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_UNSPECIFIED SortDirection = 0 // Descending for timestamps, ascending otherwise
+	SortDirection_SORT_DIRECTION_ASC         SortDirection = 1
+	SortDirection_SORT_DIRECTION_DESC        SortDirection = 2
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_UNSPECIFIED",
+		1: "SORT_DIRECTION_ASC",
+		2: "SORT_DIRECTION_DESC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_UNSPECIFIED": 0,
+		"SORT_DIRECTION_ASC":         1,
+		"SORT_DIRECTION_DESC":        2,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_todo_v1_service_proto_enumTypes[5].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_todo_v1_service_proto_enumTypes[5]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+// TaskHistoryAction says what kind of change a history entry records
+type TaskHistoryAction int32
+
+const (
+	TaskHistoryAction_TASK_HISTORY_ACTION_UNSPECIFIED TaskHistoryAction = 0
+	TaskHistoryAction_TASK_HISTORY_ACTION_CREATED     TaskHistoryAction = 1
+	TaskHistoryAction_TASK_HISTORY_ACTION_UPDATED     TaskHistoryAction = 2
+	TaskHistoryAction_TASK_HISTORY_ACTION_DELETED     TaskHistoryAction = 3 // Moved to the trash
+	TaskHistoryAction_TASK_HISTORY_ACTION_RESTORED    TaskHistoryAction = 4
+	TaskHistoryAction_TASK_HISTORY_ACTION_PURGED      TaskHistoryAction = 5
+)
+
+// Enum value maps for TaskHistoryAction.
+var (
+	TaskHistoryAction_name = map[int32]string{
+		0: "TASK_HISTORY_ACTION_UNSPECIFIED",
+		1: "TASK_HISTORY_ACTION_CREATED",
+		2: "TASK_HISTORY_ACTION_UPDATED",
+		3: "TASK_HISTORY_ACTION_DELETED",
+		4: "TASK_HISTORY_ACTION_RESTORED",
+		5: "TASK_HISTORY_ACTION_PURGED",
+	}
+	TaskHistoryAction_value = map[string]int32{
+		"TASK_HISTORY_ACTION_UNSPECIFIED": 0,
+		"TASK_HISTORY_ACTION_CREATED":     1,
+		"TASK_HISTORY_ACTION_UPDATED":     2,
+		"TASK_HISTORY_ACTION_DELETED":     3,
+		"TASK_HISTORY_ACTION_RESTORED":    4,
+		"TASK_HISTORY_ACTION_PURGED":      5,
+	}
+)
+
+func (x TaskHistoryAction) Enum() *TaskHistoryAction {
+	p := new(TaskHistoryAction)
+	*p = x
+	return p
+}
+
+func (x TaskHistoryAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskHistoryAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_todo_v1_service_proto_enumTypes[6].Descriptor()
+}
+
+func (TaskHistoryAction) Type() protoreflect.EnumType {
+	return &file_todo_v1_service_proto_enumTypes[6]
+}
+
+func (x TaskHistoryAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskHistoryAction.Descriptor instead.
+func (TaskHistoryAction) EnumDescriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+// ShareRole is what a share lets its user do with one task
+type ShareRole int32
+
+const (
+	ShareRole_SHARE_ROLE_UNSPECIFIED ShareRole = 0
+	ShareRole_SHARE_ROLE_VIEWER      ShareRole = 1
+	ShareRole_SHARE_ROLE_EDITOR      ShareRole = 2 // Can also update and delete the task, but not change who can see it
+)
+
+// Enum value maps for ShareRole.
+var (
+	ShareRole_name = map[int32]string{
+		0: "SHARE_ROLE_UNSPECIFIED",
+		1: "SHARE_ROLE_VIEWER",
+		2: "SHARE_ROLE_EDITOR",
+	}
+	ShareRole_value = map[string]int32{
+		"SHARE_ROLE_UNSPECIFIED": 0,
+		"SHARE_ROLE_VIEWER":      1,
+		"SHARE_ROLE_EDITOR":      2,
+	}
+)
+
+func (x ShareRole) Enum() *ShareRole {
+	p := new(ShareRole)
+	*p = x
+	return p
+}
+
+func (x ShareRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShareRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_todo_v1_service_proto_enumTypes[7].Descriptor()
+}
+
+func (ShareRole) Type() protoreflect.EnumType {
+	return &file_todo_v1_service_proto_enumTypes[7]
+}
+
+func (x ShareRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShareRole.Descriptor instead.
+func (ShareRole) EnumDescriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+// NotificationKind says why a notification was sent
+type NotificationKind int32
+
+const (
+	NotificationKind_NOTIFICATION_KIND_UNSPECIFIED    NotificationKind = 0
+	NotificationKind_NOTIFICATION_KIND_MENTION        NotificationKind = 1 // You were @mentioned in a description or comment
+	NotificationKind_NOTIFICATION_KIND_ASSIGNED       NotificationKind = 2 // A task was assigned to you
+	NotificationKind_NOTIFICATION_KIND_STATUS_CHANGED NotificationKind = 3 // A task you created changed status
+	NotificationKind_NOTIFICATION_KIND_DUE_SOON       NotificationKind = 4 // A task assigned to you, or unassigned and created by you, is due soon
+	NotificationKind_NOTIFICATION_KIND_TASK_UPDATED   NotificationKind = 5 // A task you watch was changed; see changed_fields
+)
+
+// Enum value maps for NotificationKind.
+var (
+	NotificationKind_name = map[int32]string{
+		0: "NOTIFICATION_KIND_UNSPECIFIED",
+		1: "NOTIFICATION_KIND_MENTION",
+		2: "NOTIFICATION_KIND_ASSIGNED",
+		3: "NOTIFICATION_KIND_STATUS_CHANGED",
+		4: "NOTIFICATION_KIND_DUE_SOON",
+		5: "NOTIFICATION_KIND_TASK_UPDATED",
+	}
+	NotificationKind_value = map[string]int32{
+		"NOTIFICATION_KIND_UNSPECIFIED":    0,
+		"NOTIFICATION_KIND_MENTION":        1,
+		"NOTIFICATION_KIND_ASSIGNED":       2,
+		"NOTIFICATION_KIND_STATUS_CHANGED": 3,
+		"NOTIFICATION_KIND_DUE_SOON":       4,
+		"NOTIFICATION_KIND_TASK_UPDATED":   5,
+	}
+)
+
+func (x NotificationKind) Enum() *NotificationKind {
+	p := new(NotificationKind)
+	*p = x
+	return p
+}
+
+func (x NotificationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_todo_v1_service_proto_enumTypes[8].Descriptor()
+}
+
+func (NotificationKind) Type() protoreflect.EnumType {
+	return &file_todo_v1_service_proto_enumTypes[8]
+}
+
+func (x NotificationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationKind.Descriptor instead.
+func (NotificationKind) EnumDescriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+// UserRole grants permissions; each role includes the ones before it
+type UserRole int32
+
+const (
+	UserRole_USER_ROLE_UNSPECIFIED UserRole = 0
+	UserRole_USER_ROLE_VIEWER      UserRole = 1
+	UserRole_USER_ROLE_EDITOR      UserRole = 2
+	UserRole_USER_ROLE_ADMIN       UserRole = 3 // Manages users, company settings and the workflow
+	UserRole_USER_ROLE_OWNER       UserRole = 4 // Can also make other users owners
+)
+
+// Enum value maps for UserRole.
+var (
+	UserRole_name = map[int32]string{
+		0: "USER_ROLE_UNSPECIFIED",
+		1: "USER_ROLE_VIEWER",
+		2: "USER_ROLE_EDITOR",
+		3: "USER_ROLE_ADMIN",
+		4: "USER_ROLE_OWNER",
+	}
+	UserRole_value = map[string]int32{
+		"USER_ROLE_UNSPECIFIED": 0,
+		"USER_ROLE_VIEWER":      1,
+		"USER_ROLE_EDITOR":      2,
+		"USER_ROLE_ADMIN":       3,
+		"USER_ROLE_OWNER":       4,
+	}
+)
+
+func (x UserRole) Enum() *UserRole {
+	p := new(UserRole)
+	*p = x
+	return p
+}
+
+func (x UserRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_todo_v1_service_proto_enumTypes[9].Descriptor()
+}
+
+func (UserRole) Type() protoreflect.EnumType {
+	return &file_todo_v1_service_proto_enumTypes[9]
+}
+
+func (x UserRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserRole.Descriptor instead.
+func (UserRole) EnumDescriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+// OnlyMeHandover decides what happens to an offboarded user's ONLY_ME tasks
+type OnlyMeHandover int32
+
+const (
+	OnlyMeHandover_ONLY_ME_HANDOVER_UNSPECIFIED  OnlyMeHandover = 0
+	OnlyMeHandover_ONLY_ME_HANDOVER_KEEP_PRIVATE OnlyMeHandover = 1 // Stay ONLY_ME, visible to the new creator
+	OnlyMeHandover_ONLY_ME_HANDOVER_SHARE        OnlyMeHandover = 2 // Become COMPANY_WIDE
+)
+
+// Enum value maps for OnlyMeHandover.
+var (
+	OnlyMeHandover_name = map[int32]string{
+		0: "ONLY_ME_HANDOVER_UNSPECIFIED",
+		1: "ONLY_ME_HANDOVER_KEEP_PRIVATE",
+		2: "ONLY_ME_HANDOVER_SHARE",
+	}
+	OnlyMeHandover_value = map[string]int32{
+		"ONLY_ME_HANDOVER_UNSPECIFIED":  0,
+		"ONLY_ME_HANDOVER_KEEP_PRIVATE": 1,
+		"ONLY_ME_HANDOVER_SHARE":        2,
+	}
+)
+
+func (x OnlyMeHandover) Enum() *OnlyMeHandover {
+	p := new(OnlyMeHandover)
+	*p = x
+	return p
+}
+
+func (x OnlyMeHandover) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OnlyMeHandover) Descriptor() protoreflect.EnumDescriptor {
+	return file_todo_v1_service_proto_enumTypes[10].Descriptor()
+}
+
+func (OnlyMeHandover) Type() protoreflect.EnumType {
+	return &file_todo_v1_service_proto_enumTypes[10]
+}
+
+func (x OnlyMeHandover) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OnlyMeHandover.Descriptor instead.
+func (OnlyMeHandover) EnumDescriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{10}
+}
+
 // Task represents a todo item
 type Task struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CompanyId     string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
-	CreatorId     string                 `protobuf:"bytes,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
-	AssigneeId    *string                `protobuf:"bytes,4,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"`
-	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
-	Visibility    Visibility             `protobuf:"varint,8,opt,name=visibility,proto3,enum=todo.v1.Visibility" json:"visibility,omitempty"`
-	Status        TaskStatus             `protobuf:"varint,9,opt,name=status,proto3,enum=todo.v1.TaskStatus" json:"status,omitempty"`
-	Version       int32                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId       string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CreatorId       string                 `protobuf:"bytes,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	AssigneeId      *string                `protobuf:"bytes,4,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"` // Primary assignee: the first of assignee_ids
+	Title           string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Description     *string                `protobuf:"bytes,6,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	DueDate         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	Visibility      Visibility             `protobuf:"varint,8,opt,name=visibility,proto3,enum=todo.v1.Visibility" json:"visibility,omitempty"`
+	Status          TaskStatus             `protobuf:"varint,9,opt,name=status,proto3,enum=todo.v1.TaskStatus" json:"status,omitempty"`
+	Version         int32                  `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	LabelIds        []string               `protobuf:"bytes,13,rep,name=label_ids,json=labelIds,proto3" json:"label_ids,omitempty"`
+	Priority        TaskPriority           `protobuf:"varint,14,opt,name=priority,proto3,enum=todo.v1.TaskPriority" json:"priority,omitempty"`
+	ParentId        *string                `protobuf:"bytes,15,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	SubtaskProgress *SubtaskProgress       `protobuf:"bytes,16,opt,name=subtask_progress,json=subtaskProgress,proto3" json:"subtask_progress,omitempty"`
+	SeriesId        *string                `protobuf:"bytes,17,opt,name=series_id,json=seriesId,proto3,oneof" json:"series_id,omitempty"`          // Set on instances of a recurring task
+	Occurrence      int32                  `protobuf:"varint,18,opt,name=occurrence,proto3" json:"occurrence,omitempty"`                           // 1-based position within the series
+	DeletedAt       *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=deleted_at,json=deletedAt,proto3,oneof" json:"deleted_at,omitempty"`       // Set on tasks in the trash
+	ArchivedAt      *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`    // Set on archived tasks
+	CompletedAt     *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"` // When the task last moved to a done status
+	StatusKey       string                 `protobuf:"bytes,22,opt,name=status_key,json=statusKey,proto3" json:"status_key,omitempty"`             // Key of the status in the company workflow
+	StatusCategory  StatusCategory         `protobuf:"varint,23,opt,name=status_category,json=statusCategory,proto3,enum=todo.v1.StatusCategory" json:"status_category,omitempty"`
+	AssigneeIds     []string               `protobuf:"bytes,24,rep,name=assignee_ids,json=assigneeIds,proto3" json:"assignee_ids,omitempty"` // Everyone the task is assigned to, primary first
+	TeamId          *string                `protobuf:"bytes,25,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`          // Set on team-visible tasks
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Task) Reset() {
@@ -151,14 +666,7119 @@ func (x *Task) Reset() {
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) String() string {
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Task) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Task) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *Task) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *Task) GetAssigneeId() string {
+	if x != nil && x.AssigneeId != nil {
+		return *x.AssigneeId
+	}
+	return ""
+}
+
+func (x *Task) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Task) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *Task) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Task) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *Task) GetStatus() TaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *Task) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetLabelIds() []string {
+	if x != nil {
+		return x.LabelIds
+	}
+	return nil
+}
+
+func (x *Task) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_UNSPECIFIED
+}
+
+func (x *Task) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *Task) GetSubtaskProgress() *SubtaskProgress {
+	if x != nil {
+		return x.SubtaskProgress
+	}
+	return nil
+}
+
+func (x *Task) GetSeriesId() string {
+	if x != nil && x.SeriesId != nil {
+		return *x.SeriesId
+	}
+	return ""
+}
+
+func (x *Task) GetOccurrence() int32 {
+	if x != nil {
+		return x.Occurrence
+	}
+	return 0
+}
+
+func (x *Task) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Task) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *Task) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Task) GetStatusKey() string {
+	if x != nil {
+		return x.StatusKey
+	}
+	return ""
+}
+
+func (x *Task) GetStatusCategory() StatusCategory {
+	if x != nil {
+		return x.StatusCategory
+	}
+	return StatusCategory_STATUS_CATEGORY_UNSPECIFIED
+}
+
+func (x *Task) GetAssigneeIds() []string {
+	if x != nil {
+		return x.AssigneeIds
+	}
+	return nil
+}
+
+func (x *Task) GetTeamId() string {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return ""
+}
+
+// SubtaskProgress counts a task's direct subtasks, including ones the caller
+// cannot see
+type SubtaskProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Done          int32                  `protobuf:"varint,1,opt,name=done,proto3" json:"done,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubtaskProgress) Reset() {
+	*x = SubtaskProgress{}
+	mi := &file_todo_v1_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubtaskProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubtaskProgress) ProtoMessage() {}
+
+func (x *SubtaskProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubtaskProgress.ProtoReflect.Descriptor instead.
+func (*SubtaskProgress) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubtaskProgress) GetDone() int32 {
+	if x != nil {
+		return x.Done
+	}
+	return 0
+}
+
+func (x *SubtaskProgress) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// CreateTaskRequest creates a new task
+type CreateTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"` // May @mention users by email or ID
+	AssigneeId    *string                `protobuf:"bytes,3,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	Visibility    Visibility             `protobuf:"varint,5,opt,name=visibility,proto3,enum=todo.v1.Visibility" json:"visibility,omitempty"`
+	LabelIds      []string               `protobuf:"bytes,6,rep,name=label_ids,json=labelIds,proto3" json:"label_ids,omitempty"` // Labels from the caller's company catalog
+	Priority      TaskPriority           `protobuf:"varint,7,opt,name=priority,proto3,enum=todo.v1.TaskPriority" json:"priority,omitempty"`
+	ParentId      *string                `protobuf:"bytes,8,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`     // Makes the new task a subtask of this one
+	Recurrence    *Recurrence            `protobuf:"bytes,9,opt,name=recurrence,proto3" json:"recurrence,omitempty"`                       // Requires due_date, which becomes the first occurrence and allows a single assignee
+	AssigneeIds   []string               `protobuf:"bytes,10,rep,name=assignee_ids,json=assigneeIds,proto3" json:"assignee_ids,omitempty"` // Further assignees after assignee_id
+	TeamId        *string                `protobuf:"bytes,11,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`          // Required with VISIBILITY_TEAM, rejected otherwise
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskRequest) Reset() {
+	*x = CreateTaskRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskRequest) ProtoMessage() {}
+
+func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateTaskRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetAssigneeId() string {
+	if x != nil && x.AssigneeId != nil {
+		return *x.AssigneeId
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *CreateTaskRequest) GetLabelIds() []string {
+	if x != nil {
+		return x.LabelIds
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_UNSPECIFIED
+}
+
+func (x *CreateTaskRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *CreateTaskRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetAssigneeIds() []string {
+	if x != nil {
+		return x.AssigneeIds
+	}
+	return nil
+}
+
+func (x *CreateTaskRequest) GetTeamId() string {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return ""
+}
+
+// Recurrence repeats a task on a schedule
+type Recurrence struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// RRULE subset: FREQ=DAILY|WEEKLY|MONTHLY with optional INTERVAL, BYDAY,
+	// COUNT or UNTIL, e.g. "FREQ=WEEKLY;BYDAY=MO,WE"
+	Rule          string `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	TimeZone      string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"` // IANA name; defaults to UTC
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recurrence) Reset() {
+	*x = Recurrence{}
+	mi := &file_todo_v1_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recurrence) ProtoMessage() {}
+
+func (x *Recurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recurrence.ProtoReflect.Descriptor instead.
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Recurrence) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *Recurrence) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+// CreateTaskResponse returns the created task
+type CreateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskResponse) Reset() {
+	*x = CreateTaskResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskResponse) ProtoMessage() {}
+
+func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
+func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// TaskFilter narrows a task listing. Unset fields are ignored; "after"
+// bounds are inclusive and "before" bounds are exclusive.
+type TaskFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []TaskStatus           `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=todo.v1.TaskStatus" json:"statuses,omitempty"`
+	AssigneeId    *string                `protobuf:"bytes,2,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"` // Tasks with this user among their assignees
+	Unassigned    bool                   `protobuf:"varint,3,opt,name=unassigned,proto3" json:"unassigned,omitempty"`                        // Only tasks without an assignee
+	CreatorId     *string                `protobuf:"bytes,4,opt,name=creator_id,json=creatorId,proto3,oneof" json:"creator_id,omitempty"`
+	Visibility    *Visibility            `protobuf:"varint,5,opt,name=visibility,proto3,enum=todo.v1.Visibility,oneof" json:"visibility,omitempty"`
+	DueAfter      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_after,json=dueAfter,proto3,oneof" json:"due_after,omitempty"`
+	DueBefore     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_before,json=dueBefore,proto3,oneof" json:"due_before,omitempty"`
+	OverdueOnly   bool                   `protobuf:"varint,8,opt,name=overdue_only,json=overdueOnly,proto3" json:"overdue_only,omitempty"` // Past due and not done
+	CreatedAfter  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_after,json=createdAfter,proto3,oneof" json:"created_after,omitempty"`
+	CreatedBefore *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_before,json=createdBefore,proto3,oneof" json:"created_before,omitempty"`
+	UpdatedAfter  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_after,json=updatedAfter,proto3,oneof" json:"updated_after,omitempty"`
+	UpdatedBefore *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_before,json=updatedBefore,proto3,oneof" json:"updated_before,omitempty"`
+	LabelIds      []string               `protobuf:"bytes,13,rep,name=label_ids,json=labelIds,proto3" json:"label_ids,omitempty"` // Tasks carrying all of these labels
+	Priorities    []TaskPriority         `protobuf:"varint,14,rep,packed,name=priorities,proto3,enum=todo.v1.TaskPriority" json:"priorities,omitempty"`
+	StatusKeys    []string               `protobuf:"bytes,15,rep,name=status_keys,json=statusKeys,proto3" json:"status_keys,omitempty"` // Workflow status keys, combined with statuses
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskFilter) Reset() {
+	*x = TaskFilter{}
+	mi := &file_todo_v1_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskFilter) ProtoMessage() {}
+
+func (x *TaskFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskFilter.ProtoReflect.Descriptor instead.
+func (*TaskFilter) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TaskFilter) GetStatuses() []TaskStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetAssigneeId() string {
+	if x != nil && x.AssigneeId != nil {
+		return *x.AssigneeId
+	}
+	return ""
+}
+
+func (x *TaskFilter) GetUnassigned() bool {
+	if x != nil {
+		return x.Unassigned
+	}
+	return false
+}
+
+func (x *TaskFilter) GetCreatorId() string {
+	if x != nil && x.CreatorId != nil {
+		return *x.CreatorId
+	}
+	return ""
+}
+
+func (x *TaskFilter) GetVisibility() Visibility {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *TaskFilter) GetDueAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAfter
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetDueBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueBefore
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetOverdueOnly() bool {
+	if x != nil {
+		return x.OverdueOnly
+	}
+	return false
+}
+
+func (x *TaskFilter) GetCreatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAfter
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetCreatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetUpdatedAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAfter
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetUpdatedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedBefore
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetLabelIds() []string {
+	if x != nil {
+		return x.LabelIds
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetPriorities() []TaskPriority {
+	if x != nil {
+		return x.Priorities
+	}
+	return nil
+}
+
+func (x *TaskFilter) GetStatusKeys() []string {
+	if x != nil {
+		return x.StatusKeys
+	}
+	return nil
+}
+
+// TaskSort orders a task listing. Ties are broken by task ID.
+type TaskSort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         TaskSortField          `protobuf:"varint,1,opt,name=field,proto3,enum=todo.v1.TaskSortField" json:"field,omitempty"`
+	Direction     SortDirection          `protobuf:"varint,2,opt,name=direction,proto3,enum=todo.v1.SortDirection" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskSort) Reset() {
+	*x = TaskSort{}
+	mi := &file_todo_v1_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskSort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskSort) ProtoMessage() {}
+
+func (x *TaskSort) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskSort.ProtoReflect.Descriptor instead.
+func (*TaskSort) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TaskSort) GetField() TaskSortField {
+	if x != nil {
+		return x.Field
+	}
+	return TaskSortField_TASK_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *TaskSort) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+// ListCompanyTasksRequest lists all tasks visible to the user in their company
+type ListCompanyTasksRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PageSize        int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken       string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Only valid with the filter and sort that produced it
+	Filter          *TaskFilter            `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort            *TaskSort              `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,5,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // Archived tasks are left out by default
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListCompanyTasksRequest) Reset() {
+	*x = ListCompanyTasksRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompanyTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompanyTasksRequest) ProtoMessage() {}
+
+func (x *ListCompanyTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompanyTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListCompanyTasksRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListCompanyTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCompanyTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCompanyTasksRequest) GetFilter() *TaskFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListCompanyTasksRequest) GetSort() *TaskSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListCompanyTasksRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+// ListCompanyTasksResponse returns paginated tasks
+type ListCompanyTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompanyTasksResponse) Reset() {
+	*x = ListCompanyTasksResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompanyTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompanyTasksResponse) ProtoMessage() {}
+
+func (x *ListCompanyTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompanyTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListCompanyTasksResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListCompanyTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListCompanyTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ListMyTasksRequest lists tasks assigned to the authenticated user
+type ListMyTasksRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PageSize        int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken       string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Only valid with the filter and sort that produced it
+	Filter          *TaskFilter            `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`                        // assignee_id and unassigned are not supported
+	Sort            *TaskSort              `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,5,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // Archived tasks are left out by default
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListMyTasksRequest) Reset() {
+	*x = ListMyTasksRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTasksRequest) ProtoMessage() {}
+
+func (x *ListMyTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListMyTasksRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListMyTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListMyTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListMyTasksRequest) GetFilter() *TaskFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListMyTasksRequest) GetSort() *TaskSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListMyTasksRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+// ListMyTasksResponse returns paginated tasks
+type ListMyTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyTasksResponse) Reset() {
+	*x = ListMyTasksResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyTasksResponse) ProtoMessage() {}
+
+func (x *ListMyTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListMyTasksResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListMyTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListMyTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// ListSubtasksRequest lists the direct subtasks of a task visible to the user
+type ListSubtasksRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TaskId          string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	PageSize        int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken       string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Only valid with the task and sort that produced it
+	Sort            *TaskSort              `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,5,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"` // Archived subtasks are left out by default
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListSubtasksRequest) Reset() {
+	*x = ListSubtasksRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubtasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubtasksRequest) ProtoMessage() {}
+
+func (x *ListSubtasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubtasksRequest.ProtoReflect.Descriptor instead.
+func (*ListSubtasksRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListSubtasksRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ListSubtasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSubtasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSubtasksRequest) GetSort() *TaskSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListSubtasksRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+// ListSubtasksResponse returns paginated subtasks
+type ListSubtasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubtasksResponse) Reset() {
+	*x = ListSubtasksResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubtasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubtasksResponse) ProtoMessage() {}
+
+func (x *ListSubtasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubtasksResponse.ProtoReflect.Descriptor instead.
+func (*ListSubtasksResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListSubtasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListSubtasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetTaskTreeRequest fetches a task and all of its subtasks
+type GetTaskTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskTreeRequest) Reset() {
+	*x = GetTaskTreeRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskTreeRequest) ProtoMessage() {}
+
+func (x *GetTaskTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskTreeRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetTaskTreeRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// GetTaskTreeResponse returns the root task and its visible descendants,
+// ordered by depth. Subtasks hidden from the caller are omitted together
+// with their own descendants.
+type GetTaskTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Root          *Task                  `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Descendants   []*Task                `protobuf:"bytes,2,rep,name=descendants,proto3" json:"descendants,omitempty"`
+	Truncated     bool                   `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"` // Set when the subtree exceeds the size limit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskTreeResponse) Reset() {
+	*x = GetTaskTreeResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskTreeResponse) ProtoMessage() {}
+
+func (x *GetTaskTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskTreeResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetTaskTreeResponse) GetRoot() *Task {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *GetTaskTreeResponse) GetDescendants() []*Task {
+	if x != nil {
+		return x.Descendants
+	}
+	return nil
+}
+
+func (x *GetTaskTreeResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// AddTaskDependencyRequest makes blocked_id wait for blocker_id to be done
+type AddTaskDependencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockerId     string                 `protobuf:"bytes,1,opt,name=blocker_id,json=blockerId,proto3" json:"blocker_id,omitempty"`
+	BlockedId     string                 `protobuf:"bytes,2,opt,name=blocked_id,json=blockedId,proto3" json:"blocked_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTaskDependencyRequest) Reset() {
+	*x = AddTaskDependencyRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTaskDependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTaskDependencyRequest) ProtoMessage() {}
+
+func (x *AddTaskDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTaskDependencyRequest.ProtoReflect.Descriptor instead.
+func (*AddTaskDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddTaskDependencyRequest) GetBlockerId() string {
+	if x != nil {
+		return x.BlockerId
+	}
+	return ""
+}
+
+func (x *AddTaskDependencyRequest) GetBlockedId() string {
+	if x != nil {
+		return x.BlockedId
+	}
+	return ""
+}
+
+// AddTaskDependencyResponse is empty on success
+type AddTaskDependencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTaskDependencyResponse) Reset() {
+	*x = AddTaskDependencyResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTaskDependencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTaskDependencyResponse) ProtoMessage() {}
+
+func (x *AddTaskDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTaskDependencyResponse.ProtoReflect.Descriptor instead.
+func (*AddTaskDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{16}
+}
+
+// RemoveTaskDependencyRequest removes a blocking edge
+type RemoveTaskDependencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BlockerId     string                 `protobuf:"bytes,1,opt,name=blocker_id,json=blockerId,proto3" json:"blocker_id,omitempty"`
+	BlockedId     string                 `protobuf:"bytes,2,opt,name=blocked_id,json=blockedId,proto3" json:"blocked_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTaskDependencyRequest) Reset() {
+	*x = RemoveTaskDependencyRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTaskDependencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTaskDependencyRequest) ProtoMessage() {}
+
+func (x *RemoveTaskDependencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTaskDependencyRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTaskDependencyRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RemoveTaskDependencyRequest) GetBlockerId() string {
+	if x != nil {
+		return x.BlockerId
+	}
+	return ""
+}
+
+func (x *RemoveTaskDependencyRequest) GetBlockedId() string {
+	if x != nil {
+		return x.BlockedId
+	}
+	return ""
+}
+
+// RemoveTaskDependencyResponse is empty on success
+type RemoveTaskDependencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTaskDependencyResponse) Reset() {
+	*x = RemoveTaskDependencyResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTaskDependencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTaskDependencyResponse) ProtoMessage() {}
+
+func (x *RemoveTaskDependencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTaskDependencyResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTaskDependencyResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{18}
+}
+
+// ListTaskBlockersRequest lists the tasks that block a task
+type ListTaskBlockersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskBlockersRequest) Reset() {
+	*x = ListTaskBlockersRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskBlockersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskBlockersRequest) ProtoMessage() {}
+
+func (x *ListTaskBlockersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskBlockersRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskBlockersRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListTaskBlockersRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// ListTaskBlockersResponse returns the visible blockers. Blockers hidden
+// from the caller still block the task and are only counted.
+type ListTaskBlockersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	HiddenCount   int32                  `protobuf:"varint,2,opt,name=hidden_count,json=hiddenCount,proto3" json:"hidden_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskBlockersResponse) Reset() {
+	*x = ListTaskBlockersResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskBlockersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskBlockersResponse) ProtoMessage() {}
+
+func (x *ListTaskBlockersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskBlockersResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskBlockersResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListTaskBlockersResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTaskBlockersResponse) GetHiddenCount() int32 {
+	if x != nil {
+		return x.HiddenCount
+	}
+	return 0
+}
+
+// ListTaskDependentsRequest lists the tasks a task blocks
+type ListTaskDependentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskDependentsRequest) Reset() {
+	*x = ListTaskDependentsRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskDependentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskDependentsRequest) ProtoMessage() {}
+
+func (x *ListTaskDependentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskDependentsRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskDependentsRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListTaskDependentsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// ListTaskDependentsResponse returns the visible dependents
+type ListTaskDependentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	HiddenCount   int32                  `protobuf:"varint,2,opt,name=hidden_count,json=hiddenCount,proto3" json:"hidden_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskDependentsResponse) Reset() {
+	*x = ListTaskDependentsResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskDependentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskDependentsResponse) ProtoMessage() {}
+
+func (x *ListTaskDependentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskDependentsResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskDependentsResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListTaskDependentsResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListTaskDependentsResponse) GetHiddenCount() int32 {
+	if x != nil {
+		return x.HiddenCount
+	}
+	return 0
+}
+
+// TaskFieldChange is one field's value before and after a change. Values are
+// text: IDs and enums as stored (e.g. "in_progress"), due dates in RFC 3339,
+// labels and assignees as sorted comma-separated IDs. Unset means the field
+// was empty. "creator_id" only appears when a user's tasks were handed over.
+type TaskFieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`         // API field name, e.g. "status" or "assignee_ids"
+	Before        *string                `protobuf:"bytes,2,opt,name=before,proto3,oneof" json:"before,omitempty"` // Unset for created tasks
+	After         *string                `protobuf:"bytes,3,opt,name=after,proto3,oneof" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskFieldChange) Reset() {
+	*x = TaskFieldChange{}
+	mi := &file_todo_v1_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskFieldChange) ProtoMessage() {}
+
+func (x *TaskFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskFieldChange.ProtoReflect.Descriptor instead.
+func (*TaskFieldChange) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TaskFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *TaskFieldChange) GetBefore() string {
+	if x != nil && x.Before != nil {
+		return *x.Before
+	}
+	return ""
+}
+
+func (x *TaskFieldChange) GetAfter() string {
+	if x != nil && x.After != nil {
+		return *x.After
+	}
+	return ""
+}
+
+// TaskHistoryEntry records one change to a task
+type TaskHistoryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ActorId       *string                `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"` // Unset for changes made by the server
+	Action        TaskHistoryAction      `protobuf:"varint,4,opt,name=action,proto3,enum=todo.v1.TaskHistoryAction" json:"action,omitempty"`
+	Version       int32                  `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"` // Task version after the change
+	Changes       []*TaskFieldChange     `protobuf:"bytes,6,rep,name=changes,proto3" json:"changes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskHistoryEntry) Reset() {
+	*x = TaskHistoryEntry{}
+	mi := &file_todo_v1_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskHistoryEntry) ProtoMessage() {}
+
+func (x *TaskHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskHistoryEntry.ProtoReflect.Descriptor instead.
+func (*TaskHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TaskHistoryEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TaskHistoryEntry) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TaskHistoryEntry) GetActorId() string {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return ""
+}
+
+func (x *TaskHistoryEntry) GetAction() TaskHistoryAction {
+	if x != nil {
+		return x.Action
+	}
+	return TaskHistoryAction_TASK_HISTORY_ACTION_UNSPECIFIED
+}
+
+func (x *TaskHistoryEntry) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TaskHistoryEntry) GetChanges() []*TaskFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *TaskHistoryEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListTaskHistoryRequest lists a task's changes, newest first
+type ListTaskHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskHistoryRequest) Reset() {
+	*x = ListTaskHistoryRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskHistoryRequest) ProtoMessage() {}
+
+func (x *ListTaskHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListTaskHistoryRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ListTaskHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTaskHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListTaskHistoryResponse returns one page of history
+type ListTaskHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*TaskHistoryEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskHistoryResponse) Reset() {
+	*x = ListTaskHistoryResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskHistoryResponse) ProtoMessage() {}
+
+func (x *ListTaskHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListTaskHistoryResponse) GetEntries() []*TaskHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListTaskHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// TaskWatcher is a user following a task
+type TaskWatcher struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskWatcher) Reset() {
+	*x = TaskWatcher{}
+	mi := &file_todo_v1_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskWatcher) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskWatcher) ProtoMessage() {}
+
+func (x *TaskWatcher) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskWatcher.ProtoReflect.Descriptor instead.
+func (*TaskWatcher) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TaskWatcher) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TaskWatcher) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// WatchTaskRequest follows a task as the authenticated user
+type WatchTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTaskRequest) Reset() {
+	*x = WatchTaskRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTaskRequest) ProtoMessage() {}
+
+func (x *WatchTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTaskRequest.ProtoReflect.Descriptor instead.
+func (*WatchTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WatchTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// WatchTaskResponse is empty on success
+type WatchTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTaskResponse) Reset() {
+	*x = WatchTaskResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTaskResponse) ProtoMessage() {}
+
+func (x *WatchTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTaskResponse.ProtoReflect.Descriptor instead.
+func (*WatchTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{29}
+}
+
+// UnwatchTaskRequest stops following a task
+type UnwatchTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnwatchTaskRequest) Reset() {
+	*x = UnwatchTaskRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwatchTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwatchTaskRequest) ProtoMessage() {}
+
+func (x *UnwatchTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwatchTaskRequest.ProtoReflect.Descriptor instead.
+func (*UnwatchTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UnwatchTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// UnwatchTaskResponse is empty on success
+type UnwatchTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnwatchTaskResponse) Reset() {
+	*x = UnwatchTaskResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnwatchTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnwatchTaskResponse) ProtoMessage() {}
+
+func (x *UnwatchTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnwatchTaskResponse.ProtoReflect.Descriptor instead.
+func (*UnwatchTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{31}
+}
+
+// ListTaskWatchersRequest lists who follows a task
+type ListTaskWatchersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskWatchersRequest) Reset() {
+	*x = ListTaskWatchersRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskWatchersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskWatchersRequest) ProtoMessage() {}
+
+func (x *ListTaskWatchersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskWatchersRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskWatchersRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListTaskWatchersRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// ListTaskWatchersResponse returns the task's watchers
+type ListTaskWatchersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Watchers      []*TaskWatcher         `protobuf:"bytes,1,rep,name=watchers,proto3" json:"watchers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskWatchersResponse) Reset() {
+	*x = ListTaskWatchersResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskWatchersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskWatchersResponse) ProtoMessage() {}
+
+func (x *ListTaskWatchersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskWatchersResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskWatchersResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListTaskWatchersResponse) GetWatchers() []*TaskWatcher {
+	if x != nil {
+		return x.Watchers
+	}
+	return nil
+}
+
+// TaskShare grants a user access to a task regardless of its visibility
+type TaskShare struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          ShareRole              `protobuf:"varint,2,opt,name=role,proto3,enum=todo.v1.ShareRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskShare) Reset() {
+	*x = TaskShare{}
+	mi := &file_todo_v1_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskShare) ProtoMessage() {}
+
+func (x *TaskShare) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskShare.ProtoReflect.Descriptor instead.
+func (*TaskShare) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TaskShare) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TaskShare) GetRole() ShareRole {
+	if x != nil {
+		return x.Role
+	}
+	return ShareRole_SHARE_ROLE_UNSPECIFIED
+}
+
+// ShareTaskRequest shares a task with a user of the company
+type ShareTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          ShareRole              `protobuf:"varint,3,opt,name=role,proto3,enum=todo.v1.ShareRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareTaskRequest) Reset() {
+	*x = ShareTaskRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareTaskRequest) ProtoMessage() {}
+
+func (x *ShareTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareTaskRequest.ProtoReflect.Descriptor instead.
+func (*ShareTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ShareTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ShareTaskRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ShareTaskRequest) GetRole() ShareRole {
+	if x != nil {
+		return x.Role
+	}
+	return ShareRole_SHARE_ROLE_UNSPECIFIED
+}
+
+// ShareTaskResponse returns the share
+type ShareTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Share         *TaskShare             `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareTaskResponse) Reset() {
+	*x = ShareTaskResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareTaskResponse) ProtoMessage() {}
+
+func (x *ShareTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareTaskResponse.ProtoReflect.Descriptor instead.
+func (*ShareTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ShareTaskResponse) GetShare() *TaskShare {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+// UnshareTaskRequest removes a user's share of a task
+type UnshareTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareTaskRequest) Reset() {
+	*x = UnshareTaskRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareTaskRequest) ProtoMessage() {}
+
+func (x *UnshareTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareTaskRequest.ProtoReflect.Descriptor instead.
+func (*UnshareTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UnshareTaskRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *UnshareTaskRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// UnshareTaskResponse is empty on success
+type UnshareTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnshareTaskResponse) Reset() {
+	*x = UnshareTaskResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnshareTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnshareTaskResponse) ProtoMessage() {}
+
+func (x *UnshareTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnshareTaskResponse.ProtoReflect.Descriptor instead.
+func (*UnshareTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{38}
+}
+
+// ListTaskSharesRequest lists who a task is shared with
+type ListTaskSharesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskSharesRequest) Reset() {
+	*x = ListTaskSharesRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskSharesRequest) ProtoMessage() {}
+
+func (x *ListTaskSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListTaskSharesRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListTaskSharesRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+// ListTaskSharesResponse returns the task's shares, oldest first
+type ListTaskSharesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shares        []*TaskShare           `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTaskSharesResponse) Reset() {
+	*x = ListTaskSharesResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTaskSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTaskSharesResponse) ProtoMessage() {}
+
+func (x *ListTaskSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTaskSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListTaskSharesResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListTaskSharesResponse) GetShares() []*TaskShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+// SearchTasksRequest searches title and description of tasks visible to the user
+type SearchTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"` // Web-search syntax: quoted phrases, OR, and -exclusions
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Only valid with the query that produced it
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTasksRequest) Reset() {
+	*x = SearchTasksRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksRequest) ProtoMessage() {}
+
+func (x *SearchTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksRequest.ProtoReflect.Descriptor instead.
+func (*SearchTasksRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SearchTasksRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// TaskSearchResult is a ranked match with <mark>-highlighted fragments
+type TaskSearchResult struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Task                 *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Score                float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	TitleHighlight       string                 `protobuf:"bytes,3,opt,name=title_highlight,json=titleHighlight,proto3" json:"title_highlight,omitempty"`
+	DescriptionHighlight *string                `protobuf:"bytes,4,opt,name=description_highlight,json=descriptionHighlight,proto3,oneof" json:"description_highlight,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TaskSearchResult) Reset() {
+	*x = TaskSearchResult{}
+	mi := &file_todo_v1_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskSearchResult) ProtoMessage() {}
+
+func (x *TaskSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskSearchResult.ProtoReflect.Descriptor instead.
+func (*TaskSearchResult) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TaskSearchResult) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *TaskSearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TaskSearchResult) GetTitleHighlight() string {
+	if x != nil {
+		return x.TitleHighlight
+	}
+	return ""
+}
+
+func (x *TaskSearchResult) GetDescriptionHighlight() string {
+	if x != nil && x.DescriptionHighlight != nil {
+		return *x.DescriptionHighlight
+	}
+	return ""
+}
+
+// SearchTasksResponse returns ranked, paginated matches
+type SearchTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*TaskSearchResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTasksResponse) Reset() {
+	*x = SearchTasksResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksResponse) ProtoMessage() {}
+
+func (x *SearchTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksResponse.ProtoReflect.Descriptor instead.
+func (*SearchTasksResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SearchTasksResponse) GetResults() []*TaskSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// GetTaskRequest retrieves a single task by ID
+type GetTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskRequest) Reset() {
+	*x = GetTaskRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskRequest) ProtoMessage() {}
+
+func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetTaskResponse returns the task
+type GetTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskResponse) Reset() {
+	*x = GetTaskResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskResponse) ProtoMessage() {}
+
+func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// UpdateTaskRequest updates an existing task (partial update)
+type UpdateTaskRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version        int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Required for optimistic locking
+	Title          *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description    *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	AssigneeId     *string                `protobuf:"bytes,5,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"` // Replaces every assignee; empty string unassigns the task
+	DueDate        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	Visibility     *Visibility            `protobuf:"varint,7,opt,name=visibility,proto3,enum=todo.v1.Visibility,oneof" json:"visibility,omitempty"`
+	Status         *TaskStatus            `protobuf:"varint,8,opt,name=status,proto3,enum=todo.v1.TaskStatus,oneof" json:"status,omitempty"`
+	AddLabelIds    []string               `protobuf:"bytes,9,rep,name=add_label_ids,json=addLabelIds,proto3" json:"add_label_ids,omitempty"`
+	RemoveLabelIds []string               `protobuf:"bytes,10,rep,name=remove_label_ids,json=removeLabelIds,proto3" json:"remove_label_ids,omitempty"`
+	Priority       *TaskPriority          `protobuf:"varint,11,opt,name=priority,proto3,enum=todo.v1.TaskPriority,oneof" json:"priority,omitempty"`
+	ParentId       *string                `protobuf:"bytes,12,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"` // Set to empty string to detach from the parent
+	// Any status of the company workflow; cannot be combined with status. The
+	// workflow must allow the move from the current status.
+	StatusKey         *string  `protobuf:"bytes,13,opt,name=status_key,json=statusKey,proto3,oneof" json:"status_key,omitempty"`
+	AddAssigneeIds    []string `protobuf:"bytes,14,rep,name=add_assignee_ids,json=addAssigneeIds,proto3" json:"add_assignee_ids,omitempty"` // Applied after assignee_id
+	RemoveAssigneeIds []string `protobuf:"bytes,15,rep,name=remove_assignee_ids,json=removeAssigneeIds,proto3" json:"remove_assignee_ids,omitempty"`
+	// Team of a team-visible task. Changing the visibility to anything else
+	// clears it.
+	TeamId        *string `protobuf:"bytes,16,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateTaskRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetAssigneeId() string {
+	if x != nil && x.AssigneeId != nil {
+		return *x.AssigneeId
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetVisibility() Visibility {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *UpdateTaskRequest) GetStatus() TaskStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return TaskStatus_TASK_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateTaskRequest) GetAddLabelIds() []string {
+	if x != nil {
+		return x.AddLabelIds
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetRemoveLabelIds() []string {
+	if x != nil {
+		return x.RemoveLabelIds
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetPriority() TaskPriority {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_UNSPECIFIED
+}
+
+func (x *UpdateTaskRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetStatusKey() string {
+	if x != nil && x.StatusKey != nil {
+		return *x.StatusKey
+	}
+	return ""
+}
+
+func (x *UpdateTaskRequest) GetAddAssigneeIds() []string {
+	if x != nil {
+		return x.AddAssigneeIds
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetRemoveAssigneeIds() []string {
+	if x != nil {
+		return x.RemoveAssigneeIds
+	}
+	return nil
+}
+
+func (x *UpdateTaskRequest) GetTeamId() string {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return ""
+}
+
+// UpdateTaskResponse returns the updated task
+type UpdateTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskResponse) Reset() {
+	*x = UpdateTaskResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskResponse) ProtoMessage() {}
+
+func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// TaskSeries is the schedule and template behind a recurring task
+type TaskSeries struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId        string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CreatorId        string                 `protobuf:"bytes,3,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Recurrence       *Recurrence            `protobuf:"bytes,4,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	StartsAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	LastOccurrenceAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_occurrence_at,json=lastOccurrenceAt,proto3" json:"last_occurrence_at,omitempty"`
+	Occurrences      int32                  `protobuf:"varint,7,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	NextOccurrenceAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_occurrence_at,json=nextOccurrenceAt,proto3,oneof" json:"next_occurrence_at,omitempty"` // Unset once the series has ended
+	Ended            bool                   `protobuf:"varint,9,opt,name=ended,proto3" json:"ended,omitempty"`
+	Title            string                 `protobuf:"bytes,10,opt,name=title,proto3" json:"title,omitempty"`
+	Description      *string                `protobuf:"bytes,11,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	AssigneeId       *string                `protobuf:"bytes,12,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"`
+	Visibility       Visibility             `protobuf:"varint,13,opt,name=visibility,proto3,enum=todo.v1.Visibility" json:"visibility,omitempty"`
+	Priority         TaskPriority           `protobuf:"varint,14,opt,name=priority,proto3,enum=todo.v1.TaskPriority" json:"priority,omitempty"`
+	Version          int32                  `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TeamId           *string                `protobuf:"bytes,18,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"` // Set on team-visible series
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TaskSeries) Reset() {
+	*x = TaskSeries{}
+	mi := &file_todo_v1_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskSeries) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskSeries) ProtoMessage() {}
+
+func (x *TaskSeries) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskSeries.ProtoReflect.Descriptor instead.
+func (*TaskSeries) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *TaskSeries) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TaskSeries) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *TaskSeries) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *TaskSeries) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *TaskSeries) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *TaskSeries) GetLastOccurrenceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastOccurrenceAt
+	}
+	return nil
+}
+
+func (x *TaskSeries) GetOccurrences() int32 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+func (x *TaskSeries) GetNextOccurrenceAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextOccurrenceAt
+	}
+	return nil
+}
+
+func (x *TaskSeries) GetEnded() bool {
+	if x != nil {
+		return x.Ended
+	}
+	return false
+}
+
+func (x *TaskSeries) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TaskSeries) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *TaskSeries) GetAssigneeId() string {
+	if x != nil && x.AssigneeId != nil {
+		return *x.AssigneeId
+	}
+	return ""
+}
+
+func (x *TaskSeries) GetVisibility() Visibility {
+	if x != nil {
+		return x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *TaskSeries) GetPriority() TaskPriority {
+	if x != nil {
+		return x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_UNSPECIFIED
+}
+
+func (x *TaskSeries) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *TaskSeries) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TaskSeries) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TaskSeries) GetTeamId() string {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return ""
+}
+
+// GetTaskSeriesRequest retrieves a series by ID
+type GetTaskSeriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskSeriesRequest) Reset() {
+	*x = GetTaskSeriesRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskSeriesRequest) ProtoMessage() {}
+
+func (x *GetTaskSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskSeriesRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetTaskSeriesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// GetTaskSeriesResponse returns the series
+type GetTaskSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *TaskSeries            `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTaskSeriesResponse) Reset() {
+	*x = GetTaskSeriesResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTaskSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTaskSeriesResponse) ProtoMessage() {}
+
+func (x *GetTaskSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTaskSeriesResponse.ProtoReflect.Descriptor instead.
+func (*GetTaskSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetTaskSeriesResponse) GetSeries() *TaskSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+// UpdateTaskSeriesRequest edits all future instances (partial update).
+// Template changes also apply to instances that are not done yet.
+type UpdateTaskSeriesRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version     int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Required for optimistic locking
+	Title       *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	AssigneeId  *string                `protobuf:"bytes,5,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"`
+	Visibility  *Visibility            `protobuf:"varint,6,opt,name=visibility,proto3,enum=todo.v1.Visibility,oneof" json:"visibility,omitempty"`
+	Priority    *TaskPriority          `protobuf:"varint,7,opt,name=priority,proto3,enum=todo.v1.TaskPriority,oneof" json:"priority,omitempty"`
+	// Replaces the schedule, counting from the latest occurrence
+	Recurrence    *Recurrence `protobuf:"bytes,8,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	TeamId        *string     `protobuf:"bytes,9,opt,name=team_id,json=teamId,proto3,oneof" json:"team_id,omitempty"` // As in UpdateTaskRequest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskSeriesRequest) Reset() {
+	*x = UpdateTaskSeriesRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskSeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskSeriesRequest) ProtoMessage() {}
+
+func (x *UpdateTaskSeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskSeriesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskSeriesRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateTaskSeriesRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateTaskSeriesRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateTaskSeriesRequest) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTaskSeriesRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *UpdateTaskSeriesRequest) GetAssigneeId() string {
+	if x != nil && x.AssigneeId != nil {
+		return *x.AssigneeId
+	}
+	return ""
+}
+
+func (x *UpdateTaskSeriesRequest) GetVisibility() Visibility {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return Visibility_VISIBILITY_UNSPECIFIED
+}
+
+func (x *UpdateTaskSeriesRequest) GetPriority() TaskPriority {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return TaskPriority_TASK_PRIORITY_UNSPECIFIED
+}
+
+func (x *UpdateTaskSeriesRequest) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *UpdateTaskSeriesRequest) GetTeamId() string {
+	if x != nil && x.TeamId != nil {
+		return *x.TeamId
+	}
+	return ""
+}
+
+// UpdateTaskSeriesResponse returns the series and its open instances
+type UpdateTaskSeriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Series        *TaskSeries            `protobuf:"bytes,1,opt,name=series,proto3" json:"series,omitempty"`
+	Instances     []*Task                `protobuf:"bytes,2,rep,name=instances,proto3" json:"instances,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskSeriesResponse) Reset() {
+	*x = UpdateTaskSeriesResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskSeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskSeriesResponse) ProtoMessage() {}
+
+func (x *UpdateTaskSeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskSeriesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTaskSeriesResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateTaskSeriesResponse) GetSeries() *TaskSeries {
+	if x != nil {
+		return x.Series
+	}
+	return nil
+}
+
+func (x *UpdateTaskSeriesResponse) GetInstances() []*Task {
+	if x != nil {
+		return x.Instances
+	}
+	return nil
+}
+
+// DeleteTaskRequest deletes a task by ID
+type DeleteTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskRequest) Reset() {
+	*x = DeleteTaskRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskRequest) ProtoMessage() {}
+
+func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteTaskResponse is empty on success
+type DeleteTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskResponse) Reset() {
+	*x = DeleteTaskResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskResponse) ProtoMessage() {}
+
+func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{54}
+}
+
+// ArchiveTaskRequest archives a task
+type ArchiveTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveTaskRequest) Reset() {
+	*x = ArchiveTaskRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTaskRequest) ProtoMessage() {}
+
+func (x *ArchiveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTaskRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ArchiveTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ArchiveTaskResponse returns the archived task
+type ArchiveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveTaskResponse) Reset() {
+	*x = ArchiveTaskResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveTaskResponse) ProtoMessage() {}
+
+func (x *ArchiveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveTaskResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ArchiveTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// UnarchiveTaskRequest brings an archived task back into listings
+type UnarchiveTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveTaskRequest) Reset() {
+	*x = UnarchiveTaskRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveTaskRequest) ProtoMessage() {}
+
+func (x *UnarchiveTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveTaskRequest.ProtoReflect.Descriptor instead.
+func (*UnarchiveTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *UnarchiveTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UnarchiveTaskResponse returns the task
+type UnarchiveTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnarchiveTaskResponse) Reset() {
+	*x = UnarchiveTaskResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnarchiveTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnarchiveTaskResponse) ProtoMessage() {}
+
+func (x *UnarchiveTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnarchiveTaskResponse.ProtoReflect.Descriptor instead.
+func (*UnarchiveTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UnarchiveTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// ListDeletedTasksRequest lists the trash
+type ListDeletedTasksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Only valid with the sort that produced it
+	Sort          *TaskSort              `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedTasksRequest) Reset() {
+	*x = ListDeletedTasksRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedTasksRequest) ProtoMessage() {}
+
+func (x *ListDeletedTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedTasksRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedTasksRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListDeletedTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeletedTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDeletedTasksRequest) GetSort() *TaskSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+// ListDeletedTasksResponse returns paginated deleted tasks
+type ListDeletedTasksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedTasksResponse) Reset() {
+	*x = ListDeletedTasksResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedTasksResponse) ProtoMessage() {}
+
+func (x *ListDeletedTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedTasksResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedTasksResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListDeletedTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *ListDeletedTasksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// RestoreTaskRequest takes a task out of the trash
+type RestoreTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTaskRequest) Reset() {
+	*x = RestoreTaskRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTaskRequest) ProtoMessage() {}
+
+func (x *RestoreTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTaskRequest.ProtoReflect.Descriptor instead.
+func (*RestoreTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *RestoreTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RestoreTaskResponse returns the restored task
+type RestoreTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreTaskResponse) Reset() {
+	*x = RestoreTaskResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreTaskResponse) ProtoMessage() {}
+
+func (x *RestoreTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreTaskResponse.ProtoReflect.Descriptor instead.
+func (*RestoreTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *RestoreTaskResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// PurgeTaskRequest permanently removes a task from the trash
+type PurgeTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTaskRequest) Reset() {
+	*x = PurgeTaskRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTaskRequest) ProtoMessage() {}
+
+func (x *PurgeTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTaskRequest.ProtoReflect.Descriptor instead.
+func (*PurgeTaskRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PurgeTaskRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// PurgeTaskResponse is empty on success
+type PurgeTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeTaskResponse) Reset() {
+	*x = PurgeTaskResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeTaskResponse) ProtoMessage() {}
+
+func (x *PurgeTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeTaskResponse.ProtoReflect.Descriptor instead.
+func (*PurgeTaskResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{64}
+}
+
+// Label is a company-scoped tag that can be attached to tasks
+type Label struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId     string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"` // Hex color, e.g. "#1a2b3c"
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Label) Reset() {
+	*x = Label{}
+	mi := &file_todo_v1_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Label) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Label) ProtoMessage() {}
+
+func (x *Label) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Label.ProtoReflect.Descriptor instead.
+func (*Label) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *Label) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Label) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *Label) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Label) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Label) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// CreateLabelRequest adds a label to the company catalog
+type CreateLabelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLabelRequest) Reset() {
+	*x = CreateLabelRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLabelRequest) ProtoMessage() {}
+
+func (x *CreateLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLabelRequest.ProtoReflect.Descriptor instead.
+func (*CreateLabelRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateLabelRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateLabelRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+// CreateLabelResponse returns the created label
+type CreateLabelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         *Label                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLabelResponse) Reset() {
+	*x = CreateLabelResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLabelResponse) ProtoMessage() {}
+
+func (x *CreateLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLabelResponse.ProtoReflect.Descriptor instead.
+func (*CreateLabelResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateLabelResponse) GetLabel() *Label {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+// ListLabelsRequest lists the company's label catalog
+type ListLabelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLabelsRequest) Reset() {
+	*x = ListLabelsRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLabelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLabelsRequest) ProtoMessage() {}
+
+func (x *ListLabelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLabelsRequest.ProtoReflect.Descriptor instead.
+func (*ListLabelsRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{68}
+}
+
+// ListLabelsResponse returns labels ordered by name
+type ListLabelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Labels        []*Label               `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLabelsResponse) Reset() {
+	*x = ListLabelsResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLabelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLabelsResponse) ProtoMessage() {}
+
+func (x *ListLabelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLabelsResponse.ProtoReflect.Descriptor instead.
+func (*ListLabelsResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListLabelsResponse) GetLabels() []*Label {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// UpdateLabelRequest renames or recolors a label (partial update)
+type UpdateLabelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Color         *string                `protobuf:"bytes,3,opt,name=color,proto3,oneof" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLabelRequest) Reset() {
+	*x = UpdateLabelRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLabelRequest) ProtoMessage() {}
+
+func (x *UpdateLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLabelRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLabelRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UpdateLabelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateLabelRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateLabelRequest) GetColor() string {
+	if x != nil && x.Color != nil {
+		return *x.Color
+	}
+	return ""
+}
+
+// UpdateLabelResponse returns the updated label
+type UpdateLabelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Label         *Label                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLabelResponse) Reset() {
+	*x = UpdateLabelResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLabelResponse) ProtoMessage() {}
+
+func (x *UpdateLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLabelResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLabelResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdateLabelResponse) GetLabel() *Label {
+	if x != nil {
+		return x.Label
+	}
+	return nil
+}
+
+// DeleteLabelRequest removes a label and detaches it from all tasks
+type DeleteLabelRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLabelRequest) Reset() {
+	*x = DeleteLabelRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLabelRequest) ProtoMessage() {}
+
+func (x *DeleteLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLabelRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLabelRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteLabelRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteLabelResponse is empty on success
+type DeleteLabelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLabelResponse) Reset() {
+	*x = DeleteLabelResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLabelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLabelResponse) ProtoMessage() {}
+
+func (x *DeleteLabelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLabelResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLabelResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{73}
+}
+
+// Comment is a message on a task; replies set parent_id
+type Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ParentId      *string                `protobuf:"bytes,4,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	EditedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=edited_at,json=editedAt,proto3,oneof" json:"edited_at,omitempty"` // Unset until the body is edited
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_todo_v1_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *Comment) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *Comment) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+func (x *Comment) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Comment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Comment) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+// CreateCommentRequest comments on a task or replies to a comment on it
+type CreateCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`                               // May @mention users by email or ID
+	ParentId      *string                `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"` // Comment being replied to, on the same task
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentRequest) Reset() {
+	*x = CreateCommentRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentRequest) ProtoMessage() {}
+
+func (x *CreateCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommentRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CreateCommentRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *CreateCommentRequest) GetParentId() string {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return ""
+}
+
+// CreateCommentResponse returns the created comment
+type CreateCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentResponse) Reset() {
+	*x = CreateCommentResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentResponse) ProtoMessage() {}
+
+func (x *CreateCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentResponse.ProtoReflect.Descriptor instead.
+func (*CreateCommentResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreateCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// ListCommentsRequest lists a task's comments, oldest first
+type ListCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsRequest) ProtoMessage() {}
+
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListCommentsRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *ListCommentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCommentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListCommentsResponse returns one page of comments
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCommentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCommentsResponse) ProtoMessage() {}
+
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListCommentsResponse) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *ListCommentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// EditCommentRequest replaces a comment's body
+type EditCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCommentRequest) Reset() {
+	*x = EditCommentRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCommentRequest) ProtoMessage() {}
+
+func (x *EditCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCommentRequest.ProtoReflect.Descriptor instead.
+func (*EditCommentRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *EditCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EditCommentRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+// EditCommentResponse returns the edited comment
+type EditCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EditCommentResponse) Reset() {
+	*x = EditCommentResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EditCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditCommentResponse) ProtoMessage() {}
+
+func (x *EditCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditCommentResponse.ProtoReflect.Descriptor instead.
+func (*EditCommentResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *EditCommentResponse) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// DeleteCommentRequest deletes a comment by ID
+type DeleteCommentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentRequest) Reset() {
+	*x = DeleteCommentRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentRequest) ProtoMessage() {}
+
+func (x *DeleteCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCommentRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DeleteCommentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// DeleteCommentResponse is empty on success
+type DeleteCommentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCommentResponse) Reset() {
+	*x = DeleteCommentResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCommentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCommentResponse) ProtoMessage() {}
+
+func (x *DeleteCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCommentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCommentResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{82}
+}
+
+// Notification is an entry in the authenticated user's inbox
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          NotificationKind       `protobuf:"varint,2,opt,name=kind,proto3,enum=todo.v1.NotificationKind" json:"kind,omitempty"`
+	TaskId        string                 `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ActorId       *string                `protobuf:"bytes,4,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`       // Unset for reminders sent by the server
+	CommentId     *string                `protobuf:"bytes,5,opt,name=comment_id,json=commentId,proto3,oneof" json:"comment_id,omitempty"` // Set for mentions in comments
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`       // The due date a DUE_SOON reminder is about
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ReadAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=read_at,json=readAt,proto3,oneof" json:"read_at,omitempty"`
+	ChangedFields []string               `protobuf:"bytes,9,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"` // Task fields a TASK_UPDATED change touched, e.g. "status"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_todo_v1_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetKind() NotificationKind {
+	if x != nil {
+		return x.Kind
+	}
+	return NotificationKind_NOTIFICATION_KIND_UNSPECIFIED
+}
+
+func (x *Notification) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *Notification) GetActorId() string {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return ""
+}
+
+func (x *Notification) GetCommentId() string {
+	if x != nil && x.CommentId != nil {
+		return *x.CommentId
+	}
+	return ""
+}
+
+func (x *Notification) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Notification) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Notification) GetReadAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadAt
+	}
+	return nil
+}
+
+func (x *Notification) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+// ListNotificationsRequest lists the inbox, newest first
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	UnreadOnly    bool                   `protobuf:"varint,3,opt,name=unread_only,json=unreadOnly,proto3" json:"unread_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListNotificationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListNotificationsRequest) GetUnreadOnly() bool {
+	if x != nil {
+		return x.UnreadOnly
+	}
+	return false
+}
+
+// ListNotificationsResponse returns one page of notifications
+type ListNotificationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationsResponse) Reset() {
+	*x = ListNotificationsResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsResponse) ProtoMessage() {}
+
+func (x *ListNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ListNotificationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// MarkNotificationsReadRequest marks the given notifications read, or the
+// whole inbox when all is set. Exactly one of the two must be given.
+type MarkNotificationsReadRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NotificationIds []string               `protobuf:"bytes,1,rep,name=notification_ids,json=notificationIds,proto3" json:"notification_ids,omitempty"`
+	All             bool                   `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadRequest) Reset() {
+	*x = MarkNotificationsReadRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadRequest) ProtoMessage() {}
+
+func (x *MarkNotificationsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *MarkNotificationsReadRequest) GetNotificationIds() []string {
+	if x != nil {
+		return x.NotificationIds
+	}
+	return nil
+}
+
+func (x *MarkNotificationsReadRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+// MarkNotificationsReadResponse reports how many notifications were unread
+type MarkNotificationsReadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MarkedCount   int32                  `protobuf:"varint,1,opt,name=marked_count,json=markedCount,proto3" json:"marked_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkNotificationsReadResponse) Reset() {
+	*x = MarkNotificationsReadResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkNotificationsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkNotificationsReadResponse) ProtoMessage() {}
+
+func (x *MarkNotificationsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkNotificationsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkNotificationsReadResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *MarkNotificationsReadResponse) GetMarkedCount() int32 {
+	if x != nil {
+		return x.MarkedCount
+	}
+	return 0
+}
+
+// GetUnreadCountRequest takes no arguments
+type GetUnreadCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountRequest) Reset() {
+	*x = GetUnreadCountRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountRequest) ProtoMessage() {}
+
+func (x *GetUnreadCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{88}
+}
+
+// GetUnreadCountResponse returns the number of unread notifications
+type GetUnreadCountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UnreadCount   int32                  `protobuf:"varint,1,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountResponse) Reset() {
+	*x = GetUnreadCountResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountResponse) ProtoMessage() {}
+
+func (x *GetUnreadCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountResponse.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetUnreadCountResponse) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+// Company is a tenant; users only ever see their own
+type Company struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Id                   string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AutoArchiveAfterDays int32                  `protobuf:"varint,3,opt,name=auto_archive_after_days,json=autoArchiveAfterDays,proto3" json:"auto_archive_after_days,omitempty"` // 0 when done tasks are never archived automatically
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SuspendedAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=suspended_at,json=suspendedAt,proto3,oneof" json:"suspended_at,omitempty"` // Set while its users cannot sign in
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Company) Reset() {
+	*x = Company{}
+	mi := &file_todo_v1_service_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Company) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Company) ProtoMessage() {}
+
+func (x *Company) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Company.ProtoReflect.Descriptor instead.
+func (*Company) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *Company) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Company) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Company) GetAutoArchiveAfterDays() int32 {
+	if x != nil {
+		return x.AutoArchiveAfterDays
+	}
+	return 0
+}
+
+func (x *Company) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Company) GetSuspendedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SuspendedAt
+	}
+	return nil
+}
+
+// GetCompanyRequest takes no arguments
+type GetCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyRequest) Reset() {
+	*x = GetCompanyRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyRequest) ProtoMessage() {}
+
+func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{91}
+}
+
+// GetCompanyResponse returns the user's company
+type GetCompanyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyResponse) Reset() {
+	*x = GetCompanyResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyResponse) ProtoMessage() {}
+
+func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetCompanyResponse) GetCompany() *Company {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+// UpdateCompanySettingsRequest changes the settings that are set
+type UpdateCompanySettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Archive done tasks this many days after completion, at most 3650; 0 turns it off
+	AutoArchiveAfterDays *int32 `protobuf:"varint,1,opt,name=auto_archive_after_days,json=autoArchiveAfterDays,proto3,oneof" json:"auto_archive_after_days,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UpdateCompanySettingsRequest) Reset() {
+	*x = UpdateCompanySettingsRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanySettingsRequest) ProtoMessage() {}
+
+func (x *UpdateCompanySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanySettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *UpdateCompanySettingsRequest) GetAutoArchiveAfterDays() int32 {
+	if x != nil && x.AutoArchiveAfterDays != nil {
+		return *x.AutoArchiveAfterDays
+	}
+	return 0
+}
+
+// UpdateCompanySettingsResponse returns the updated company
+type UpdateCompanySettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCompanySettingsResponse) Reset() {
+	*x = UpdateCompanySettingsResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanySettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanySettingsResponse) ProtoMessage() {}
+
+func (x *UpdateCompanySettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanySettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCompanySettingsResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *UpdateCompanySettingsResponse) GetCompany() *Company {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+// WorkflowStatus is one status the company's tasks can be in
+type WorkflowStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // Lowercase letters, digits and underscores, up to 32 characters
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Category      StatusCategory         `protobuf:"varint,3,opt,name=category,proto3,enum=todo.v1.StatusCategory" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowStatus) Reset() {
+	*x = WorkflowStatus{}
+	mi := &file_todo_v1_service_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowStatus) ProtoMessage() {}
+
+func (x *WorkflowStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowStatus.ProtoReflect.Descriptor instead.
+func (*WorkflowStatus) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *WorkflowStatus) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *WorkflowStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkflowStatus) GetCategory() StatusCategory {
+	if x != nil {
+		return x.Category
+	}
+	return StatusCategory_STATUS_CATEGORY_UNSPECIFIED
+}
+
+// WorkflowTransition allows tasks to move from one status to another
+type WorkflowTransition struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WorkflowTransition) Reset() {
+	*x = WorkflowTransition{}
+	mi := &file_todo_v1_service_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WorkflowTransition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkflowTransition) ProtoMessage() {}
+
+func (x *WorkflowTransition) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkflowTransition.ProtoReflect.Descriptor instead.
+func (*WorkflowTransition) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *WorkflowTransition) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *WorkflowTransition) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+// Workflow lists the company's statuses in display order and the moves
+// allowed between them. New tasks start in the first todo-category status.
+type Workflow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statuses      []*WorkflowStatus      `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	Transitions   []*WorkflowTransition  `protobuf:"bytes,2,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Workflow) Reset() {
+	*x = Workflow{}
+	mi := &file_todo_v1_service_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Workflow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Workflow) ProtoMessage() {}
+
+func (x *Workflow) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Workflow.ProtoReflect.Descriptor instead.
+func (*Workflow) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *Workflow) GetStatuses() []*WorkflowStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *Workflow) GetTransitions() []*WorkflowTransition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+// GetWorkflowRequest takes no arguments
+type GetWorkflowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkflowRequest) Reset() {
+	*x = GetWorkflowRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowRequest) ProtoMessage() {}
+
+func (x *GetWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{98}
+}
+
+// GetWorkflowResponse returns the company's workflow
+type GetWorkflowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workflow      *Workflow              `protobuf:"bytes,1,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkflowResponse) Reset() {
+	*x = GetWorkflowResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkflowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkflowResponse) ProtoMessage() {}
+
+func (x *GetWorkflowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkflowResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkflowResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetWorkflowResponse) GetWorkflow() *Workflow {
+	if x != nil {
+		return x.Workflow
+	}
+	return nil
+}
+
+// UpdateWorkflowRequest replaces the company's workflow. It needs at least
+// one todo and one done status, and cannot drop a status tasks are still in.
+type UpdateWorkflowRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workflow      *Workflow              `protobuf:"bytes,1,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkflowRequest) Reset() {
+	*x = UpdateWorkflowRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkflowRequest) ProtoMessage() {}
+
+func (x *UpdateWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *UpdateWorkflowRequest) GetWorkflow() *Workflow {
+	if x != nil {
+		return x.Workflow
+	}
+	return nil
+}
+
+// UpdateWorkflowResponse returns the saved workflow
+type UpdateWorkflowResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workflow      *Workflow              `protobuf:"bytes,1,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWorkflowResponse) Reset() {
+	*x = UpdateWorkflowResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWorkflowResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkflowResponse) ProtoMessage() {}
+
+func (x *UpdateWorkflowResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkflowResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkflowResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *UpdateWorkflowResponse) GetWorkflow() *Workflow {
+	if x != nil {
+		return x.Workflow
+	}
+	return nil
+}
+
+// User is a member of the authenticated user's company
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role          UserRole               `protobuf:"varint,3,opt,name=role,proto3,enum=todo.v1.UserRole" json:"role,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DeactivatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deactivated_at,json=deactivatedAt,proto3,oneof" json:"deactivated_at,omitempty"` // Set once the user can no longer sign in
+	TeamIds       []string               `protobuf:"bytes,6,rep,name=team_ids,json=teamIds,proto3" json:"team_ids,omitempty"`                         // Teams the user belongs to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_todo_v1_service_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetDeactivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeactivatedAt
+	}
+	return nil
+}
+
+func (x *User) GetTeamIds() []string {
+	if x != nil {
+		return x.TeamIds
+	}
+	return nil
+}
+
+// Invite asks someone to join the company
+type Invite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role          UserRole               `protobuf:"varint,3,opt,name=role,proto3,enum=todo.v1.UserRole" json:"role,omitempty"`
+	InvitedBy     string                 `protobuf:"bytes,4,opt,name=invited_by,json=invitedBy,proto3" json:"invited_by,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_todo_v1_service_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *Invite) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invite) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Invite) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *Invite) GetInvitedBy() string {
+	if x != nil {
+		return x.InvitedBy
+	}
+	return ""
+}
+
+func (x *Invite) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListUsersRequest lists the company's users
+type ListUsersRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	IncludeDeactivated bool                   `protobuf:"varint,1,opt,name=include_deactivated,json=includeDeactivated,proto3" json:"include_deactivated,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListUsersRequest) GetIncludeDeactivated() bool {
+	if x != nil {
+		return x.IncludeDeactivated
+	}
+	return false
+}
+
+// ListUsersResponse returns users ordered by email
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// GetUserRequest looks up a user of the company
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// GetUserResponse returns the user
+type GetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// InviteUserRequest invites someone to the company with a role no higher
+// than your own
+type InviteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Role          UserRole               `protobuf:"varint,2,opt,name=role,proto3,enum=todo.v1.UserRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteUserRequest) Reset() {
+	*x = InviteUserRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteUserRequest) ProtoMessage() {}
+
+func (x *InviteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteUserRequest.ProtoReflect.Descriptor instead.
+func (*InviteUserRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *InviteUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteUserRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+// InviteUserResponse returns the invite and its single-use token. The token
+// is not stored and cannot be retrieved again.
+type InviteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invite        *Invite                `protobuf:"bytes,1,opt,name=invite,proto3" json:"invite,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteUserResponse) Reset() {
+	*x = InviteUserResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteUserResponse) ProtoMessage() {}
+
+func (x *InviteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteUserResponse.ProtoReflect.Descriptor instead.
+func (*InviteUserResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *InviteUserResponse) GetInvite() *Invite {
+	if x != nil {
+		return x.Invite
+	}
+	return nil
+}
+
+func (x *InviteUserResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// AcceptInviteRequest redeems an invite token
+type AcceptInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInviteRequest) Reset() {
+	*x = AcceptInviteRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInviteRequest) ProtoMessage() {}
+
+func (x *AcceptInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInviteRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInviteRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *AcceptInviteRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// AcceptInviteResponse returns the new user and a token to sign in with
+type AcceptInviteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInviteResponse) Reset() {
+	*x = AcceptInviteResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInviteResponse) ProtoMessage() {}
+
+func (x *AcceptInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInviteResponse.ProtoReflect.Descriptor instead.
+func (*AcceptInviteResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *AcceptInviteResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *AcceptInviteResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+// UpdateUserRoleRequest changes another user's role
+type UpdateUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          UserRole               `protobuf:"varint,2,opt,name=role,proto3,enum=todo.v1.UserRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRoleRequest) Reset() {
+	*x = UpdateUserRoleRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRoleRequest) ProtoMessage() {}
+
+func (x *UpdateUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *UpdateUserRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateUserRoleRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+// UpdateUserRoleResponse returns the updated user
+type UpdateUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRoleResponse) Reset() {
+	*x = UpdateUserRoleResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRoleResponse) ProtoMessage() {}
+
+func (x *UpdateUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *UpdateUserRoleResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// DeactivateUserRequest stops a user from signing in
+type DeactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserRequest) Reset() {
+	*x = DeactivateUserRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserRequest) ProtoMessage() {}
+
+func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *DeactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// DeactivateUserResponse returns the deactivated user
+type DeactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserResponse) Reset() {
+	*x = DeactivateUserResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserResponse) ProtoMessage() {}
+
+func (x *DeactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *DeactivateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// OffboardUserRequest hands a user's tasks over and deactivates them
+type OffboardUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TransferToId  string                 `protobuf:"bytes,2,opt,name=transfer_to_id,json=transferToId,proto3" json:"transfer_to_id,omitempty"`       // New creator of the user's tasks; must be able to edit tasks
+	ReassignToId  *string                `protobuf:"bytes,3,opt,name=reassign_to_id,json=reassignToId,proto3,oneof" json:"reassign_to_id,omitempty"` // Takes over the user's assignments; unset unassigns them
+	OnlyMeTasks   OnlyMeHandover         `protobuf:"varint,4,opt,name=only_me_tasks,json=onlyMeTasks,proto3,enum=todo.v1.OnlyMeHandover" json:"only_me_tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OffboardUserRequest) Reset() {
+	*x = OffboardUserRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OffboardUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffboardUserRequest) ProtoMessage() {}
+
+func (x *OffboardUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffboardUserRequest.ProtoReflect.Descriptor instead.
+func (*OffboardUserRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *OffboardUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *OffboardUserRequest) GetTransferToId() string {
+	if x != nil {
+		return x.TransferToId
+	}
+	return ""
+}
+
+func (x *OffboardUserRequest) GetReassignToId() string {
+	if x != nil && x.ReassignToId != nil {
+		return *x.ReassignToId
+	}
+	return ""
+}
+
+func (x *OffboardUserRequest) GetOnlyMeTasks() OnlyMeHandover {
+	if x != nil {
+		return x.OnlyMeTasks
+	}
+	return OnlyMeHandover_ONLY_ME_HANDOVER_UNSPECIFIED
+}
+
+// OffboardUserResponse returns the deactivated user and how many tasks were
+// handed over
+type OffboardUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	TasksUpdated  int32                  `protobuf:"varint,2,opt,name=tasks_updated,json=tasksUpdated,proto3" json:"tasks_updated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OffboardUserResponse) Reset() {
+	*x = OffboardUserResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OffboardUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OffboardUserResponse) ProtoMessage() {}
+
+func (x *OffboardUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OffboardUserResponse.ProtoReflect.Descriptor instead.
+func (*OffboardUserResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *OffboardUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *OffboardUserResponse) GetTasksUpdated() int32 {
+	if x != nil {
+		return x.TasksUpdated
+	}
+	return 0
+}
+
+// CreateCompanyRequest provisions a tenant with its first owner
+type CreateCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Up to 200 characters
+	OwnerEmail    string                 `protobuf:"bytes,2,opt,name=owner_email,json=ownerEmail,proto3" json:"owner_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompanyRequest) Reset() {
+	*x = CreateCompanyRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyRequest) ProtoMessage() {}
+
+func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *CreateCompanyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetOwnerEmail() string {
+	if x != nil {
+		return x.OwnerEmail
+	}
+	return ""
+}
+
+// CreateCompanyResponse returns the company, its owner and a token the
+// owner can sign in with
+type CreateCompanyResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Company          *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	Owner            *User                  `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	OwnerAccessToken string                 `protobuf:"bytes,3,opt,name=owner_access_token,json=ownerAccessToken,proto3" json:"owner_access_token,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateCompanyResponse) Reset() {
+	*x = CreateCompanyResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyResponse) ProtoMessage() {}
+
+func (x *CreateCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompanyResponse.ProtoReflect.Descriptor instead.
+func (*CreateCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *CreateCompanyResponse) GetCompany() *Company {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+func (x *CreateCompanyResponse) GetOwner() *User {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *CreateCompanyResponse) GetOwnerAccessToken() string {
+	if x != nil {
+		return x.OwnerAccessToken
+	}
+	return ""
+}
+
+// ListCompaniesRequest takes no arguments
+type ListCompaniesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompaniesRequest) Reset() {
+	*x = ListCompaniesRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompaniesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompaniesRequest) ProtoMessage() {}
+
+func (x *ListCompaniesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompaniesRequest.ProtoReflect.Descriptor instead.
+func (*ListCompaniesRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{120}
+}
+
+// ListCompaniesResponse returns every company, oldest first
+type ListCompaniesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Companies     []*Company             `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompaniesResponse) Reset() {
+	*x = ListCompaniesResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompaniesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompaniesResponse) ProtoMessage() {}
+
+func (x *ListCompaniesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompaniesResponse.ProtoReflect.Descriptor instead.
+func (*ListCompaniesResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *ListCompaniesResponse) GetCompanies() []*Company {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
+// RenameCompanyRequest changes a company's name
+type RenameCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCompanyRequest) Reset() {
+	*x = RenameCompanyRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCompanyRequest) ProtoMessage() {}
+
+func (x *RenameCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCompanyRequest.ProtoReflect.Descriptor instead.
+func (*RenameCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *RenameCompanyRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *RenameCompanyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// RenameCompanyResponse returns the renamed company
+type RenameCompanyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameCompanyResponse) Reset() {
+	*x = RenameCompanyResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameCompanyResponse) ProtoMessage() {}
+
+func (x *RenameCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameCompanyResponse.ProtoReflect.Descriptor instead.
+func (*RenameCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *RenameCompanyResponse) GetCompany() *Company {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+// SuspendCompanyRequest blocks a company's users from signing in
+type SuspendCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendCompanyRequest) Reset() {
+	*x = SuspendCompanyRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendCompanyRequest) ProtoMessage() {}
+
+func (x *SuspendCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendCompanyRequest.ProtoReflect.Descriptor instead.
+func (*SuspendCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *SuspendCompanyRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+// SuspendCompanyResponse returns the suspended company
+type SuspendCompanyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendCompanyResponse) Reset() {
+	*x = SuspendCompanyResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendCompanyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*SuspendCompanyResponse) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_v1_service_proto_msgTypes[0]
+func (x *SuspendCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -169,122 +7789,135 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
-	return file_todo_v1_service_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use SuspendCompanyResponse.ProtoReflect.Descriptor instead.
+func (*SuspendCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *Task) GetId() string {
+func (x *SuspendCompanyResponse) GetCompany() *Company {
 	if x != nil {
-		return x.Id
+		return x.Company
 	}
-	return ""
+	return nil
 }
 
-func (x *Task) GetCompanyId() string {
-	if x != nil {
-		return x.CompanyId
-	}
-	return ""
+// UnsuspendCompanyRequest lets a company's users sign in again
+type UnsuspendCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Task) GetCreatorId() string {
-	if x != nil {
-		return x.CreatorId
-	}
-	return ""
+func (x *UnsuspendCompanyRequest) Reset() {
+	*x = UnsuspendCompanyRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) GetAssigneeId() string {
-	if x != nil && x.AssigneeId != nil {
-		return *x.AssigneeId
-	}
-	return ""
+func (x *UnsuspendCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Task) GetTitle() string {
+func (*UnsuspendCompanyRequest) ProtoMessage() {}
+
+func (x *UnsuspendCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[126]
 	if x != nil {
-		return x.Title
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Task) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
+// Deprecated: Use UnsuspendCompanyRequest.ProtoReflect.Descriptor instead.
+func (*UnsuspendCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *Task) GetDueDate() *timestamppb.Timestamp {
+func (x *UnsuspendCompanyRequest) GetCompanyId() string {
 	if x != nil {
-		return x.DueDate
+		return x.CompanyId
 	}
-	return nil
+	return ""
 }
 
-func (x *Task) GetVisibility() Visibility {
-	if x != nil {
-		return x.Visibility
-	}
-	return Visibility_VISIBILITY_UNSPECIFIED
+// UnsuspendCompanyResponse returns the company
+type UnsuspendCompanyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Task) GetStatus() TaskStatus {
-	if x != nil {
-		return x.Status
-	}
-	return TaskStatus_TASK_STATUS_UNSPECIFIED
+func (x *UnsuspendCompanyResponse) Reset() {
+	*x = UnsuspendCompanyResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) GetVersion() int32 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
+func (x *UnsuspendCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+func (*UnsuspendCompanyResponse) ProtoMessage() {}
+
+func (x *UnsuspendCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[127]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
+// Deprecated: Use UnsuspendCompanyResponse.ProtoReflect.Descriptor instead.
+func (*UnsuspendCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *UnsuspendCompanyResponse) GetCompany() *Company {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Company
 	}
 	return nil
 }
 
-// CreateTaskRequest creates a new task
-type CreateTaskRequest struct {
+// Team is a group of users within a company. Tasks with VISIBILITY_TEAM
+// are shown to the team's members.
+type Team struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	AssigneeId    *string                `protobuf:"bytes,3,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
-	Visibility    Visibility             `protobuf:"varint,5,opt,name=visibility,proto3,enum=todo.v1.Visibility" json:"visibility,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId     string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskRequest) Reset() {
-	*x = CreateTaskRequest{}
-	mi := &file_todo_v1_service_proto_msgTypes[1]
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_todo_v1_service_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskRequest) String() string {
+func (x *Team) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskRequest) ProtoMessage() {}
+func (*Team) ProtoMessage() {}
 
-func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_v1_service_proto_msgTypes[1]
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -295,69 +7928,62 @@ func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
-func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_todo_v1_service_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *CreateTaskRequest) GetTitle() string {
+func (x *Team) GetId() string {
 	if x != nil {
-		return x.Title
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateTaskRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
+func (x *Team) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
 	}
 	return ""
 }
 
-func (x *CreateTaskRequest) GetAssigneeId() string {
-	if x != nil && x.AssigneeId != nil {
-		return *x.AssigneeId
+func (x *Team) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateTaskRequest) GetDueDate() *timestamppb.Timestamp {
+func (x *Team) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.DueDate
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *CreateTaskRequest) GetVisibility() Visibility {
-	if x != nil {
-		return x.Visibility
-	}
-	return Visibility_VISIBILITY_UNSPECIFIED
-}
-
-// CreateTaskResponse returns the created task
-type CreateTaskResponse struct {
+// CreateTeamRequest adds a team to the company
+type CreateTeamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // Up to 50 characters, unique within the company
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskResponse) Reset() {
-	*x = CreateTaskResponse{}
-	mi := &file_todo_v1_service_proto_msgTypes[2]
+func (x *CreateTeamRequest) Reset() {
+	*x = CreateTeamRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskResponse) String() string {
+func (x *CreateTeamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskResponse) ProtoMessage() {}
+func (*CreateTeamRequest) ProtoMessage() {}
 
-func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_v1_service_proto_msgTypes[2]
+func (x *CreateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -368,42 +7994,41 @@ func (x *CreateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskResponse.ProtoReflect.Descriptor instead.
-func (*CreateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_todo_v1_service_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CreateTeamRequest.ProtoReflect.Descriptor instead.
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *CreateTaskResponse) GetTask() *Task {
+func (x *CreateTeamRequest) GetName() string {
 	if x != nil {
-		return x.Task
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-// ListCompanyTasksRequest lists all tasks visible to the user in their company
-type ListCompanyTasksRequest struct {
+// CreateTeamResponse returns the created team
+type CreateTeamResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCompanyTasksRequest) Reset() {
-	*x = ListCompanyTasksRequest{}
-	mi := &file_todo_v1_service_proto_msgTypes[3]
+func (x *CreateTeamResponse) Reset() {
+	*x = CreateTeamResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCompanyTasksRequest) String() string {
+func (x *CreateTeamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCompanyTasksRequest) ProtoMessage() {}
+func (*CreateTeamResponse) ProtoMessage() {}
 
-func (x *ListCompanyTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_v1_service_proto_msgTypes[3]
+func (x *CreateTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,49 +8039,78 @@ func (x *ListCompanyTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCompanyTasksRequest.ProtoReflect.Descriptor instead.
-func (*ListCompanyTasksRequest) Descriptor() ([]byte, []int) {
-	return file_todo_v1_service_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CreateTeamResponse.ProtoReflect.Descriptor instead.
+func (*CreateTeamResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *ListCompanyTasksRequest) GetPageSize() int32 {
+func (x *CreateTeamResponse) GetTeam() *Team {
 	if x != nil {
-		return x.PageSize
+		return x.Team
 	}
-	return 0
+	return nil
 }
 
-func (x *ListCompanyTasksRequest) GetPageToken() string {
+// ListTeamsRequest lists the company's teams
+type ListTeamsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamsRequest) Reset() {
+	*x = ListTeamsRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamsRequest) ProtoMessage() {}
+
+func (x *ListTeamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[131]
 	if x != nil {
-		return x.PageToken
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-// ListCompanyTasksResponse returns paginated tasks
-type ListCompanyTasksResponse struct {
+// Deprecated: Use ListTeamsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamsRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{131}
+}
+
+// ListTeamsResponse returns teams ordered by name
+type ListTeamsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Teams         []*Team                `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCompanyTasksResponse) Reset() {
-	*x = ListCompanyTasksResponse{}
-	mi := &file_todo_v1_service_proto_msgTypes[4]
+func (x *ListTeamsResponse) Reset() {
+	*x = ListTeamsResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCompanyTasksResponse) String() string {
+func (x *ListTeamsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCompanyTasksResponse) ProtoMessage() {}
+func (*ListTeamsResponse) ProtoMessage() {}
 
-func (x *ListCompanyTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_v1_service_proto_msgTypes[4]
+func (x *ListTeamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,49 +8121,42 @@ func (x *ListCompanyTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCompanyTasksResponse.ProtoReflect.Descriptor instead.
-func (*ListCompanyTasksResponse) Descriptor() ([]byte, []int) {
-	return file_todo_v1_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ListTeamsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamsResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *ListCompanyTasksResponse) GetTasks() []*Task {
+func (x *ListTeamsResponse) GetTeams() []*Team {
 	if x != nil {
-		return x.Tasks
+		return x.Teams
 	}
 	return nil
 }
 
-func (x *ListCompanyTasksResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-// ListMyTasksRequest lists tasks assigned to the authenticated user
-type ListMyTasksRequest struct {
+// UpdateTeamRequest renames a team (partial update)
+type UpdateTeamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMyTasksRequest) Reset() {
-	*x = ListMyTasksRequest{}
-	mi := &file_todo_v1_service_proto_msgTypes[5]
+func (x *UpdateTeamRequest) Reset() {
+	*x = UpdateTeamRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMyTasksRequest) String() string {
+func (x *UpdateTeamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMyTasksRequest) ProtoMessage() {}
+func (*UpdateTeamRequest) ProtoMessage() {}
 
-func (x *ListMyTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_v1_service_proto_msgTypes[5]
+func (x *UpdateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -520,49 +8167,48 @@ func (x *ListMyTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMyTasksRequest.ProtoReflect.Descriptor instead.
-func (*ListMyTasksRequest) Descriptor() ([]byte, []int) {
-	return file_todo_v1_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use UpdateTeamRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *ListMyTasksRequest) GetPageSize() int32 {
+func (x *UpdateTeamRequest) GetId() string {
 	if x != nil {
-		return x.PageSize
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *ListMyTasksRequest) GetPageToken() string {
-	if x != nil {
-		return x.PageToken
+func (x *UpdateTeamRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-// ListMyTasksResponse returns paginated tasks
-type ListMyTasksResponse struct {
+// UpdateTeamResponse returns the updated team
+type UpdateTeamResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListMyTasksResponse) Reset() {
-	*x = ListMyTasksResponse{}
-	mi := &file_todo_v1_service_proto_msgTypes[6]
+func (x *UpdateTeamResponse) Reset() {
+	*x = UpdateTeamResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListMyTasksResponse) String() string {
+func (x *UpdateTeamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListMyTasksResponse) ProtoMessage() {}
+func (*UpdateTeamResponse) ProtoMessage() {}
 
-func (x *ListMyTasksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_v1_service_proto_msgTypes[6]
+func (x *UpdateTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -573,48 +8219,41 @@ func (x *ListMyTasksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListMyTasksResponse.ProtoReflect.Descriptor instead.
-func (*ListMyTasksResponse) Descriptor() ([]byte, []int) {
-	return file_todo_v1_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use UpdateTeamResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTeamResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *ListMyTasksResponse) GetTasks() []*Task {
+func (x *UpdateTeamResponse) GetTeam() *Team {
 	if x != nil {
-		return x.Tasks
+		return x.Team
 	}
 	return nil
 }
 
-func (x *ListMyTasksResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-// GetTaskRequest retrieves a single task by ID
-type GetTaskRequest struct {
+// DeleteTeamRequest removes a team and its memberships
+type DeleteTeamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskRequest) Reset() {
-	*x = GetTaskRequest{}
-	mi := &file_todo_v1_service_proto_msgTypes[7]
+func (x *DeleteTeamRequest) Reset() {
+	*x = DeleteTeamRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskRequest) String() string {
+func (x *DeleteTeamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskRequest) ProtoMessage() {}
+func (*DeleteTeamRequest) ProtoMessage() {}
 
-func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_v1_service_proto_msgTypes[7]
+func (x *DeleteTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,41 +8264,40 @@ func (x *GetTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskRequest.ProtoReflect.Descriptor instead.
-func (*GetTaskRequest) Descriptor() ([]byte, []int) {
-	return file_todo_v1_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DeleteTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *GetTaskRequest) GetId() string {
+func (x *DeleteTeamRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// GetTaskResponse returns the task
-type GetTaskResponse struct {
+// DeleteTeamResponse is empty on success
+type DeleteTeamResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTaskResponse) Reset() {
-	*x = GetTaskResponse{}
-	mi := &file_todo_v1_service_proto_msgTypes[8]
+func (x *DeleteTeamResponse) Reset() {
+	*x = DeleteTeamResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTaskResponse) String() string {
+func (x *DeleteTeamResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTaskResponse) ProtoMessage() {}
+func (*DeleteTeamResponse) ProtoMessage() {}
 
-func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_v1_service_proto_msgTypes[8]
+func (x *DeleteTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,48 +8308,35 @@ func (x *GetTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTaskResponse.ProtoReflect.Descriptor instead.
-func (*GetTaskResponse) Descriptor() ([]byte, []int) {
-	return file_todo_v1_service_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *GetTaskResponse) GetTask() *Task {
-	if x != nil {
-		return x.Task
-	}
-	return nil
+// Deprecated: Use DeleteTeamResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{136}
 }
 
-// UpdateTaskRequest updates an existing task (partial update)
-type UpdateTaskRequest struct {
+// AddTeamMemberRequest adds an active user of the company to a team
+type AddTeamMemberRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Required for optimistic locking
-	Title         *string                `protobuf:"bytes,3,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	AssigneeId    *string                `protobuf:"bytes,5,opt,name=assignee_id,json=assigneeId,proto3,oneof" json:"assignee_id,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
-	Visibility    *Visibility            `protobuf:"varint,7,opt,name=visibility,proto3,enum=todo.v1.Visibility,oneof" json:"visibility,omitempty"`
-	Status        *TaskStatus            `protobuf:"varint,8,opt,name=status,proto3,enum=todo.v1.TaskStatus,oneof" json:"status,omitempty"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskRequest) Reset() {
-	*x = UpdateTaskRequest{}
-	mi := &file_todo_v1_service_proto_msgTypes[9]
+func (x *AddTeamMemberRequest) Reset() {
+	*x = AddTeamMemberRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskRequest) String() string {
+func (x *AddTeamMemberRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskRequest) ProtoMessage() {}
+func (*AddTeamMemberRequest) ProtoMessage() {}
 
-func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_v1_service_proto_msgTypes[9]
+func (x *AddTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,90 +8347,137 @@ func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_todo_v1_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use AddTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *UpdateTaskRequest) GetId() string {
+func (x *AddTeamMemberRequest) GetTeamId() string {
 	if x != nil {
-		return x.Id
+		return x.TeamId
 	}
 	return ""
 }
 
-func (x *UpdateTaskRequest) GetVersion() int32 {
+func (x *AddTeamMemberRequest) GetUserId() string {
 	if x != nil {
-		return x.Version
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateTaskRequest) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
-	}
-	return ""
+// AddTeamMemberResponse is empty on success
+type AddTeamMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskRequest) GetDescription() string {
-	if x != nil && x.Description != nil {
-		return *x.Description
-	}
-	return ""
+func (x *AddTeamMemberResponse) Reset() {
+	*x = AddTeamMemberResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskRequest) GetAssigneeId() string {
-	if x != nil && x.AssigneeId != nil {
-		return *x.AssigneeId
+func (x *AddTeamMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamMemberResponse) ProtoMessage() {}
+
+func (x *AddTeamMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateTaskRequest) GetDueDate() *timestamppb.Timestamp {
+// Deprecated: Use AddTeamMemberResponse.ProtoReflect.Descriptor instead.
+func (*AddTeamMemberResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{138}
+}
+
+// RemoveTeamMemberRequest takes a user out of a team
+type RemoveTeamMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTeamMemberRequest) Reset() {
+	*x = RemoveTeamMemberRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTeamMemberRequest) ProtoMessage() {}
+
+func (x *RemoveTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[139]
 	if x != nil {
-		return x.DueDate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateTaskRequest) GetVisibility() Visibility {
-	if x != nil && x.Visibility != nil {
-		return *x.Visibility
+// Deprecated: Use RemoveTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *RemoveTeamMemberRequest) GetTeamId() string {
+	if x != nil {
+		return x.TeamId
 	}
-	return Visibility_VISIBILITY_UNSPECIFIED
+	return ""
 }
 
-func (x *UpdateTaskRequest) GetStatus() TaskStatus {
-	if x != nil && x.Status != nil {
-		return *x.Status
+func (x *RemoveTeamMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
 	}
-	return TaskStatus_TASK_STATUS_UNSPECIFIED
+	return ""
 }
 
-// UpdateTaskResponse returns the updated task
-type UpdateTaskResponse struct {
+// RemoveTeamMemberResponse is empty on success
+type RemoveTeamMemberResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Task          *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTaskResponse) Reset() {
-	*x = UpdateTaskResponse{}
-	mi := &file_todo_v1_service_proto_msgTypes[10]
+func (x *RemoveTeamMemberResponse) Reset() {
+	*x = RemoveTeamMemberResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskResponse) String() string {
+func (x *RemoveTeamMemberResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskResponse) ProtoMessage() {}
+func (*RemoveTeamMemberResponse) ProtoMessage() {}
 
-func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_v1_service_proto_msgTypes[10]
+func (x *RemoveTeamMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -816,41 +8488,34 @@ func (x *UpdateTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTaskResponse) Descriptor() ([]byte, []int) {
-	return file_todo_v1_service_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *UpdateTaskResponse) GetTask() *Task {
-	if x != nil {
-		return x.Task
-	}
-	return nil
+// Deprecated: Use RemoveTeamMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTeamMemberResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{140}
 }
 
-// DeleteTaskRequest deletes a task by ID
-type DeleteTaskRequest struct {
+// ListTeamMembersRequest lists the members of a team
+type ListTeamMembersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TeamId        string                 `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskRequest) Reset() {
-	*x = DeleteTaskRequest{}
-	mi := &file_todo_v1_service_proto_msgTypes[11]
+func (x *ListTeamMembersRequest) Reset() {
+	*x = ListTeamMembersRequest{}
+	mi := &file_todo_v1_service_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskRequest) String() string {
+func (x *ListTeamMembersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskRequest) ProtoMessage() {}
+func (*ListTeamMembersRequest) ProtoMessage() {}
 
-func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_v1_service_proto_msgTypes[11]
+func (x *ListTeamMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -861,40 +8526,41 @@ func (x *DeleteTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTaskRequest) Descriptor() ([]byte, []int) {
-	return file_todo_v1_service_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListTeamMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamMembersRequest) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *DeleteTaskRequest) GetId() string {
+func (x *ListTeamMembersRequest) GetTeamId() string {
 	if x != nil {
-		return x.Id
+		return x.TeamId
 	}
 	return ""
 }
 
-// DeleteTaskResponse is empty on success
-type DeleteTaskResponse struct {
+// ListTeamMembersResponse returns members ordered by email
+type ListTeamMembersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskResponse) Reset() {
-	*x = DeleteTaskResponse{}
-	mi := &file_todo_v1_service_proto_msgTypes[12]
+func (x *ListTeamMembersResponse) Reset() {
+	*x = ListTeamMembersResponse{}
+	mi := &file_todo_v1_service_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskResponse) String() string {
+func (x *ListTeamMembersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskResponse) ProtoMessage() {}
+func (*ListTeamMembersResponse) ProtoMessage() {}
 
-func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_todo_v1_service_proto_msgTypes[12]
+func (x *ListTeamMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_todo_v1_service_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -905,16 +8571,23 @@ func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
-	return file_todo_v1_service_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListTeamMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamMembersResponse) Descriptor() ([]byte, []int) {
+	return file_todo_v1_service_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *ListTeamMembersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
 }
 
 var File_todo_v1_service_proto protoreflect.FileDescriptor
 
 const file_todo_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x15todo/v1/service.proto\x12\atodo.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x92\x04\n" +
+	"\x15todo/v1/service.proto\x12\atodo.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcb\t\n" +
 	"\x04Task\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -935,10 +8608,40 @@ const file_todo_v1_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x0e\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1b\n" +
+	"\tlabel_ids\x18\r \x03(\tR\blabelIds\x121\n" +
+	"\bpriority\x18\x0e \x01(\x0e2\x15.todo.v1.TaskPriorityR\bpriority\x12 \n" +
+	"\tparent_id\x18\x0f \x01(\tH\x03R\bparentId\x88\x01\x01\x12C\n" +
+	"\x10subtask_progress\x18\x10 \x01(\v2\x18.todo.v1.SubtaskProgressR\x0fsubtaskProgress\x12 \n" +
+	"\tseries_id\x18\x11 \x01(\tH\x04R\bseriesId\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"occurrence\x18\x12 \x01(\x05R\n" +
+	"occurrence\x12>\n" +
+	"\n" +
+	"deleted_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampH\x05R\tdeletedAt\x88\x01\x01\x12@\n" +
+	"\varchived_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampH\x06R\n" +
+	"archivedAt\x88\x01\x01\x12B\n" +
+	"\fcompleted_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampH\aR\vcompletedAt\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"status_key\x18\x16 \x01(\tR\tstatusKey\x12@\n" +
+	"\x0fstatus_category\x18\x17 \x01(\x0e2\x17.todo.v1.StatusCategoryR\x0estatusCategory\x12!\n" +
+	"\fassignee_ids\x18\x18 \x03(\tR\vassigneeIds\x12\x1c\n" +
+	"\ateam_id\x18\x19 \x01(\tH\bR\x06teamId\x88\x01\x01B\x0e\n" +
 	"\f_assignee_idB\x0e\n" +
 	"\f_descriptionB\v\n" +
-	"\t_due_date\"\x94\x02\n" +
+	"\t_due_dateB\f\n" +
+	"\n" +
+	"_parent_idB\f\n" +
+	"\n" +
+	"_series_idB\r\n" +
+	"\v_deleted_atB\x0e\n" +
+	"\f_archived_atB\x0f\n" +
+	"\r_completed_atB\n" +
+	"\n" +
+	"\b_team_id\";\n" +
+	"\x0fSubtaskProgress\x12\x12\n" +
+	"\x04done\x18\x01 \x01(\x05R\x04done\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x96\x04\n" +
 	"\x11CreateTaskRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12%\n" +
 	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12$\n" +
@@ -947,30 +8650,200 @@ const file_todo_v1_service_proto_rawDesc = "" +
 	"\bdue_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\adueDate\x88\x01\x01\x123\n" +
 	"\n" +
 	"visibility\x18\x05 \x01(\x0e2\x13.todo.v1.VisibilityR\n" +
-	"visibilityB\x0e\n" +
+	"visibility\x12\x1b\n" +
+	"\tlabel_ids\x18\x06 \x03(\tR\blabelIds\x121\n" +
+	"\bpriority\x18\a \x01(\x0e2\x15.todo.v1.TaskPriorityR\bpriority\x12 \n" +
+	"\tparent_id\x18\b \x01(\tH\x03R\bparentId\x88\x01\x01\x123\n" +
+	"\n" +
+	"recurrence\x18\t \x01(\v2\x13.todo.v1.RecurrenceR\n" +
+	"recurrence\x12!\n" +
+	"\fassignee_ids\x18\n" +
+	" \x03(\tR\vassigneeIds\x12\x1c\n" +
+	"\ateam_id\x18\v \x01(\tH\x04R\x06teamId\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\x0e\n" +
 	"\f_assignee_idB\v\n" +
-	"\t_due_date\"7\n" +
+	"\t_due_dateB\f\n" +
+	"\n" +
+	"_parent_idB\n" +
+	"\n" +
+	"\b_team_id\"=\n" +
+	"\n" +
+	"Recurrence\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12\x1b\n" +
+	"\ttime_zone\x18\x02 \x01(\tR\btimeZone\"7\n" +
 	"\x12CreateTaskResponse\x12!\n" +
-	"\x04task\x18\x01 \x01(\v2\r.todo.v1.TaskR\x04task\"U\n" +
+	"\x04task\x18\x01 \x01(\v2\r.todo.v1.TaskR\x04task\"\xa8\a\n" +
+	"\n" +
+	"TaskFilter\x12/\n" +
+	"\bstatuses\x18\x01 \x03(\x0e2\x13.todo.v1.TaskStatusR\bstatuses\x12$\n" +
+	"\vassignee_id\x18\x02 \x01(\tH\x00R\n" +
+	"assigneeId\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"unassigned\x18\x03 \x01(\bR\n" +
+	"unassigned\x12\"\n" +
+	"\n" +
+	"creator_id\x18\x04 \x01(\tH\x01R\tcreatorId\x88\x01\x01\x128\n" +
+	"\n" +
+	"visibility\x18\x05 \x01(\x0e2\x13.todo.v1.VisibilityH\x02R\n" +
+	"visibility\x88\x01\x01\x12<\n" +
+	"\tdue_after\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x03R\bdueAfter\x88\x01\x01\x12>\n" +
+	"\n" +
+	"due_before\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x04R\tdueBefore\x88\x01\x01\x12!\n" +
+	"\foverdue_only\x18\b \x01(\bR\voverdueOnly\x12D\n" +
+	"\rcreated_after\x18\t \x01(\v2\x1a.google.protobuf.TimestampH\x05R\fcreatedAfter\x88\x01\x01\x12F\n" +
+	"\x0ecreated_before\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampH\x06R\rcreatedBefore\x88\x01\x01\x12D\n" +
+	"\rupdated_after\x18\v \x01(\v2\x1a.google.protobuf.TimestampH\aR\fupdatedAfter\x88\x01\x01\x12F\n" +
+	"\x0eupdated_before\x18\f \x01(\v2\x1a.google.protobuf.TimestampH\bR\rupdatedBefore\x88\x01\x01\x12\x1b\n" +
+	"\tlabel_ids\x18\r \x03(\tR\blabelIds\x125\n" +
+	"\n" +
+	"priorities\x18\x0e \x03(\x0e2\x15.todo.v1.TaskPriorityR\n" +
+	"priorities\x12\x1f\n" +
+	"\vstatus_keys\x18\x0f \x03(\tR\n" +
+	"statusKeysB\x0e\n" +
+	"\f_assignee_idB\r\n" +
+	"\v_creator_idB\r\n" +
+	"\v_visibilityB\f\n" +
+	"\n" +
+	"_due_afterB\r\n" +
+	"\v_due_beforeB\x10\n" +
+	"\x0e_created_afterB\x11\n" +
+	"\x0f_created_beforeB\x10\n" +
+	"\x0e_updated_afterB\x11\n" +
+	"\x0f_updated_before\"n\n" +
+	"\bTaskSort\x12,\n" +
+	"\x05field\x18\x01 \x01(\x0e2\x16.todo.v1.TaskSortFieldR\x05field\x124\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x16.todo.v1.SortDirectionR\tdirection\"\xd4\x01\n" +
 	"\x17ListCompanyTasksRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"g\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12+\n" +
+	"\x06filter\x18\x03 \x01(\v2\x13.todo.v1.TaskFilterR\x06filter\x12%\n" +
+	"\x04sort\x18\x04 \x01(\v2\x11.todo.v1.TaskSortR\x04sort\x12)\n" +
+	"\x10include_archived\x18\x05 \x01(\bR\x0fincludeArchived\"g\n" +
 	"\x18ListCompanyTasksResponse\x12#\n" +
 	"\x05tasks\x18\x01 \x03(\v2\r.todo.v1.TaskR\x05tasks\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"P\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xcf\x01\n" +
 	"\x12ListMyTasksRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
-	"page_token\x18\x02 \x01(\tR\tpageToken\"b\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12+\n" +
+	"\x06filter\x18\x03 \x01(\v2\x13.todo.v1.TaskFilterR\x06filter\x12%\n" +
+	"\x04sort\x18\x04 \x01(\v2\x11.todo.v1.TaskSortR\x04sort\x12)\n" +
+	"\x10include_archived\x18\x05 \x01(\bR\x0fincludeArchived\"b\n" +
 	"\x13ListMyTasksResponse\x12#\n" +
 	"\x05tasks\x18\x01 \x03(\v2\r.todo.v1.TaskR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xbc\x01\n" +
+	"\x13ListSubtasksRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12%\n" +
+	"\x04sort\x18\x04 \x01(\v2\x11.todo.v1.TaskSortR\x04sort\x12)\n" +
+	"\x10include_archived\x18\x05 \x01(\bR\x0fincludeArchived\"c\n" +
+	"\x14ListSubtasksResponse\x12#\n" +
+	"\x05tasks\x18\x01 \x03(\v2\r.todo.v1.TaskR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"-\n" +
+	"\x12GetTaskTreeRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"\x87\x01\n" +
+	"\x13GetTaskTreeResponse\x12!\n" +
+	"\x04root\x18\x01 \x01(\v2\r.todo.v1.TaskR\x04root\x12/\n" +
+	"\vdescendants\x18\x02 \x03(\v2\r.todo.v1.TaskR\vdescendants\x12\x1c\n" +
+	"\ttruncated\x18\x03 \x01(\bR\ttruncated\"X\n" +
+	"\x18AddTaskDependencyRequest\x12\x1d\n" +
+	"\n" +
+	"blocker_id\x18\x01 \x01(\tR\tblockerId\x12\x1d\n" +
+	"\n" +
+	"blocked_id\x18\x02 \x01(\tR\tblockedId\"\x1b\n" +
+	"\x19AddTaskDependencyResponse\"[\n" +
+	"\x1bRemoveTaskDependencyRequest\x12\x1d\n" +
+	"\n" +
+	"blocker_id\x18\x01 \x01(\tR\tblockerId\x12\x1d\n" +
+	"\n" +
+	"blocked_id\x18\x02 \x01(\tR\tblockedId\"\x1e\n" +
+	"\x1cRemoveTaskDependencyResponse\"2\n" +
+	"\x17ListTaskBlockersRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"b\n" +
+	"\x18ListTaskBlockersResponse\x12#\n" +
+	"\x05tasks\x18\x01 \x03(\v2\r.todo.v1.TaskR\x05tasks\x12!\n" +
+	"\fhidden_count\x18\x02 \x01(\x05R\vhiddenCount\"4\n" +
+	"\x19ListTaskDependentsRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"d\n" +
+	"\x1aListTaskDependentsResponse\x12#\n" +
+	"\x05tasks\x18\x01 \x03(\v2\r.todo.v1.TaskR\x05tasks\x12!\n" +
+	"\fhidden_count\x18\x02 \x01(\x05R\vhiddenCount\"t\n" +
+	"\x0fTaskFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x1b\n" +
+	"\x06before\x18\x02 \x01(\tH\x00R\x06before\x88\x01\x01\x12\x19\n" +
+	"\x05after\x18\x03 \x01(\tH\x01R\x05after\x88\x01\x01B\t\n" +
+	"\a_beforeB\b\n" +
+	"\x06_after\"\xa5\x02\n" +
+	"\x10TaskHistoryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1e\n" +
+	"\bactor_id\x18\x03 \x01(\tH\x00R\aactorId\x88\x01\x01\x122\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x1a.todo.v1.TaskHistoryActionR\x06action\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\x05R\aversion\x122\n" +
+	"\achanges\x18\x06 \x03(\v2\x18.todo.v1.TaskFieldChangeR\achanges\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\v\n" +
+	"\t_actor_id\"m\n" +
+	"\x16ListTaskHistoryRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"v\n" +
+	"\x17ListTaskHistoryResponse\x123\n" +
+	"\aentries\x18\x01 \x03(\v2\x19.todo.v1.TaskHistoryEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"<\n" +
+	"\vTaskWatcher\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\"+\n" +
+	"\x10WatchTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"\x13\n" +
+	"\x11WatchTaskResponse\"-\n" +
+	"\x12UnwatchTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"\x15\n" +
+	"\x13UnwatchTaskResponse\"2\n" +
+	"\x17ListTaskWatchersRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"L\n" +
+	"\x18ListTaskWatchersResponse\x120\n" +
+	"\bwatchers\x18\x01 \x03(\v2\x14.todo.v1.TaskWatcherR\bwatchers\"L\n" +
+	"\tTaskShare\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12&\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x12.todo.v1.ShareRoleR\x04role\"l\n" +
+	"\x10ShareTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12&\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x12.todo.v1.ShareRoleR\x04role\"=\n" +
+	"\x11ShareTaskResponse\x12(\n" +
+	"\x05share\x18\x01 \x01(\v2\x12.todo.v1.TaskShareR\x05share\"F\n" +
+	"\x12UnshareTaskRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x15\n" +
+	"\x13UnshareTaskResponse\"0\n" +
+	"\x15ListTaskSharesRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\"D\n" +
+	"\x16ListTaskSharesResponse\x12*\n" +
+	"\x06shares\x18\x01 \x03(\v2\x12.todo.v1.TaskShareR\x06shares\"f\n" +
+	"\x12SearchTasksRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xc8\x01\n" +
+	"\x10TaskSearchResult\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.todo.v1.TaskR\x04task\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x12'\n" +
+	"\x0ftitle_highlight\x18\x03 \x01(\tR\x0etitleHighlight\x128\n" +
+	"\x15description_highlight\x18\x04 \x01(\tH\x00R\x14descriptionHighlight\x88\x01\x01B\x18\n" +
+	"\x16_description_highlight\"r\n" +
+	"\x13SearchTasksResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.todo.v1.TaskSearchResultR\aresults\x12&\n" +
 	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\" \n" +
 	"\x0eGetTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
 	"\x0fGetTaskResponse\x12!\n" +
-	"\x04task\x18\x01 \x01(\v2\r.todo.v1.TaskR\x04task\"\x9e\x03\n" +
+	"\x04task\x18\x01 \x01(\v2\r.todo.v1.TaskR\x04task\"\x98\x06\n" +
 	"\x11UpdateTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x19\n" +
@@ -982,40 +8855,513 @@ const file_todo_v1_service_proto_rawDesc = "" +
 	"\n" +
 	"visibility\x18\a \x01(\x0e2\x13.todo.v1.VisibilityH\x04R\n" +
 	"visibility\x88\x01\x01\x120\n" +
-	"\x06status\x18\b \x01(\x0e2\x13.todo.v1.TaskStatusH\x05R\x06status\x88\x01\x01B\b\n" +
+	"\x06status\x18\b \x01(\x0e2\x13.todo.v1.TaskStatusH\x05R\x06status\x88\x01\x01\x12\"\n" +
+	"\radd_label_ids\x18\t \x03(\tR\vaddLabelIds\x12(\n" +
+	"\x10remove_label_ids\x18\n" +
+	" \x03(\tR\x0eremoveLabelIds\x126\n" +
+	"\bpriority\x18\v \x01(\x0e2\x15.todo.v1.TaskPriorityH\x06R\bpriority\x88\x01\x01\x12 \n" +
+	"\tparent_id\x18\f \x01(\tH\aR\bparentId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"status_key\x18\r \x01(\tH\bR\tstatusKey\x88\x01\x01\x12(\n" +
+	"\x10add_assignee_ids\x18\x0e \x03(\tR\x0eaddAssigneeIds\x12.\n" +
+	"\x13remove_assignee_ids\x18\x0f \x03(\tR\x11removeAssigneeIds\x12\x1c\n" +
+	"\ateam_id\x18\x10 \x01(\tH\tR\x06teamId\x88\x01\x01B\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\x0e\n" +
 	"\f_assignee_idB\v\n" +
 	"\t_due_dateB\r\n" +
 	"\v_visibilityB\t\n" +
-	"\a_status\"7\n" +
+	"\a_statusB\v\n" +
+	"\t_priorityB\f\n" +
+	"\n" +
+	"_parent_idB\r\n" +
+	"\v_status_keyB\n" +
+	"\n" +
+	"\b_team_id\"7\n" +
 	"\x12UpdateTaskResponse\x12!\n" +
-	"\x04task\x18\x01 \x01(\v2\r.todo.v1.TaskR\x04task\"#\n" +
+	"\x04task\x18\x01 \x01(\v2\r.todo.v1.TaskR\x04task\"\xd5\x06\n" +
+	"\n" +
+	"TaskSeries\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x02 \x01(\tR\tcompanyId\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x03 \x01(\tR\tcreatorId\x123\n" +
+	"\n" +
+	"recurrence\x18\x04 \x01(\v2\x13.todo.v1.RecurrenceR\n" +
+	"recurrence\x127\n" +
+	"\tstarts_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x12H\n" +
+	"\x12last_occurrence_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x10lastOccurrenceAt\x12 \n" +
+	"\voccurrences\x18\a \x01(\x05R\voccurrences\x12M\n" +
+	"\x12next_occurrence_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x10nextOccurrenceAt\x88\x01\x01\x12\x14\n" +
+	"\x05ended\x18\t \x01(\bR\x05ended\x12\x14\n" +
+	"\x05title\x18\n" +
+	" \x01(\tR\x05title\x12%\n" +
+	"\vdescription\x18\v \x01(\tH\x01R\vdescription\x88\x01\x01\x12$\n" +
+	"\vassignee_id\x18\f \x01(\tH\x02R\n" +
+	"assigneeId\x88\x01\x01\x123\n" +
+	"\n" +
+	"visibility\x18\r \x01(\x0e2\x13.todo.v1.VisibilityR\n" +
+	"visibility\x121\n" +
+	"\bpriority\x18\x0e \x01(\x0e2\x15.todo.v1.TaskPriorityR\bpriority\x12\x18\n" +
+	"\aversion\x18\x0f \x01(\x05R\aversion\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1c\n" +
+	"\ateam_id\x18\x12 \x01(\tH\x03R\x06teamId\x88\x01\x01B\x15\n" +
+	"\x13_next_occurrence_atB\x0e\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_assignee_idB\n" +
+	"\n" +
+	"\b_team_id\"&\n" +
+	"\x14GetTaskSeriesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"D\n" +
+	"\x15GetTaskSeriesResponse\x12+\n" +
+	"\x06series\x18\x01 \x01(\v2\x13.todo.v1.TaskSeriesR\x06series\"\xc2\x03\n" +
+	"\x17UpdateTaskSeriesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\x12\x19\n" +
+	"\x05title\x18\x03 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
+	"\vdescription\x18\x04 \x01(\tH\x01R\vdescription\x88\x01\x01\x12$\n" +
+	"\vassignee_id\x18\x05 \x01(\tH\x02R\n" +
+	"assigneeId\x88\x01\x01\x128\n" +
+	"\n" +
+	"visibility\x18\x06 \x01(\x0e2\x13.todo.v1.VisibilityH\x03R\n" +
+	"visibility\x88\x01\x01\x126\n" +
+	"\bpriority\x18\a \x01(\x0e2\x15.todo.v1.TaskPriorityH\x04R\bpriority\x88\x01\x01\x123\n" +
+	"\n" +
+	"recurrence\x18\b \x01(\v2\x13.todo.v1.RecurrenceR\n" +
+	"recurrence\x12\x1c\n" +
+	"\ateam_id\x18\t \x01(\tH\x05R\x06teamId\x88\x01\x01B\b\n" +
+	"\x06_titleB\x0e\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_assignee_idB\r\n" +
+	"\v_visibilityB\v\n" +
+	"\t_priorityB\n" +
+	"\n" +
+	"\b_team_id\"t\n" +
+	"\x18UpdateTaskSeriesResponse\x12+\n" +
+	"\x06series\x18\x01 \x01(\v2\x13.todo.v1.TaskSeriesR\x06series\x12+\n" +
+	"\tinstances\x18\x02 \x03(\v2\r.todo.v1.TaskR\tinstances\"#\n" +
 	"\x11DeleteTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
-	"\x12DeleteTaskResponse*]\n" +
+	"\x12DeleteTaskResponse\"$\n" +
+	"\x12ArchiveTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
+	"\x13ArchiveTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.todo.v1.TaskR\x04task\"&\n" +
+	"\x14UnarchiveTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\":\n" +
+	"\x15UnarchiveTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.todo.v1.TaskR\x04task\"|\n" +
+	"\x17ListDeletedTasksRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12%\n" +
+	"\x04sort\x18\x03 \x01(\v2\x11.todo.v1.TaskSortR\x04sort\"g\n" +
+	"\x18ListDeletedTasksResponse\x12#\n" +
+	"\x05tasks\x18\x01 \x03(\v2\r.todo.v1.TaskR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"$\n" +
+	"\x12RestoreTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"8\n" +
+	"\x13RestoreTaskResponse\x12!\n" +
+	"\x04task\x18\x01 \x01(\v2\r.todo.v1.TaskR\x04task\"\"\n" +
+	"\x10PurgeTaskRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x13\n" +
+	"\x11PurgeTaskResponse\"\x9b\x01\n" +
+	"\x05Label\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x02 \x01(\tR\tcompanyId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x04 \x01(\tR\x05color\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\">\n" +
+	"\x12CreateLabelRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05color\x18\x02 \x01(\tR\x05color\";\n" +
+	"\x13CreateLabelResponse\x12$\n" +
+	"\x05label\x18\x01 \x01(\v2\x0e.todo.v1.LabelR\x05label\"\x13\n" +
+	"\x11ListLabelsRequest\"<\n" +
+	"\x12ListLabelsResponse\x12&\n" +
+	"\x06labels\x18\x01 \x03(\v2\x0e.todo.v1.LabelR\x06labels\"k\n" +
+	"\x12UpdateLabelRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01\x12\x19\n" +
+	"\x05color\x18\x03 \x01(\tH\x01R\x05color\x88\x01\x01B\a\n" +
+	"\x05_nameB\b\n" +
+	"\x06_color\";\n" +
+	"\x13UpdateLabelResponse\x12$\n" +
+	"\x05label\x18\x01 \x01(\v2\x0e.todo.v1.LabelR\x05label\"$\n" +
+	"\x12DeleteLabelRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
+	"\x13DeleteLabelResponse\"\x9a\x02\n" +
+	"\aComment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12 \n" +
+	"\tparent_id\x18\x04 \x01(\tH\x00R\bparentId\x88\x01\x01\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\tedited_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x01R\beditedAt\x88\x01\x01B\f\n" +
+	"\n" +
+	"_parent_idB\f\n" +
+	"\n" +
+	"_edited_at\"s\n" +
+	"\x14CreateCommentRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\x12 \n" +
+	"\tparent_id\x18\x03 \x01(\tH\x00R\bparentId\x88\x01\x01B\f\n" +
+	"\n" +
+	"_parent_id\"C\n" +
+	"\x15CreateCommentResponse\x12*\n" +
+	"\acomment\x18\x01 \x01(\v2\x10.todo.v1.CommentR\acomment\"j\n" +
+	"\x13ListCommentsRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"l\n" +
+	"\x14ListCommentsResponse\x12,\n" +
+	"\bcomments\x18\x01 \x03(\v2\x10.todo.v1.CommentR\bcomments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"8\n" +
+	"\x12EditCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04body\x18\x02 \x01(\tR\x04body\"A\n" +
+	"\x13EditCommentResponse\x12*\n" +
+	"\acomment\x18\x01 \x01(\v2\x10.todo.v1.CommentR\acomment\"&\n" +
+	"\x14DeleteCommentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15DeleteCommentResponse\"\xb7\x03\n" +
+	"\fNotification\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x19.todo.v1.NotificationKindR\x04kind\x12\x17\n" +
+	"\atask_id\x18\x03 \x01(\tR\x06taskId\x12\x1e\n" +
+	"\bactor_id\x18\x04 \x01(\tH\x00R\aactorId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"comment_id\x18\x05 \x01(\tH\x01R\tcommentId\x88\x01\x01\x12:\n" +
+	"\bdue_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\adueDate\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
+	"\aread_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampH\x03R\x06readAt\x88\x01\x01\x12%\n" +
+	"\x0echanged_fields\x18\t \x03(\tR\rchangedFieldsB\v\n" +
+	"\t_actor_idB\r\n" +
+	"\v_comment_idB\v\n" +
+	"\t_due_dateB\n" +
+	"\n" +
+	"\b_read_at\"w\n" +
+	"\x18ListNotificationsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12\x1f\n" +
+	"\vunread_only\x18\x03 \x01(\bR\n" +
+	"unreadOnly\"\x80\x01\n" +
+	"\x19ListNotificationsResponse\x12;\n" +
+	"\rnotifications\x18\x01 \x03(\v2\x15.todo.v1.NotificationR\rnotifications\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"[\n" +
+	"\x1cMarkNotificationsReadRequest\x12)\n" +
+	"\x10notification_ids\x18\x01 \x03(\tR\x0fnotificationIds\x12\x10\n" +
+	"\x03all\x18\x02 \x01(\bR\x03all\"B\n" +
+	"\x1dMarkNotificationsReadResponse\x12!\n" +
+	"\fmarked_count\x18\x01 \x01(\x05R\vmarkedCount\"\x17\n" +
+	"\x15GetUnreadCountRequest\";\n" +
+	"\x16GetUnreadCountResponse\x12!\n" +
+	"\funread_count\x18\x01 \x01(\x05R\vunreadCount\"\xf4\x01\n" +
+	"\aCompany\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x125\n" +
+	"\x17auto_archive_after_days\x18\x03 \x01(\x05R\x14autoArchiveAfterDays\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12B\n" +
+	"\fsuspended_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vsuspendedAt\x88\x01\x01B\x0f\n" +
+	"\r_suspended_at\"\x13\n" +
+	"\x11GetCompanyRequest\"@\n" +
+	"\x12GetCompanyResponse\x12*\n" +
+	"\acompany\x18\x01 \x01(\v2\x10.todo.v1.CompanyR\acompany\"v\n" +
+	"\x1cUpdateCompanySettingsRequest\x12:\n" +
+	"\x17auto_archive_after_days\x18\x01 \x01(\x05H\x00R\x14autoArchiveAfterDays\x88\x01\x01B\x1a\n" +
+	"\x18_auto_archive_after_days\"K\n" +
+	"\x1dUpdateCompanySettingsResponse\x12*\n" +
+	"\acompany\x18\x01 \x01(\v2\x10.todo.v1.CompanyR\acompany\"k\n" +
+	"\x0eWorkflowStatus\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x123\n" +
+	"\bcategory\x18\x03 \x01(\x0e2\x17.todo.v1.StatusCategoryR\bcategory\"8\n" +
+	"\x12WorkflowTransition\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"~\n" +
+	"\bWorkflow\x123\n" +
+	"\bstatuses\x18\x01 \x03(\v2\x17.todo.v1.WorkflowStatusR\bstatuses\x12=\n" +
+	"\vtransitions\x18\x02 \x03(\v2\x1b.todo.v1.WorkflowTransitionR\vtransitions\"\x14\n" +
+	"\x12GetWorkflowRequest\"D\n" +
+	"\x13GetWorkflowResponse\x12-\n" +
+	"\bworkflow\x18\x01 \x01(\v2\x11.todo.v1.WorkflowR\bworkflow\"F\n" +
+	"\x15UpdateWorkflowRequest\x12-\n" +
+	"\bworkflow\x18\x01 \x01(\v2\x11.todo.v1.WorkflowR\bworkflow\"G\n" +
+	"\x16UpdateWorkflowResponse\x12-\n" +
+	"\bworkflow\x18\x01 \x01(\v2\x11.todo.v1.WorkflowR\bworkflow\"\x84\x02\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12%\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x11.todo.v1.UserRoleR\x04role\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12F\n" +
+	"\x0edeactivated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\rdeactivatedAt\x88\x01\x01\x12\x19\n" +
+	"\bteam_ids\x18\x06 \x03(\tR\ateamIdsB\x11\n" +
+	"\x0f_deactivated_at\"\xea\x01\n" +
+	"\x06Invite\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12%\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x11.todo.v1.UserRoleR\x04role\x12\x1d\n" +
+	"\n" +
+	"invited_by\x18\x04 \x01(\tR\tinvitedBy\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"C\n" +
+	"\x10ListUsersRequest\x12/\n" +
+	"\x13include_deactivated\x18\x01 \x01(\bR\x12includeDeactivated\"8\n" +
+	"\x11ListUsersResponse\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.todo.v1.UserR\x05users\")\n" +
+	"\x0eGetUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"4\n" +
+	"\x0fGetUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.todo.v1.UserR\x04user\"P\n" +
+	"\x11InviteUserRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12%\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x11.todo.v1.UserRoleR\x04role\"S\n" +
+	"\x12InviteUserResponse\x12'\n" +
+	"\x06invite\x18\x01 \x01(\v2\x0f.todo.v1.InviteR\x06invite\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"+\n" +
+	"\x13AcceptInviteRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\\\n" +
+	"\x14AcceptInviteResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.todo.v1.UserR\x04user\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\"W\n" +
+	"\x15UpdateUserRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12%\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x11.todo.v1.UserRoleR\x04role\";\n" +
+	"\x16UpdateUserRoleResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.todo.v1.UserR\x04user\"0\n" +
+	"\x15DeactivateUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\";\n" +
+	"\x16DeactivateUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.todo.v1.UserR\x04user\"\xcf\x01\n" +
+	"\x13OffboardUserRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12$\n" +
+	"\x0etransfer_to_id\x18\x02 \x01(\tR\ftransferToId\x12)\n" +
+	"\x0ereassign_to_id\x18\x03 \x01(\tH\x00R\freassignToId\x88\x01\x01\x12;\n" +
+	"\ronly_me_tasks\x18\x04 \x01(\x0e2\x17.todo.v1.OnlyMeHandoverR\vonlyMeTasksB\x11\n" +
+	"\x0f_reassign_to_id\"^\n" +
+	"\x14OffboardUserResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.todo.v1.UserR\x04user\x12#\n" +
+	"\rtasks_updated\x18\x02 \x01(\x05R\ftasksUpdated\"K\n" +
+	"\x14CreateCompanyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vowner_email\x18\x02 \x01(\tR\n" +
+	"ownerEmail\"\x96\x01\n" +
+	"\x15CreateCompanyResponse\x12*\n" +
+	"\acompany\x18\x01 \x01(\v2\x10.todo.v1.CompanyR\acompany\x12#\n" +
+	"\x05owner\x18\x02 \x01(\v2\r.todo.v1.UserR\x05owner\x12,\n" +
+	"\x12owner_access_token\x18\x03 \x01(\tR\x10ownerAccessToken\"\x16\n" +
+	"\x14ListCompaniesRequest\"G\n" +
+	"\x15ListCompaniesResponse\x12.\n" +
+	"\tcompanies\x18\x01 \x03(\v2\x10.todo.v1.CompanyR\tcompanies\"I\n" +
+	"\x14RenameCompanyRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"C\n" +
+	"\x15RenameCompanyResponse\x12*\n" +
+	"\acompany\x18\x01 \x01(\v2\x10.todo.v1.CompanyR\acompany\"6\n" +
+	"\x15SuspendCompanyRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\"D\n" +
+	"\x16SuspendCompanyResponse\x12*\n" +
+	"\acompany\x18\x01 \x01(\v2\x10.todo.v1.CompanyR\acompany\"8\n" +
+	"\x17UnsuspendCompanyRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\"F\n" +
+	"\x18UnsuspendCompanyResponse\x12*\n" +
+	"\acompany\x18\x01 \x01(\v2\x10.todo.v1.CompanyR\acompany\"\x84\x01\n" +
+	"\x04Team\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x02 \x01(\tR\tcompanyId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"'\n" +
+	"\x11CreateTeamRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"7\n" +
+	"\x12CreateTeamResponse\x12!\n" +
+	"\x04team\x18\x01 \x01(\v2\r.todo.v1.TeamR\x04team\"\x12\n" +
+	"\x10ListTeamsRequest\"8\n" +
+	"\x11ListTeamsResponse\x12#\n" +
+	"\x05teams\x18\x01 \x03(\v2\r.todo.v1.TeamR\x05teams\"E\n" +
+	"\x11UpdateTeamRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"7\n" +
+	"\x12UpdateTeamResponse\x12!\n" +
+	"\x04team\x18\x01 \x01(\v2\r.todo.v1.TeamR\x04team\"#\n" +
+	"\x11DeleteTeamRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12DeleteTeamResponse\"H\n" +
+	"\x14AddTeamMemberRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x17\n" +
+	"\x15AddTeamMemberResponse\"K\n" +
+	"\x17RemoveTeamMemberRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1a\n" +
+	"\x18RemoveTeamMemberResponse\"1\n" +
+	"\x16ListTeamMembersRequest\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\tR\x06teamId\">\n" +
+	"\x17ListTeamMembersResponse\x12#\n" +
+	"\x05users\x18\x01 \x03(\v2\r.todo.v1.UserR\x05users*r\n" +
 	"\n" +
 	"Visibility\x12\x1a\n" +
 	"\x16VISIBILITY_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12VISIBILITY_ONLY_ME\x10\x01\x12\x1b\n" +
-	"\x17VISIBILITY_COMPANY_WIDE\x10\x02*r\n" +
+	"\x17VISIBILITY_COMPANY_WIDE\x10\x02\x12\x13\n" +
+	"\x0fVISIBILITY_TEAM\x10\x03*r\n" +
 	"\n" +
 	"TaskStatus\x12\x1b\n" +
 	"\x17TASK_STATUS_UNSPECIFIED\x10\x00\x12\x14\n" +
 	"\x10TASK_STATUS_TODO\x10\x01\x12\x1b\n" +
 	"\x17TASK_STATUS_IN_PROGRESS\x10\x02\x12\x14\n" +
-	"\x10TASK_STATUS_DONE\x10\x032\xc3\x03\n" +
+	"\x10TASK_STATUS_DONE\x10\x03*\x81\x01\n" +
+	"\x0eStatusCategory\x12\x1f\n" +
+	"\x1bSTATUS_CATEGORY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14STATUS_CATEGORY_TODO\x10\x01\x12\x1a\n" +
+	"\x16STATUS_CATEGORY_ACTIVE\x10\x02\x12\x18\n" +
+	"\x14STATUS_CATEGORY_DONE\x10\x03*\xa8\x01\n" +
+	"\fTaskPriority\x12\x1d\n" +
+	"\x19TASK_PRIORITY_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12TASK_PRIORITY_NONE\x10\x01\x12\x15\n" +
+	"\x11TASK_PRIORITY_LOW\x10\x02\x12\x18\n" +
+	"\x14TASK_PRIORITY_MEDIUM\x10\x03\x12\x16\n" +
+	"\x12TASK_PRIORITY_HIGH\x10\x04\x12\x18\n" +
+	"\x14TASK_PRIORITY_URGENT\x10\x05*\xe3\x01\n" +
+	"\rTaskSortField\x12\x1f\n" +
+	"\x1bTASK_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aTASK_SORT_FIELD_CREATED_AT\x10\x01\x12\x1c\n" +
+	"\x18TASK_SORT_FIELD_DUE_DATE\x10\x02\x12\x1e\n" +
+	"\x1aTASK_SORT_FIELD_UPDATED_AT\x10\x03\x12\x19\n" +
+	"\x15TASK_SORT_FIELD_TITLE\x10\x04\x12\x1a\n" +
+	"\x16TASK_SORT_FIELD_STATUS\x10\x05\x12\x1c\n" +
+	"\x18TASK_SORT_FIELD_PRIORITY\x10\x06*`\n" +
+	"\rSortDirection\x12\x1e\n" +
+	"\x1aSORT_DIRECTION_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12SORT_DIRECTION_ASC\x10\x01\x12\x17\n" +
+	"\x13SORT_DIRECTION_DESC\x10\x02*\xdd\x01\n" +
+	"\x11TaskHistoryAction\x12#\n" +
+	"\x1fTASK_HISTORY_ACTION_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bTASK_HISTORY_ACTION_CREATED\x10\x01\x12\x1f\n" +
+	"\x1bTASK_HISTORY_ACTION_UPDATED\x10\x02\x12\x1f\n" +
+	"\x1bTASK_HISTORY_ACTION_DELETED\x10\x03\x12 \n" +
+	"\x1cTASK_HISTORY_ACTION_RESTORED\x10\x04\x12\x1e\n" +
+	"\x1aTASK_HISTORY_ACTION_PURGED\x10\x05*U\n" +
+	"\tShareRole\x12\x1a\n" +
+	"\x16SHARE_ROLE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11SHARE_ROLE_VIEWER\x10\x01\x12\x15\n" +
+	"\x11SHARE_ROLE_EDITOR\x10\x02*\xde\x01\n" +
+	"\x10NotificationKind\x12!\n" +
+	"\x1dNOTIFICATION_KIND_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19NOTIFICATION_KIND_MENTION\x10\x01\x12\x1e\n" +
+	"\x1aNOTIFICATION_KIND_ASSIGNED\x10\x02\x12$\n" +
+	" NOTIFICATION_KIND_STATUS_CHANGED\x10\x03\x12\x1e\n" +
+	"\x1aNOTIFICATION_KIND_DUE_SOON\x10\x04\x12\"\n" +
+	"\x1eNOTIFICATION_KIND_TASK_UPDATED\x10\x05*{\n" +
+	"\bUserRole\x12\x19\n" +
+	"\x15USER_ROLE_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10USER_ROLE_VIEWER\x10\x01\x12\x14\n" +
+	"\x10USER_ROLE_EDITOR\x10\x02\x12\x13\n" +
+	"\x0fUSER_ROLE_ADMIN\x10\x03\x12\x13\n" +
+	"\x0fUSER_ROLE_OWNER\x10\x04*q\n" +
+	"\x0eOnlyMeHandover\x12 \n" +
+	"\x1cONLY_ME_HANDOVER_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dONLY_ME_HANDOVER_KEEP_PRIVATE\x10\x01\x12\x1a\n" +
+	"\x16ONLY_ME_HANDOVER_SHARE\x10\x022\xe5\x10\n" +
 	"\vTodoService\x12E\n" +
 	"\n" +
 	"CreateTask\x12\x1a.todo.v1.CreateTaskRequest\x1a\x1b.todo.v1.CreateTaskResponse\x12W\n" +
 	"\x10ListCompanyTasks\x12 .todo.v1.ListCompanyTasksRequest\x1a!.todo.v1.ListCompanyTasksResponse\x12H\n" +
-	"\vListMyTasks\x12\x1b.todo.v1.ListMyTasksRequest\x1a\x1c.todo.v1.ListMyTasksResponse\x12<\n" +
+	"\vListMyTasks\x12\x1b.todo.v1.ListMyTasksRequest\x1a\x1c.todo.v1.ListMyTasksResponse\x12H\n" +
+	"\vSearchTasks\x12\x1b.todo.v1.SearchTasksRequest\x1a\x1c.todo.v1.SearchTasksResponse\x12K\n" +
+	"\fListSubtasks\x12\x1c.todo.v1.ListSubtasksRequest\x1a\x1d.todo.v1.ListSubtasksResponse\x12H\n" +
+	"\vGetTaskTree\x12\x1b.todo.v1.GetTaskTreeRequest\x1a\x1c.todo.v1.GetTaskTreeResponse\x12Z\n" +
+	"\x11AddTaskDependency\x12!.todo.v1.AddTaskDependencyRequest\x1a\".todo.v1.AddTaskDependencyResponse\x12c\n" +
+	"\x14RemoveTaskDependency\x12$.todo.v1.RemoveTaskDependencyRequest\x1a%.todo.v1.RemoveTaskDependencyResponse\x12W\n" +
+	"\x10ListTaskBlockers\x12 .todo.v1.ListTaskBlockersRequest\x1a!.todo.v1.ListTaskBlockersResponse\x12]\n" +
+	"\x12ListTaskDependents\x12\".todo.v1.ListTaskDependentsRequest\x1a#.todo.v1.ListTaskDependentsResponse\x12<\n" +
 	"\aGetTask\x12\x17.todo.v1.GetTaskRequest\x1a\x18.todo.v1.GetTaskResponse\x12E\n" +
 	"\n" +
-	"UpdateTask\x12\x1a.todo.v1.UpdateTaskRequest\x1a\x1b.todo.v1.UpdateTaskResponse\x12E\n" +
+	"UpdateTask\x12\x1a.todo.v1.UpdateTaskRequest\x1a\x1b.todo.v1.UpdateTaskResponse\x12N\n" +
+	"\rGetTaskSeries\x12\x1d.todo.v1.GetTaskSeriesRequest\x1a\x1e.todo.v1.GetTaskSeriesResponse\x12W\n" +
+	"\x10UpdateTaskSeries\x12 .todo.v1.UpdateTaskSeriesRequest\x1a!.todo.v1.UpdateTaskSeriesResponse\x12E\n" +
+	"\n" +
+	"DeleteTask\x12\x1a.todo.v1.DeleteTaskRequest\x1a\x1b.todo.v1.DeleteTaskResponse\x12H\n" +
+	"\vArchiveTask\x12\x1b.todo.v1.ArchiveTaskRequest\x1a\x1c.todo.v1.ArchiveTaskResponse\x12N\n" +
+	"\rUnarchiveTask\x12\x1d.todo.v1.UnarchiveTaskRequest\x1a\x1e.todo.v1.UnarchiveTaskResponse\x12W\n" +
+	"\x10ListDeletedTasks\x12 .todo.v1.ListDeletedTasksRequest\x1a!.todo.v1.ListDeletedTasksResponse\x12H\n" +
+	"\vRestoreTask\x12\x1b.todo.v1.RestoreTaskRequest\x1a\x1c.todo.v1.RestoreTaskResponse\x12B\n" +
+	"\tPurgeTask\x12\x19.todo.v1.PurgeTaskRequest\x1a\x1a.todo.v1.PurgeTaskResponse\x12B\n" +
+	"\tWatchTask\x12\x19.todo.v1.WatchTaskRequest\x1a\x1a.todo.v1.WatchTaskResponse\x12H\n" +
+	"\vUnwatchTask\x12\x1b.todo.v1.UnwatchTaskRequest\x1a\x1c.todo.v1.UnwatchTaskResponse\x12W\n" +
+	"\x10ListTaskWatchers\x12 .todo.v1.ListTaskWatchersRequest\x1a!.todo.v1.ListTaskWatchersResponse\x12T\n" +
+	"\x0fListTaskHistory\x12\x1f.todo.v1.ListTaskHistoryRequest\x1a .todo.v1.ListTaskHistoryResponse\x12B\n" +
+	"\tShareTask\x12\x19.todo.v1.ShareTaskRequest\x1a\x1a.todo.v1.ShareTaskResponse\x12H\n" +
+	"\vUnshareTask\x12\x1b.todo.v1.UnshareTaskRequest\x1a\x1c.todo.v1.UnshareTaskResponse\x12Q\n" +
+	"\x0eListTaskShares\x12\x1e.todo.v1.ListTaskSharesRequest\x1a\x1f.todo.v1.ListTaskSharesResponse2\xb3\x02\n" +
+	"\fLabelService\x12H\n" +
+	"\vCreateLabel\x12\x1b.todo.v1.CreateLabelRequest\x1a\x1c.todo.v1.CreateLabelResponse\x12E\n" +
+	"\n" +
+	"ListLabels\x12\x1a.todo.v1.ListLabelsRequest\x1a\x1b.todo.v1.ListLabelsResponse\x12H\n" +
+	"\vUpdateLabel\x12\x1b.todo.v1.UpdateLabelRequest\x1a\x1c.todo.v1.UpdateLabelResponse\x12H\n" +
+	"\vDeleteLabel\x12\x1b.todo.v1.DeleteLabelRequest\x1a\x1c.todo.v1.DeleteLabelResponse2\xc7\x02\n" +
+	"\x0eCommentService\x12N\n" +
+	"\rCreateComment\x12\x1d.todo.v1.CreateCommentRequest\x1a\x1e.todo.v1.CreateCommentResponse\x12K\n" +
+	"\fListComments\x12\x1c.todo.v1.ListCommentsRequest\x1a\x1d.todo.v1.ListCommentsResponse\x12H\n" +
+	"\vEditComment\x12\x1b.todo.v1.EditCommentRequest\x1a\x1c.todo.v1.EditCommentResponse\x12N\n" +
+	"\rDeleteComment\x12\x1d.todo.v1.DeleteCommentRequest\x1a\x1e.todo.v1.DeleteCommentResponse2\xac\x02\n" +
+	"\x13NotificationService\x12Z\n" +
+	"\x11ListNotifications\x12!.todo.v1.ListNotificationsRequest\x1a\".todo.v1.ListNotificationsResponse\x12f\n" +
+	"\x15MarkNotificationsRead\x12%.todo.v1.MarkNotificationsReadRequest\x1a&.todo.v1.MarkNotificationsReadResponse\x12Q\n" +
+	"\x0eGetUnreadCount\x12\x1e.todo.v1.GetUnreadCountRequest\x1a\x1f.todo.v1.GetUnreadCountResponse2\xdc\x02\n" +
+	"\x0eCompanyService\x12E\n" +
+	"\n" +
+	"GetCompany\x12\x1a.todo.v1.GetCompanyRequest\x1a\x1b.todo.v1.GetCompanyResponse\x12f\n" +
+	"\x15UpdateCompanySettings\x12%.todo.v1.UpdateCompanySettingsRequest\x1a&.todo.v1.UpdateCompanySettingsResponse\x12H\n" +
+	"\vGetWorkflow\x12\x1b.todo.v1.GetWorkflowRequest\x1a\x1c.todo.v1.GetWorkflowResponse\x12Q\n" +
+	"\x0eUpdateWorkflow\x12\x1e.todo.v1.UpdateWorkflowRequest\x1a\x1f.todo.v1.UpdateWorkflowResponse2\x96\x04\n" +
+	"\vUserService\x12B\n" +
+	"\tListUsers\x12\x19.todo.v1.ListUsersRequest\x1a\x1a.todo.v1.ListUsersResponse\x12<\n" +
+	"\aGetUser\x12\x17.todo.v1.GetUserRequest\x1a\x18.todo.v1.GetUserResponse\x12E\n" +
+	"\n" +
+	"InviteUser\x12\x1a.todo.v1.InviteUserRequest\x1a\x1b.todo.v1.InviteUserResponse\x12K\n" +
+	"\fAcceptInvite\x12\x1c.todo.v1.AcceptInviteRequest\x1a\x1d.todo.v1.AcceptInviteResponse\x12Q\n" +
+	"\x0eUpdateUserRole\x12\x1e.todo.v1.UpdateUserRoleRequest\x1a\x1f.todo.v1.UpdateUserRoleResponse\x12Q\n" +
+	"\x0eDeactivateUser\x12\x1e.todo.v1.DeactivateUserRequest\x1a\x1f.todo.v1.DeactivateUserResponse\x12K\n" +
+	"\fOffboardUser\x12\x1c.todo.v1.OffboardUserRequest\x1a\x1d.todo.v1.OffboardUserResponse2\xa5\x04\n" +
+	"\vTeamService\x12E\n" +
+	"\n" +
+	"CreateTeam\x12\x1a.todo.v1.CreateTeamRequest\x1a\x1b.todo.v1.CreateTeamResponse\x12B\n" +
+	"\tListTeams\x12\x19.todo.v1.ListTeamsRequest\x1a\x1a.todo.v1.ListTeamsResponse\x12E\n" +
+	"\n" +
+	"UpdateTeam\x12\x1a.todo.v1.UpdateTeamRequest\x1a\x1b.todo.v1.UpdateTeamResponse\x12E\n" +
 	"\n" +
-	"DeleteTask\x12\x1a.todo.v1.DeleteTaskRequest\x1a\x1b.todo.v1.DeleteTaskResponseB\x8b\x01\n" +
-	"\vcom.todo.v1B\fServiceProtoP\x01Z1github.com/pyshx/todoapp/gen/todo/v1;todov1\xa2\x02\x03TXX\xaa\x02\aTodo.V1\xca\x02\aTodo\\V1\xe2\x02\x13Todo\\V1\\GPBMetadata\xea\x02\bTodo::V1b\x06proto3"
+	"DeleteTeam\x12\x1a.todo.v1.DeleteTeamRequest\x1a\x1b.todo.v1.DeleteTeamResponse\x12N\n" +
+	"\rAddTeamMember\x12\x1d.todo.v1.AddTeamMemberRequest\x1a\x1e.todo.v1.AddTeamMemberResponse\x12W\n" +
+	"\x10RemoveTeamMember\x12 .todo.v1.RemoveTeamMemberRequest\x1a!.todo.v1.RemoveTeamMemberResponse\x12T\n" +
+	"\x0fListTeamMembers\x12\x1f.todo.v1.ListTeamMembersRequest\x1a .todo.v1.ListTeamMembersResponse2\xaa\x03\n" +
+	"\fAdminService\x12N\n" +
+	"\rCreateCompany\x12\x1d.todo.v1.CreateCompanyRequest\x1a\x1e.todo.v1.CreateCompanyResponse\x12N\n" +
+	"\rListCompanies\x12\x1d.todo.v1.ListCompaniesRequest\x1a\x1e.todo.v1.ListCompaniesResponse\x12N\n" +
+	"\rRenameCompany\x12\x1d.todo.v1.RenameCompanyRequest\x1a\x1e.todo.v1.RenameCompanyResponse\x12Q\n" +
+	"\x0eSuspendCompany\x12\x1e.todo.v1.SuspendCompanyRequest\x1a\x1f.todo.v1.SuspendCompanyResponse\x12W\n" +
+	"\x10UnsuspendCompany\x12 .todo.v1.UnsuspendCompanyRequest\x1a!.todo.v1.UnsuspendCompanyResponseB\x85\x01\n" +
+	"\vcom.todo.v1B\fServiceProtoP\x01Z+github.com/pyshx/todoapp/gen/todo/v1;todov1\xa2\x02\x03TXX\xaa\x02\aTodo.V1\xca\x02\aTodo\\V1\xe2\x02\x13Todo\\V1\\GPBMetadata\xea\x02\bTodo::V1b\x06proto3"
 
 var (
 	file_todo_v1_service_proto_rawDescOnce sync.Once
@@ -1029,59 +9375,419 @@ func file_todo_v1_service_proto_rawDescGZIP() []byte {
 	return file_todo_v1_service_proto_rawDescData
 }
 
-var file_todo_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_todo_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_todo_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_todo_v1_service_proto_msgTypes = make([]protoimpl.MessageInfo, 143)
 var file_todo_v1_service_proto_goTypes = []any{
-	(Visibility)(0),                  // 0: todo.v1.Visibility
-	(TaskStatus)(0),                  // 1: todo.v1.TaskStatus
-	(*Task)(nil),                     // 2: todo.v1.Task
-	(*CreateTaskRequest)(nil),        // 3: todo.v1.CreateTaskRequest
-	(*CreateTaskResponse)(nil),       // 4: todo.v1.CreateTaskResponse
-	(*ListCompanyTasksRequest)(nil),  // 5: todo.v1.ListCompanyTasksRequest
-	(*ListCompanyTasksResponse)(nil), // 6: todo.v1.ListCompanyTasksResponse
-	(*ListMyTasksRequest)(nil),       // 7: todo.v1.ListMyTasksRequest
-	(*ListMyTasksResponse)(nil),      // 8: todo.v1.ListMyTasksResponse
-	(*GetTaskRequest)(nil),           // 9: todo.v1.GetTaskRequest
-	(*GetTaskResponse)(nil),          // 10: todo.v1.GetTaskResponse
-	(*UpdateTaskRequest)(nil),        // 11: todo.v1.UpdateTaskRequest
-	(*UpdateTaskResponse)(nil),       // 12: todo.v1.UpdateTaskResponse
-	(*DeleteTaskRequest)(nil),        // 13: todo.v1.DeleteTaskRequest
-	(*DeleteTaskResponse)(nil),       // 14: todo.v1.DeleteTaskResponse
-	(*timestamppb.Timestamp)(nil),    // 15: google.protobuf.Timestamp
+	(Visibility)(0),                       // 0: todo.v1.Visibility
+	(TaskStatus)(0),                       // 1: todo.v1.TaskStatus
+	(StatusCategory)(0),                   // 2: todo.v1.StatusCategory
+	(TaskPriority)(0),                     // 3: todo.v1.TaskPriority
+	(TaskSortField)(0),                    // 4: todo.v1.TaskSortField
+	(SortDirection)(0),                    // 5: todo.v1.SortDirection
+	(TaskHistoryAction)(0),                // 6: todo.v1.TaskHistoryAction
+	(ShareRole)(0),                        // 7: todo.v1.ShareRole
+	(NotificationKind)(0),                 // 8: todo.v1.NotificationKind
+	(UserRole)(0),                         // 9: todo.v1.UserRole
+	(OnlyMeHandover)(0),                   // 10: todo.v1.OnlyMeHandover
+	(*Task)(nil),                          // 11: todo.v1.Task
+	(*SubtaskProgress)(nil),               // 12: todo.v1.SubtaskProgress
+	(*CreateTaskRequest)(nil),             // 13: todo.v1.CreateTaskRequest
+	(*Recurrence)(nil),                    // 14: todo.v1.Recurrence
+	(*CreateTaskResponse)(nil),            // 15: todo.v1.CreateTaskResponse
+	(*TaskFilter)(nil),                    // 16: todo.v1.TaskFilter
+	(*TaskSort)(nil),                      // 17: todo.v1.TaskSort
+	(*ListCompanyTasksRequest)(nil),       // 18: todo.v1.ListCompanyTasksRequest
+	(*ListCompanyTasksResponse)(nil),      // 19: todo.v1.ListCompanyTasksResponse
+	(*ListMyTasksRequest)(nil),            // 20: todo.v1.ListMyTasksRequest
+	(*ListMyTasksResponse)(nil),           // 21: todo.v1.ListMyTasksResponse
+	(*ListSubtasksRequest)(nil),           // 22: todo.v1.ListSubtasksRequest
+	(*ListSubtasksResponse)(nil),          // 23: todo.v1.ListSubtasksResponse
+	(*GetTaskTreeRequest)(nil),            // 24: todo.v1.GetTaskTreeRequest
+	(*GetTaskTreeResponse)(nil),           // 25: todo.v1.GetTaskTreeResponse
+	(*AddTaskDependencyRequest)(nil),      // 26: todo.v1.AddTaskDependencyRequest
+	(*AddTaskDependencyResponse)(nil),     // 27: todo.v1.AddTaskDependencyResponse
+	(*RemoveTaskDependencyRequest)(nil),   // 28: todo.v1.RemoveTaskDependencyRequest
+	(*RemoveTaskDependencyResponse)(nil),  // 29: todo.v1.RemoveTaskDependencyResponse
+	(*ListTaskBlockersRequest)(nil),       // 30: todo.v1.ListTaskBlockersRequest
+	(*ListTaskBlockersResponse)(nil),      // 31: todo.v1.ListTaskBlockersResponse
+	(*ListTaskDependentsRequest)(nil),     // 32: todo.v1.ListTaskDependentsRequest
+	(*ListTaskDependentsResponse)(nil),    // 33: todo.v1.ListTaskDependentsResponse
+	(*TaskFieldChange)(nil),               // 34: todo.v1.TaskFieldChange
+	(*TaskHistoryEntry)(nil),              // 35: todo.v1.TaskHistoryEntry
+	(*ListTaskHistoryRequest)(nil),        // 36: todo.v1.ListTaskHistoryRequest
+	(*ListTaskHistoryResponse)(nil),       // 37: todo.v1.ListTaskHistoryResponse
+	(*TaskWatcher)(nil),                   // 38: todo.v1.TaskWatcher
+	(*WatchTaskRequest)(nil),              // 39: todo.v1.WatchTaskRequest
+	(*WatchTaskResponse)(nil),             // 40: todo.v1.WatchTaskResponse
+	(*UnwatchTaskRequest)(nil),            // 41: todo.v1.UnwatchTaskRequest
+	(*UnwatchTaskResponse)(nil),           // 42: todo.v1.UnwatchTaskResponse
+	(*ListTaskWatchersRequest)(nil),       // 43: todo.v1.ListTaskWatchersRequest
+	(*ListTaskWatchersResponse)(nil),      // 44: todo.v1.ListTaskWatchersResponse
+	(*TaskShare)(nil),                     // 45: todo.v1.TaskShare
+	(*ShareTaskRequest)(nil),              // 46: todo.v1.ShareTaskRequest
+	(*ShareTaskResponse)(nil),             // 47: todo.v1.ShareTaskResponse
+	(*UnshareTaskRequest)(nil),            // 48: todo.v1.UnshareTaskRequest
+	(*UnshareTaskResponse)(nil),           // 49: todo.v1.UnshareTaskResponse
+	(*ListTaskSharesRequest)(nil),         // 50: todo.v1.ListTaskSharesRequest
+	(*ListTaskSharesResponse)(nil),        // 51: todo.v1.ListTaskSharesResponse
+	(*SearchTasksRequest)(nil),            // 52: todo.v1.SearchTasksRequest
+	(*TaskSearchResult)(nil),              // 53: todo.v1.TaskSearchResult
+	(*SearchTasksResponse)(nil),           // 54: todo.v1.SearchTasksResponse
+	(*GetTaskRequest)(nil),                // 55: todo.v1.GetTaskRequest
+	(*GetTaskResponse)(nil),               // 56: todo.v1.GetTaskResponse
+	(*UpdateTaskRequest)(nil),             // 57: todo.v1.UpdateTaskRequest
+	(*UpdateTaskResponse)(nil),            // 58: todo.v1.UpdateTaskResponse
+	(*TaskSeries)(nil),                    // 59: todo.v1.TaskSeries
+	(*GetTaskSeriesRequest)(nil),          // 60: todo.v1.GetTaskSeriesRequest
+	(*GetTaskSeriesResponse)(nil),         // 61: todo.v1.GetTaskSeriesResponse
+	(*UpdateTaskSeriesRequest)(nil),       // 62: todo.v1.UpdateTaskSeriesRequest
+	(*UpdateTaskSeriesResponse)(nil),      // 63: todo.v1.UpdateTaskSeriesResponse
+	(*DeleteTaskRequest)(nil),             // 64: todo.v1.DeleteTaskRequest
+	(*DeleteTaskResponse)(nil),            // 65: todo.v1.DeleteTaskResponse
+	(*ArchiveTaskRequest)(nil),            // 66: todo.v1.ArchiveTaskRequest
+	(*ArchiveTaskResponse)(nil),           // 67: todo.v1.ArchiveTaskResponse
+	(*UnarchiveTaskRequest)(nil),          // 68: todo.v1.UnarchiveTaskRequest
+	(*UnarchiveTaskResponse)(nil),         // 69: todo.v1.UnarchiveTaskResponse
+	(*ListDeletedTasksRequest)(nil),       // 70: todo.v1.ListDeletedTasksRequest
+	(*ListDeletedTasksResponse)(nil),      // 71: todo.v1.ListDeletedTasksResponse
+	(*RestoreTaskRequest)(nil),            // 72: todo.v1.RestoreTaskRequest
+	(*RestoreTaskResponse)(nil),           // 73: todo.v1.RestoreTaskResponse
+	(*PurgeTaskRequest)(nil),              // 74: todo.v1.PurgeTaskRequest
+	(*PurgeTaskResponse)(nil),             // 75: todo.v1.PurgeTaskResponse
+	(*Label)(nil),                         // 76: todo.v1.Label
+	(*CreateLabelRequest)(nil),            // 77: todo.v1.CreateLabelRequest
+	(*CreateLabelResponse)(nil),           // 78: todo.v1.CreateLabelResponse
+	(*ListLabelsRequest)(nil),             // 79: todo.v1.ListLabelsRequest
+	(*ListLabelsResponse)(nil),            // 80: todo.v1.ListLabelsResponse
+	(*UpdateLabelRequest)(nil),            // 81: todo.v1.UpdateLabelRequest
+	(*UpdateLabelResponse)(nil),           // 82: todo.v1.UpdateLabelResponse
+	(*DeleteLabelRequest)(nil),            // 83: todo.v1.DeleteLabelRequest
+	(*DeleteLabelResponse)(nil),           // 84: todo.v1.DeleteLabelResponse
+	(*Comment)(nil),                       // 85: todo.v1.Comment
+	(*CreateCommentRequest)(nil),          // 86: todo.v1.CreateCommentRequest
+	(*CreateCommentResponse)(nil),         // 87: todo.v1.CreateCommentResponse
+	(*ListCommentsRequest)(nil),           // 88: todo.v1.ListCommentsRequest
+	(*ListCommentsResponse)(nil),          // 89: todo.v1.ListCommentsResponse
+	(*EditCommentRequest)(nil),            // 90: todo.v1.EditCommentRequest
+	(*EditCommentResponse)(nil),           // 91: todo.v1.EditCommentResponse
+	(*DeleteCommentRequest)(nil),          // 92: todo.v1.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),         // 93: todo.v1.DeleteCommentResponse
+	(*Notification)(nil),                  // 94: todo.v1.Notification
+	(*ListNotificationsRequest)(nil),      // 95: todo.v1.ListNotificationsRequest
+	(*ListNotificationsResponse)(nil),     // 96: todo.v1.ListNotificationsResponse
+	(*MarkNotificationsReadRequest)(nil),  // 97: todo.v1.MarkNotificationsReadRequest
+	(*MarkNotificationsReadResponse)(nil), // 98: todo.v1.MarkNotificationsReadResponse
+	(*GetUnreadCountRequest)(nil),         // 99: todo.v1.GetUnreadCountRequest
+	(*GetUnreadCountResponse)(nil),        // 100: todo.v1.GetUnreadCountResponse
+	(*Company)(nil),                       // 101: todo.v1.Company
+	(*GetCompanyRequest)(nil),             // 102: todo.v1.GetCompanyRequest
+	(*GetCompanyResponse)(nil),            // 103: todo.v1.GetCompanyResponse
+	(*UpdateCompanySettingsRequest)(nil),  // 104: todo.v1.UpdateCompanySettingsRequest
+	(*UpdateCompanySettingsResponse)(nil), // 105: todo.v1.UpdateCompanySettingsResponse
+	(*WorkflowStatus)(nil),                // 106: todo.v1.WorkflowStatus
+	(*WorkflowTransition)(nil),            // 107: todo.v1.WorkflowTransition
+	(*Workflow)(nil),                      // 108: todo.v1.Workflow
+	(*GetWorkflowRequest)(nil),            // 109: todo.v1.GetWorkflowRequest
+	(*GetWorkflowResponse)(nil),           // 110: todo.v1.GetWorkflowResponse
+	(*UpdateWorkflowRequest)(nil),         // 111: todo.v1.UpdateWorkflowRequest
+	(*UpdateWorkflowResponse)(nil),        // 112: todo.v1.UpdateWorkflowResponse
+	(*User)(nil),                          // 113: todo.v1.User
+	(*Invite)(nil),                        // 114: todo.v1.Invite
+	(*ListUsersRequest)(nil),              // 115: todo.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),             // 116: todo.v1.ListUsersResponse
+	(*GetUserRequest)(nil),                // 117: todo.v1.GetUserRequest
+	(*GetUserResponse)(nil),               // 118: todo.v1.GetUserResponse
+	(*InviteUserRequest)(nil),             // 119: todo.v1.InviteUserRequest
+	(*InviteUserResponse)(nil),            // 120: todo.v1.InviteUserResponse
+	(*AcceptInviteRequest)(nil),           // 121: todo.v1.AcceptInviteRequest
+	(*AcceptInviteResponse)(nil),          // 122: todo.v1.AcceptInviteResponse
+	(*UpdateUserRoleRequest)(nil),         // 123: todo.v1.UpdateUserRoleRequest
+	(*UpdateUserRoleResponse)(nil),        // 124: todo.v1.UpdateUserRoleResponse
+	(*DeactivateUserRequest)(nil),         // 125: todo.v1.DeactivateUserRequest
+	(*DeactivateUserResponse)(nil),        // 126: todo.v1.DeactivateUserResponse
+	(*OffboardUserRequest)(nil),           // 127: todo.v1.OffboardUserRequest
+	(*OffboardUserResponse)(nil),          // 128: todo.v1.OffboardUserResponse
+	(*CreateCompanyRequest)(nil),          // 129: todo.v1.CreateCompanyRequest
+	(*CreateCompanyResponse)(nil),         // 130: todo.v1.CreateCompanyResponse
+	(*ListCompaniesRequest)(nil),          // 131: todo.v1.ListCompaniesRequest
+	(*ListCompaniesResponse)(nil),         // 132: todo.v1.ListCompaniesResponse
+	(*RenameCompanyRequest)(nil),          // 133: todo.v1.RenameCompanyRequest
+	(*RenameCompanyResponse)(nil),         // 134: todo.v1.RenameCompanyResponse
+	(*SuspendCompanyRequest)(nil),         // 135: todo.v1.SuspendCompanyRequest
+	(*SuspendCompanyResponse)(nil),        // 136: todo.v1.SuspendCompanyResponse
+	(*UnsuspendCompanyRequest)(nil),       // 137: todo.v1.UnsuspendCompanyRequest
+	(*UnsuspendCompanyResponse)(nil),      // 138: todo.v1.UnsuspendCompanyResponse
+	(*Team)(nil),                          // 139: todo.v1.Team
+	(*CreateTeamRequest)(nil),             // 140: todo.v1.CreateTeamRequest
+	(*CreateTeamResponse)(nil),            // 141: todo.v1.CreateTeamResponse
+	(*ListTeamsRequest)(nil),              // 142: todo.v1.ListTeamsRequest
+	(*ListTeamsResponse)(nil),             // 143: todo.v1.ListTeamsResponse
+	(*UpdateTeamRequest)(nil),             // 144: todo.v1.UpdateTeamRequest
+	(*UpdateTeamResponse)(nil),            // 145: todo.v1.UpdateTeamResponse
+	(*DeleteTeamRequest)(nil),             // 146: todo.v1.DeleteTeamRequest
+	(*DeleteTeamResponse)(nil),            // 147: todo.v1.DeleteTeamResponse
+	(*AddTeamMemberRequest)(nil),          // 148: todo.v1.AddTeamMemberRequest
+	(*AddTeamMemberResponse)(nil),         // 149: todo.v1.AddTeamMemberResponse
+	(*RemoveTeamMemberRequest)(nil),       // 150: todo.v1.RemoveTeamMemberRequest
+	(*RemoveTeamMemberResponse)(nil),      // 151: todo.v1.RemoveTeamMemberResponse
+	(*ListTeamMembersRequest)(nil),        // 152: todo.v1.ListTeamMembersRequest
+	(*ListTeamMembersResponse)(nil),       // 153: todo.v1.ListTeamMembersResponse
+	(*timestamppb.Timestamp)(nil),         // 154: google.protobuf.Timestamp
 }
 var file_todo_v1_service_proto_depIdxs = []int32{
-	15, // 0: todo.v1.Task.due_date:type_name -> google.protobuf.Timestamp
-	0,  // 1: todo.v1.Task.visibility:type_name -> todo.v1.Visibility
-	1,  // 2: todo.v1.Task.status:type_name -> todo.v1.TaskStatus
-	15, // 3: todo.v1.Task.created_at:type_name -> google.protobuf.Timestamp
-	15, // 4: todo.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
-	15, // 5: todo.v1.CreateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
-	0,  // 6: todo.v1.CreateTaskRequest.visibility:type_name -> todo.v1.Visibility
-	2,  // 7: todo.v1.CreateTaskResponse.task:type_name -> todo.v1.Task
-	2,  // 8: todo.v1.ListCompanyTasksResponse.tasks:type_name -> todo.v1.Task
-	2,  // 9: todo.v1.ListMyTasksResponse.tasks:type_name -> todo.v1.Task
-	2,  // 10: todo.v1.GetTaskResponse.task:type_name -> todo.v1.Task
-	15, // 11: todo.v1.UpdateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
-	0,  // 12: todo.v1.UpdateTaskRequest.visibility:type_name -> todo.v1.Visibility
-	1,  // 13: todo.v1.UpdateTaskRequest.status:type_name -> todo.v1.TaskStatus
-	2,  // 14: todo.v1.UpdateTaskResponse.task:type_name -> todo.v1.Task
-	3,  // 15: todo.v1.TodoService.CreateTask:input_type -> todo.v1.CreateTaskRequest
-	5,  // 16: todo.v1.TodoService.ListCompanyTasks:input_type -> todo.v1.ListCompanyTasksRequest
-	7,  // 17: todo.v1.TodoService.ListMyTasks:input_type -> todo.v1.ListMyTasksRequest
-	9,  // 18: todo.v1.TodoService.GetTask:input_type -> todo.v1.GetTaskRequest
-	11, // 19: todo.v1.TodoService.UpdateTask:input_type -> todo.v1.UpdateTaskRequest
-	13, // 20: todo.v1.TodoService.DeleteTask:input_type -> todo.v1.DeleteTaskRequest
-	4,  // 21: todo.v1.TodoService.CreateTask:output_type -> todo.v1.CreateTaskResponse
-	6,  // 22: todo.v1.TodoService.ListCompanyTasks:output_type -> todo.v1.ListCompanyTasksResponse
-	8,  // 23: todo.v1.TodoService.ListMyTasks:output_type -> todo.v1.ListMyTasksResponse
-	10, // 24: todo.v1.TodoService.GetTask:output_type -> todo.v1.GetTaskResponse
-	12, // 25: todo.v1.TodoService.UpdateTask:output_type -> todo.v1.UpdateTaskResponse
-	14, // 26: todo.v1.TodoService.DeleteTask:output_type -> todo.v1.DeleteTaskResponse
-	21, // [21:27] is the sub-list for method output_type
-	15, // [15:21] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	154, // 0: todo.v1.Task.due_date:type_name -> google.protobuf.Timestamp
+	0,   // 1: todo.v1.Task.visibility:type_name -> todo.v1.Visibility
+	1,   // 2: todo.v1.Task.status:type_name -> todo.v1.TaskStatus
+	154, // 3: todo.v1.Task.created_at:type_name -> google.protobuf.Timestamp
+	154, // 4: todo.v1.Task.updated_at:type_name -> google.protobuf.Timestamp
+	3,   // 5: todo.v1.Task.priority:type_name -> todo.v1.TaskPriority
+	12,  // 6: todo.v1.Task.subtask_progress:type_name -> todo.v1.SubtaskProgress
+	154, // 7: todo.v1.Task.deleted_at:type_name -> google.protobuf.Timestamp
+	154, // 8: todo.v1.Task.archived_at:type_name -> google.protobuf.Timestamp
+	154, // 9: todo.v1.Task.completed_at:type_name -> google.protobuf.Timestamp
+	2,   // 10: todo.v1.Task.status_category:type_name -> todo.v1.StatusCategory
+	154, // 11: todo.v1.CreateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
+	0,   // 12: todo.v1.CreateTaskRequest.visibility:type_name -> todo.v1.Visibility
+	3,   // 13: todo.v1.CreateTaskRequest.priority:type_name -> todo.v1.TaskPriority
+	14,  // 14: todo.v1.CreateTaskRequest.recurrence:type_name -> todo.v1.Recurrence
+	11,  // 15: todo.v1.CreateTaskResponse.task:type_name -> todo.v1.Task
+	1,   // 16: todo.v1.TaskFilter.statuses:type_name -> todo.v1.TaskStatus
+	0,   // 17: todo.v1.TaskFilter.visibility:type_name -> todo.v1.Visibility
+	154, // 18: todo.v1.TaskFilter.due_after:type_name -> google.protobuf.Timestamp
+	154, // 19: todo.v1.TaskFilter.due_before:type_name -> google.protobuf.Timestamp
+	154, // 20: todo.v1.TaskFilter.created_after:type_name -> google.protobuf.Timestamp
+	154, // 21: todo.v1.TaskFilter.created_before:type_name -> google.protobuf.Timestamp
+	154, // 22: todo.v1.TaskFilter.updated_after:type_name -> google.protobuf.Timestamp
+	154, // 23: todo.v1.TaskFilter.updated_before:type_name -> google.protobuf.Timestamp
+	3,   // 24: todo.v1.TaskFilter.priorities:type_name -> todo.v1.TaskPriority
+	4,   // 25: todo.v1.TaskSort.field:type_name -> todo.v1.TaskSortField
+	5,   // 26: todo.v1.TaskSort.direction:type_name -> todo.v1.SortDirection
+	16,  // 27: todo.v1.ListCompanyTasksRequest.filter:type_name -> todo.v1.TaskFilter
+	17,  // 28: todo.v1.ListCompanyTasksRequest.sort:type_name -> todo.v1.TaskSort
+	11,  // 29: todo.v1.ListCompanyTasksResponse.tasks:type_name -> todo.v1.Task
+	16,  // 30: todo.v1.ListMyTasksRequest.filter:type_name -> todo.v1.TaskFilter
+	17,  // 31: todo.v1.ListMyTasksRequest.sort:type_name -> todo.v1.TaskSort
+	11,  // 32: todo.v1.ListMyTasksResponse.tasks:type_name -> todo.v1.Task
+	17,  // 33: todo.v1.ListSubtasksRequest.sort:type_name -> todo.v1.TaskSort
+	11,  // 34: todo.v1.ListSubtasksResponse.tasks:type_name -> todo.v1.Task
+	11,  // 35: todo.v1.GetTaskTreeResponse.root:type_name -> todo.v1.Task
+	11,  // 36: todo.v1.GetTaskTreeResponse.descendants:type_name -> todo.v1.Task
+	11,  // 37: todo.v1.ListTaskBlockersResponse.tasks:type_name -> todo.v1.Task
+	11,  // 38: todo.v1.ListTaskDependentsResponse.tasks:type_name -> todo.v1.Task
+	6,   // 39: todo.v1.TaskHistoryEntry.action:type_name -> todo.v1.TaskHistoryAction
+	34,  // 40: todo.v1.TaskHistoryEntry.changes:type_name -> todo.v1.TaskFieldChange
+	154, // 41: todo.v1.TaskHistoryEntry.created_at:type_name -> google.protobuf.Timestamp
+	35,  // 42: todo.v1.ListTaskHistoryResponse.entries:type_name -> todo.v1.TaskHistoryEntry
+	38,  // 43: todo.v1.ListTaskWatchersResponse.watchers:type_name -> todo.v1.TaskWatcher
+	7,   // 44: todo.v1.TaskShare.role:type_name -> todo.v1.ShareRole
+	7,   // 45: todo.v1.ShareTaskRequest.role:type_name -> todo.v1.ShareRole
+	45,  // 46: todo.v1.ShareTaskResponse.share:type_name -> todo.v1.TaskShare
+	45,  // 47: todo.v1.ListTaskSharesResponse.shares:type_name -> todo.v1.TaskShare
+	11,  // 48: todo.v1.TaskSearchResult.task:type_name -> todo.v1.Task
+	53,  // 49: todo.v1.SearchTasksResponse.results:type_name -> todo.v1.TaskSearchResult
+	11,  // 50: todo.v1.GetTaskResponse.task:type_name -> todo.v1.Task
+	154, // 51: todo.v1.UpdateTaskRequest.due_date:type_name -> google.protobuf.Timestamp
+	0,   // 52: todo.v1.UpdateTaskRequest.visibility:type_name -> todo.v1.Visibility
+	1,   // 53: todo.v1.UpdateTaskRequest.status:type_name -> todo.v1.TaskStatus
+	3,   // 54: todo.v1.UpdateTaskRequest.priority:type_name -> todo.v1.TaskPriority
+	11,  // 55: todo.v1.UpdateTaskResponse.task:type_name -> todo.v1.Task
+	14,  // 56: todo.v1.TaskSeries.recurrence:type_name -> todo.v1.Recurrence
+	154, // 57: todo.v1.TaskSeries.starts_at:type_name -> google.protobuf.Timestamp
+	154, // 58: todo.v1.TaskSeries.last_occurrence_at:type_name -> google.protobuf.Timestamp
+	154, // 59: todo.v1.TaskSeries.next_occurrence_at:type_name -> google.protobuf.Timestamp
+	0,   // 60: todo.v1.TaskSeries.visibility:type_name -> todo.v1.Visibility
+	3,   // 61: todo.v1.TaskSeries.priority:type_name -> todo.v1.TaskPriority
+	154, // 62: todo.v1.TaskSeries.created_at:type_name -> google.protobuf.Timestamp
+	154, // 63: todo.v1.TaskSeries.updated_at:type_name -> google.protobuf.Timestamp
+	59,  // 64: todo.v1.GetTaskSeriesResponse.series:type_name -> todo.v1.TaskSeries
+	0,   // 65: todo.v1.UpdateTaskSeriesRequest.visibility:type_name -> todo.v1.Visibility
+	3,   // 66: todo.v1.UpdateTaskSeriesRequest.priority:type_name -> todo.v1.TaskPriority
+	14,  // 67: todo.v1.UpdateTaskSeriesRequest.recurrence:type_name -> todo.v1.Recurrence
+	59,  // 68: todo.v1.UpdateTaskSeriesResponse.series:type_name -> todo.v1.TaskSeries
+	11,  // 69: todo.v1.UpdateTaskSeriesResponse.instances:type_name -> todo.v1.Task
+	11,  // 70: todo.v1.ArchiveTaskResponse.task:type_name -> todo.v1.Task
+	11,  // 71: todo.v1.UnarchiveTaskResponse.task:type_name -> todo.v1.Task
+	17,  // 72: todo.v1.ListDeletedTasksRequest.sort:type_name -> todo.v1.TaskSort
+	11,  // 73: todo.v1.ListDeletedTasksResponse.tasks:type_name -> todo.v1.Task
+	11,  // 74: todo.v1.RestoreTaskResponse.task:type_name -> todo.v1.Task
+	154, // 75: todo.v1.Label.created_at:type_name -> google.protobuf.Timestamp
+	76,  // 76: todo.v1.CreateLabelResponse.label:type_name -> todo.v1.Label
+	76,  // 77: todo.v1.ListLabelsResponse.labels:type_name -> todo.v1.Label
+	76,  // 78: todo.v1.UpdateLabelResponse.label:type_name -> todo.v1.Label
+	154, // 79: todo.v1.Comment.created_at:type_name -> google.protobuf.Timestamp
+	154, // 80: todo.v1.Comment.edited_at:type_name -> google.protobuf.Timestamp
+	85,  // 81: todo.v1.CreateCommentResponse.comment:type_name -> todo.v1.Comment
+	85,  // 82: todo.v1.ListCommentsResponse.comments:type_name -> todo.v1.Comment
+	85,  // 83: todo.v1.EditCommentResponse.comment:type_name -> todo.v1.Comment
+	8,   // 84: todo.v1.Notification.kind:type_name -> todo.v1.NotificationKind
+	154, // 85: todo.v1.Notification.due_date:type_name -> google.protobuf.Timestamp
+	154, // 86: todo.v1.Notification.created_at:type_name -> google.protobuf.Timestamp
+	154, // 87: todo.v1.Notification.read_at:type_name -> google.protobuf.Timestamp
+	94,  // 88: todo.v1.ListNotificationsResponse.notifications:type_name -> todo.v1.Notification
+	154, // 89: todo.v1.Company.created_at:type_name -> google.protobuf.Timestamp
+	154, // 90: todo.v1.Company.suspended_at:type_name -> google.protobuf.Timestamp
+	101, // 91: todo.v1.GetCompanyResponse.company:type_name -> todo.v1.Company
+	101, // 92: todo.v1.UpdateCompanySettingsResponse.company:type_name -> todo.v1.Company
+	2,   // 93: todo.v1.WorkflowStatus.category:type_name -> todo.v1.StatusCategory
+	106, // 94: todo.v1.Workflow.statuses:type_name -> todo.v1.WorkflowStatus
+	107, // 95: todo.v1.Workflow.transitions:type_name -> todo.v1.WorkflowTransition
+	108, // 96: todo.v1.GetWorkflowResponse.workflow:type_name -> todo.v1.Workflow
+	108, // 97: todo.v1.UpdateWorkflowRequest.workflow:type_name -> todo.v1.Workflow
+	108, // 98: todo.v1.UpdateWorkflowResponse.workflow:type_name -> todo.v1.Workflow
+	9,   // 99: todo.v1.User.role:type_name -> todo.v1.UserRole
+	154, // 100: todo.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	154, // 101: todo.v1.User.deactivated_at:type_name -> google.protobuf.Timestamp
+	9,   // 102: todo.v1.Invite.role:type_name -> todo.v1.UserRole
+	154, // 103: todo.v1.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	154, // 104: todo.v1.Invite.created_at:type_name -> google.protobuf.Timestamp
+	113, // 105: todo.v1.ListUsersResponse.users:type_name -> todo.v1.User
+	113, // 106: todo.v1.GetUserResponse.user:type_name -> todo.v1.User
+	9,   // 107: todo.v1.InviteUserRequest.role:type_name -> todo.v1.UserRole
+	114, // 108: todo.v1.InviteUserResponse.invite:type_name -> todo.v1.Invite
+	113, // 109: todo.v1.AcceptInviteResponse.user:type_name -> todo.v1.User
+	9,   // 110: todo.v1.UpdateUserRoleRequest.role:type_name -> todo.v1.UserRole
+	113, // 111: todo.v1.UpdateUserRoleResponse.user:type_name -> todo.v1.User
+	113, // 112: todo.v1.DeactivateUserResponse.user:type_name -> todo.v1.User
+	10,  // 113: todo.v1.OffboardUserRequest.only_me_tasks:type_name -> todo.v1.OnlyMeHandover
+	113, // 114: todo.v1.OffboardUserResponse.user:type_name -> todo.v1.User
+	101, // 115: todo.v1.CreateCompanyResponse.company:type_name -> todo.v1.Company
+	113, // 116: todo.v1.CreateCompanyResponse.owner:type_name -> todo.v1.User
+	101, // 117: todo.v1.ListCompaniesResponse.companies:type_name -> todo.v1.Company
+	101, // 118: todo.v1.RenameCompanyResponse.company:type_name -> todo.v1.Company
+	101, // 119: todo.v1.SuspendCompanyResponse.company:type_name -> todo.v1.Company
+	101, // 120: todo.v1.UnsuspendCompanyResponse.company:type_name -> todo.v1.Company
+	154, // 121: todo.v1.Team.created_at:type_name -> google.protobuf.Timestamp
+	139, // 122: todo.v1.CreateTeamResponse.team:type_name -> todo.v1.Team
+	139, // 123: todo.v1.ListTeamsResponse.teams:type_name -> todo.v1.Team
+	139, // 124: todo.v1.UpdateTeamResponse.team:type_name -> todo.v1.Team
+	113, // 125: todo.v1.ListTeamMembersResponse.users:type_name -> todo.v1.User
+	13,  // 126: todo.v1.TodoService.CreateTask:input_type -> todo.v1.CreateTaskRequest
+	18,  // 127: todo.v1.TodoService.ListCompanyTasks:input_type -> todo.v1.ListCompanyTasksRequest
+	20,  // 128: todo.v1.TodoService.ListMyTasks:input_type -> todo.v1.ListMyTasksRequest
+	52,  // 129: todo.v1.TodoService.SearchTasks:input_type -> todo.v1.SearchTasksRequest
+	22,  // 130: todo.v1.TodoService.ListSubtasks:input_type -> todo.v1.ListSubtasksRequest
+	24,  // 131: todo.v1.TodoService.GetTaskTree:input_type -> todo.v1.GetTaskTreeRequest
+	26,  // 132: todo.v1.TodoService.AddTaskDependency:input_type -> todo.v1.AddTaskDependencyRequest
+	28,  // 133: todo.v1.TodoService.RemoveTaskDependency:input_type -> todo.v1.RemoveTaskDependencyRequest
+	30,  // 134: todo.v1.TodoService.ListTaskBlockers:input_type -> todo.v1.ListTaskBlockersRequest
+	32,  // 135: todo.v1.TodoService.ListTaskDependents:input_type -> todo.v1.ListTaskDependentsRequest
+	55,  // 136: todo.v1.TodoService.GetTask:input_type -> todo.v1.GetTaskRequest
+	57,  // 137: todo.v1.TodoService.UpdateTask:input_type -> todo.v1.UpdateTaskRequest
+	60,  // 138: todo.v1.TodoService.GetTaskSeries:input_type -> todo.v1.GetTaskSeriesRequest
+	62,  // 139: todo.v1.TodoService.UpdateTaskSeries:input_type -> todo.v1.UpdateTaskSeriesRequest
+	64,  // 140: todo.v1.TodoService.DeleteTask:input_type -> todo.v1.DeleteTaskRequest
+	66,  // 141: todo.v1.TodoService.ArchiveTask:input_type -> todo.v1.ArchiveTaskRequest
+	68,  // 142: todo.v1.TodoService.UnarchiveTask:input_type -> todo.v1.UnarchiveTaskRequest
+	70,  // 143: todo.v1.TodoService.ListDeletedTasks:input_type -> todo.v1.ListDeletedTasksRequest
+	72,  // 144: todo.v1.TodoService.RestoreTask:input_type -> todo.v1.RestoreTaskRequest
+	74,  // 145: todo.v1.TodoService.PurgeTask:input_type -> todo.v1.PurgeTaskRequest
+	39,  // 146: todo.v1.TodoService.WatchTask:input_type -> todo.v1.WatchTaskRequest
+	41,  // 147: todo.v1.TodoService.UnwatchTask:input_type -> todo.v1.UnwatchTaskRequest
+	43,  // 148: todo.v1.TodoService.ListTaskWatchers:input_type -> todo.v1.ListTaskWatchersRequest
+	36,  // 149: todo.v1.TodoService.ListTaskHistory:input_type -> todo.v1.ListTaskHistoryRequest
+	46,  // 150: todo.v1.TodoService.ShareTask:input_type -> todo.v1.ShareTaskRequest
+	48,  // 151: todo.v1.TodoService.UnshareTask:input_type -> todo.v1.UnshareTaskRequest
+	50,  // 152: todo.v1.TodoService.ListTaskShares:input_type -> todo.v1.ListTaskSharesRequest
+	77,  // 153: todo.v1.LabelService.CreateLabel:input_type -> todo.v1.CreateLabelRequest
+	79,  // 154: todo.v1.LabelService.ListLabels:input_type -> todo.v1.ListLabelsRequest
+	81,  // 155: todo.v1.LabelService.UpdateLabel:input_type -> todo.v1.UpdateLabelRequest
+	83,  // 156: todo.v1.LabelService.DeleteLabel:input_type -> todo.v1.DeleteLabelRequest
+	86,  // 157: todo.v1.CommentService.CreateComment:input_type -> todo.v1.CreateCommentRequest
+	88,  // 158: todo.v1.CommentService.ListComments:input_type -> todo.v1.ListCommentsRequest
+	90,  // 159: todo.v1.CommentService.EditComment:input_type -> todo.v1.EditCommentRequest
+	92,  // 160: todo.v1.CommentService.DeleteComment:input_type -> todo.v1.DeleteCommentRequest
+	95,  // 161: todo.v1.NotificationService.ListNotifications:input_type -> todo.v1.ListNotificationsRequest
+	97,  // 162: todo.v1.NotificationService.MarkNotificationsRead:input_type -> todo.v1.MarkNotificationsReadRequest
+	99,  // 163: todo.v1.NotificationService.GetUnreadCount:input_type -> todo.v1.GetUnreadCountRequest
+	102, // 164: todo.v1.CompanyService.GetCompany:input_type -> todo.v1.GetCompanyRequest
+	104, // 165: todo.v1.CompanyService.UpdateCompanySettings:input_type -> todo.v1.UpdateCompanySettingsRequest
+	109, // 166: todo.v1.CompanyService.GetWorkflow:input_type -> todo.v1.GetWorkflowRequest
+	111, // 167: todo.v1.CompanyService.UpdateWorkflow:input_type -> todo.v1.UpdateWorkflowRequest
+	115, // 168: todo.v1.UserService.ListUsers:input_type -> todo.v1.ListUsersRequest
+	117, // 169: todo.v1.UserService.GetUser:input_type -> todo.v1.GetUserRequest
+	119, // 170: todo.v1.UserService.InviteUser:input_type -> todo.v1.InviteUserRequest
+	121, // 171: todo.v1.UserService.AcceptInvite:input_type -> todo.v1.AcceptInviteRequest
+	123, // 172: todo.v1.UserService.UpdateUserRole:input_type -> todo.v1.UpdateUserRoleRequest
+	125, // 173: todo.v1.UserService.DeactivateUser:input_type -> todo.v1.DeactivateUserRequest
+	127, // 174: todo.v1.UserService.OffboardUser:input_type -> todo.v1.OffboardUserRequest
+	140, // 175: todo.v1.TeamService.CreateTeam:input_type -> todo.v1.CreateTeamRequest
+	142, // 176: todo.v1.TeamService.ListTeams:input_type -> todo.v1.ListTeamsRequest
+	144, // 177: todo.v1.TeamService.UpdateTeam:input_type -> todo.v1.UpdateTeamRequest
+	146, // 178: todo.v1.TeamService.DeleteTeam:input_type -> todo.v1.DeleteTeamRequest
+	148, // 179: todo.v1.TeamService.AddTeamMember:input_type -> todo.v1.AddTeamMemberRequest
+	150, // 180: todo.v1.TeamService.RemoveTeamMember:input_type -> todo.v1.RemoveTeamMemberRequest
+	152, // 181: todo.v1.TeamService.ListTeamMembers:input_type -> todo.v1.ListTeamMembersRequest
+	129, // 182: todo.v1.AdminService.CreateCompany:input_type -> todo.v1.CreateCompanyRequest
+	131, // 183: todo.v1.AdminService.ListCompanies:input_type -> todo.v1.ListCompaniesRequest
+	133, // 184: todo.v1.AdminService.RenameCompany:input_type -> todo.v1.RenameCompanyRequest
+	135, // 185: todo.v1.AdminService.SuspendCompany:input_type -> todo.v1.SuspendCompanyRequest
+	137, // 186: todo.v1.AdminService.UnsuspendCompany:input_type -> todo.v1.UnsuspendCompanyRequest
+	15,  // 187: todo.v1.TodoService.CreateTask:output_type -> todo.v1.CreateTaskResponse
+	19,  // 188: todo.v1.TodoService.ListCompanyTasks:output_type -> todo.v1.ListCompanyTasksResponse
+	21,  // 189: todo.v1.TodoService.ListMyTasks:output_type -> todo.v1.ListMyTasksResponse
+	54,  // 190: todo.v1.TodoService.SearchTasks:output_type -> todo.v1.SearchTasksResponse
+	23,  // 191: todo.v1.TodoService.ListSubtasks:output_type -> todo.v1.ListSubtasksResponse
+	25,  // 192: todo.v1.TodoService.GetTaskTree:output_type -> todo.v1.GetTaskTreeResponse
+	27,  // 193: todo.v1.TodoService.AddTaskDependency:output_type -> todo.v1.AddTaskDependencyResponse
+	29,  // 194: todo.v1.TodoService.RemoveTaskDependency:output_type -> todo.v1.RemoveTaskDependencyResponse
+	31,  // 195: todo.v1.TodoService.ListTaskBlockers:output_type -> todo.v1.ListTaskBlockersResponse
+	33,  // 196: todo.v1.TodoService.ListTaskDependents:output_type -> todo.v1.ListTaskDependentsResponse
+	56,  // 197: todo.v1.TodoService.GetTask:output_type -> todo.v1.GetTaskResponse
+	58,  // 198: todo.v1.TodoService.UpdateTask:output_type -> todo.v1.UpdateTaskResponse
+	61,  // 199: todo.v1.TodoService.GetTaskSeries:output_type -> todo.v1.GetTaskSeriesResponse
+	63,  // 200: todo.v1.TodoService.UpdateTaskSeries:output_type -> todo.v1.UpdateTaskSeriesResponse
+	65,  // 201: todo.v1.TodoService.DeleteTask:output_type -> todo.v1.DeleteTaskResponse
+	67,  // 202: todo.v1.TodoService.ArchiveTask:output_type -> todo.v1.ArchiveTaskResponse
+	69,  // 203: todo.v1.TodoService.UnarchiveTask:output_type -> todo.v1.UnarchiveTaskResponse
+	71,  // 204: todo.v1.TodoService.ListDeletedTasks:output_type -> todo.v1.ListDeletedTasksResponse
+	73,  // 205: todo.v1.TodoService.RestoreTask:output_type -> todo.v1.RestoreTaskResponse
+	75,  // 206: todo.v1.TodoService.PurgeTask:output_type -> todo.v1.PurgeTaskResponse
+	40,  // 207: todo.v1.TodoService.WatchTask:output_type -> todo.v1.WatchTaskResponse
+	42,  // 208: todo.v1.TodoService.UnwatchTask:output_type -> todo.v1.UnwatchTaskResponse
+	44,  // 209: todo.v1.TodoService.ListTaskWatchers:output_type -> todo.v1.ListTaskWatchersResponse
+	37,  // 210: todo.v1.TodoService.ListTaskHistory:output_type -> todo.v1.ListTaskHistoryResponse
+	47,  // 211: todo.v1.TodoService.ShareTask:output_type -> todo.v1.ShareTaskResponse
+	49,  // 212: todo.v1.TodoService.UnshareTask:output_type -> todo.v1.UnshareTaskResponse
+	51,  // 213: todo.v1.TodoService.ListTaskShares:output_type -> todo.v1.ListTaskSharesResponse
+	78,  // 214: todo.v1.LabelService.CreateLabel:output_type -> todo.v1.CreateLabelResponse
+	80,  // 215: todo.v1.LabelService.ListLabels:output_type -> todo.v1.ListLabelsResponse
+	82,  // 216: todo.v1.LabelService.UpdateLabel:output_type -> todo.v1.UpdateLabelResponse
+	84,  // 217: todo.v1.LabelService.DeleteLabel:output_type -> todo.v1.DeleteLabelResponse
+	87,  // 218: todo.v1.CommentService.CreateComment:output_type -> todo.v1.CreateCommentResponse
+	89,  // 219: todo.v1.CommentService.ListComments:output_type -> todo.v1.ListCommentsResponse
+	91,  // 220: todo.v1.CommentService.EditComment:output_type -> todo.v1.EditCommentResponse
+	93,  // 221: todo.v1.CommentService.DeleteComment:output_type -> todo.v1.DeleteCommentResponse
+	96,  // 222: todo.v1.NotificationService.ListNotifications:output_type -> todo.v1.ListNotificationsResponse
+	98,  // 223: todo.v1.NotificationService.MarkNotificationsRead:output_type -> todo.v1.MarkNotificationsReadResponse
+	100, // 224: todo.v1.NotificationService.GetUnreadCount:output_type -> todo.v1.GetUnreadCountResponse
+	103, // 225: todo.v1.CompanyService.GetCompany:output_type -> todo.v1.GetCompanyResponse
+	105, // 226: todo.v1.CompanyService.UpdateCompanySettings:output_type -> todo.v1.UpdateCompanySettingsResponse
+	110, // 227: todo.v1.CompanyService.GetWorkflow:output_type -> todo.v1.GetWorkflowResponse
+	112, // 228: todo.v1.CompanyService.UpdateWorkflow:output_type -> todo.v1.UpdateWorkflowResponse
+	116, // 229: todo.v1.UserService.ListUsers:output_type -> todo.v1.ListUsersResponse
+	118, // 230: todo.v1.UserService.GetUser:output_type -> todo.v1.GetUserResponse
+	120, // 231: todo.v1.UserService.InviteUser:output_type -> todo.v1.InviteUserResponse
+	122, // 232: todo.v1.UserService.AcceptInvite:output_type -> todo.v1.AcceptInviteResponse
+	124, // 233: todo.v1.UserService.UpdateUserRole:output_type -> todo.v1.UpdateUserRoleResponse
+	126, // 234: todo.v1.UserService.DeactivateUser:output_type -> todo.v1.DeactivateUserResponse
+	128, // 235: todo.v1.UserService.OffboardUser:output_type -> todo.v1.OffboardUserResponse
+	141, // 236: todo.v1.TeamService.CreateTeam:output_type -> todo.v1.CreateTeamResponse
+	143, // 237: todo.v1.TeamService.ListTeams:output_type -> todo.v1.ListTeamsResponse
+	145, // 238: todo.v1.TeamService.UpdateTeam:output_type -> todo.v1.UpdateTeamResponse
+	147, // 239: todo.v1.TeamService.DeleteTeam:output_type -> todo.v1.DeleteTeamResponse
+	149, // 240: todo.v1.TeamService.AddTeamMember:output_type -> todo.v1.AddTeamMemberResponse
+	151, // 241: todo.v1.TeamService.RemoveTeamMember:output_type -> todo.v1.RemoveTeamMemberResponse
+	153, // 242: todo.v1.TeamService.ListTeamMembers:output_type -> todo.v1.ListTeamMembersResponse
+	130, // 243: todo.v1.AdminService.CreateCompany:output_type -> todo.v1.CreateCompanyResponse
+	132, // 244: todo.v1.AdminService.ListCompanies:output_type -> todo.v1.ListCompaniesResponse
+	134, // 245: todo.v1.AdminService.RenameCompany:output_type -> todo.v1.RenameCompanyResponse
+	136, // 246: todo.v1.AdminService.SuspendCompany:output_type -> todo.v1.SuspendCompanyResponse
+	138, // 247: todo.v1.AdminService.UnsuspendCompany:output_type -> todo.v1.UnsuspendCompanyResponse
+	187, // [187:248] is the sub-list for method output_type
+	126, // [126:187] is the sub-list for method input_type
+	126, // [126:126] is the sub-list for extension type_name
+	126, // [126:126] is the sub-list for extension extendee
+	0,   // [0:126] is the sub-list for field type_name
 }
 
 func init() { file_todo_v1_service_proto_init() }
@@ -1090,17 +9796,32 @@ func file_todo_v1_service_proto_init() {
 		return
 	}
 	file_todo_v1_service_proto_msgTypes[0].OneofWrappers = []any{}
-	file_todo_v1_service_proto_msgTypes[1].OneofWrappers = []any{}
-	file_todo_v1_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[2].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[5].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[23].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[24].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[42].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[46].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[48].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[51].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[70].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[74].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[75].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[83].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[90].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[93].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[102].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[116].OneofWrappers = []any{}
+	file_todo_v1_service_proto_msgTypes[133].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_todo_v1_service_proto_rawDesc), len(file_todo_v1_service_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   13,
+			NumEnums:      11,
+			NumMessages:   143,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   8,
 		},
 		GoTypes:           file_todo_v1_service_proto_goTypes,
 		DependencyIndexes: file_todo_v1_service_proto_depIdxs,
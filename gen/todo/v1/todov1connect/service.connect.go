@@ -23,6 +23,20 @@ const _ = connect.IsAtLeastVersion1_13_0
 const (
 	// TodoServiceName is the fully-qualified name of the TodoService service.
 	TodoServiceName = "todo.v1.TodoService"
+	// LabelServiceName is the fully-qualified name of the LabelService service.
+	LabelServiceName = "todo.v1.LabelService"
+	// CommentServiceName is the fully-qualified name of the CommentService service.
+	CommentServiceName = "todo.v1.CommentService"
+	// NotificationServiceName is the fully-qualified name of the NotificationService service.
+	NotificationServiceName = "todo.v1.NotificationService"
+	// CompanyServiceName is the fully-qualified name of the CompanyService service.
+	CompanyServiceName = "todo.v1.CompanyService"
+	// UserServiceName is the fully-qualified name of the UserService service.
+	UserServiceName = "todo.v1.UserService"
+	// TeamServiceName is the fully-qualified name of the TeamService service.
+	TeamServiceName = "todo.v1.TeamService"
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "todo.v1.AdminService"
 )
 
 // These constants are the fully-qualified names of the RPCs defined in this package. They're
@@ -40,28 +54,237 @@ const (
 	TodoServiceListCompanyTasksProcedure = "/todo.v1.TodoService/ListCompanyTasks"
 	// TodoServiceListMyTasksProcedure is the fully-qualified name of the TodoService's ListMyTasks RPC.
 	TodoServiceListMyTasksProcedure = "/todo.v1.TodoService/ListMyTasks"
+	// TodoServiceSearchTasksProcedure is the fully-qualified name of the TodoService's SearchTasks RPC.
+	TodoServiceSearchTasksProcedure = "/todo.v1.TodoService/SearchTasks"
+	// TodoServiceListSubtasksProcedure is the fully-qualified name of the TodoService's ListSubtasks
+	// RPC.
+	TodoServiceListSubtasksProcedure = "/todo.v1.TodoService/ListSubtasks"
+	// TodoServiceGetTaskTreeProcedure is the fully-qualified name of the TodoService's GetTaskTree RPC.
+	TodoServiceGetTaskTreeProcedure = "/todo.v1.TodoService/GetTaskTree"
+	// TodoServiceAddTaskDependencyProcedure is the fully-qualified name of the TodoService's
+	// AddTaskDependency RPC.
+	TodoServiceAddTaskDependencyProcedure = "/todo.v1.TodoService/AddTaskDependency"
+	// TodoServiceRemoveTaskDependencyProcedure is the fully-qualified name of the TodoService's
+	// RemoveTaskDependency RPC.
+	TodoServiceRemoveTaskDependencyProcedure = "/todo.v1.TodoService/RemoveTaskDependency"
+	// TodoServiceListTaskBlockersProcedure is the fully-qualified name of the TodoService's
+	// ListTaskBlockers RPC.
+	TodoServiceListTaskBlockersProcedure = "/todo.v1.TodoService/ListTaskBlockers"
+	// TodoServiceListTaskDependentsProcedure is the fully-qualified name of the TodoService's
+	// ListTaskDependents RPC.
+	TodoServiceListTaskDependentsProcedure = "/todo.v1.TodoService/ListTaskDependents"
 	// TodoServiceGetTaskProcedure is the fully-qualified name of the TodoService's GetTask RPC.
 	TodoServiceGetTaskProcedure = "/todo.v1.TodoService/GetTask"
 	// TodoServiceUpdateTaskProcedure is the fully-qualified name of the TodoService's UpdateTask RPC.
 	TodoServiceUpdateTaskProcedure = "/todo.v1.TodoService/UpdateTask"
+	// TodoServiceGetTaskSeriesProcedure is the fully-qualified name of the TodoService's GetTaskSeries
+	// RPC.
+	TodoServiceGetTaskSeriesProcedure = "/todo.v1.TodoService/GetTaskSeries"
+	// TodoServiceUpdateTaskSeriesProcedure is the fully-qualified name of the TodoService's
+	// UpdateTaskSeries RPC.
+	TodoServiceUpdateTaskSeriesProcedure = "/todo.v1.TodoService/UpdateTaskSeries"
 	// TodoServiceDeleteTaskProcedure is the fully-qualified name of the TodoService's DeleteTask RPC.
 	TodoServiceDeleteTaskProcedure = "/todo.v1.TodoService/DeleteTask"
+	// TodoServiceArchiveTaskProcedure is the fully-qualified name of the TodoService's ArchiveTask RPC.
+	TodoServiceArchiveTaskProcedure = "/todo.v1.TodoService/ArchiveTask"
+	// TodoServiceUnarchiveTaskProcedure is the fully-qualified name of the TodoService's UnarchiveTask
+	// RPC.
+	TodoServiceUnarchiveTaskProcedure = "/todo.v1.TodoService/UnarchiveTask"
+	// TodoServiceListDeletedTasksProcedure is the fully-qualified name of the TodoService's
+	// ListDeletedTasks RPC.
+	TodoServiceListDeletedTasksProcedure = "/todo.v1.TodoService/ListDeletedTasks"
+	// TodoServiceRestoreTaskProcedure is the fully-qualified name of the TodoService's RestoreTask RPC.
+	TodoServiceRestoreTaskProcedure = "/todo.v1.TodoService/RestoreTask"
+	// TodoServicePurgeTaskProcedure is the fully-qualified name of the TodoService's PurgeTask RPC.
+	TodoServicePurgeTaskProcedure = "/todo.v1.TodoService/PurgeTask"
+	// TodoServiceWatchTaskProcedure is the fully-qualified name of the TodoService's WatchTask RPC.
+	TodoServiceWatchTaskProcedure = "/todo.v1.TodoService/WatchTask"
+	// TodoServiceUnwatchTaskProcedure is the fully-qualified name of the TodoService's UnwatchTask RPC.
+	TodoServiceUnwatchTaskProcedure = "/todo.v1.TodoService/UnwatchTask"
+	// TodoServiceListTaskWatchersProcedure is the fully-qualified name of the TodoService's
+	// ListTaskWatchers RPC.
+	TodoServiceListTaskWatchersProcedure = "/todo.v1.TodoService/ListTaskWatchers"
+	// TodoServiceListTaskHistoryProcedure is the fully-qualified name of the TodoService's
+	// ListTaskHistory RPC.
+	TodoServiceListTaskHistoryProcedure = "/todo.v1.TodoService/ListTaskHistory"
+	// TodoServiceShareTaskProcedure is the fully-qualified name of the TodoService's ShareTask RPC.
+	TodoServiceShareTaskProcedure = "/todo.v1.TodoService/ShareTask"
+	// TodoServiceUnshareTaskProcedure is the fully-qualified name of the TodoService's UnshareTask RPC.
+	TodoServiceUnshareTaskProcedure = "/todo.v1.TodoService/UnshareTask"
+	// TodoServiceListTaskSharesProcedure is the fully-qualified name of the TodoService's
+	// ListTaskShares RPC.
+	TodoServiceListTaskSharesProcedure = "/todo.v1.TodoService/ListTaskShares"
+	// LabelServiceCreateLabelProcedure is the fully-qualified name of the LabelService's CreateLabel
+	// RPC.
+	LabelServiceCreateLabelProcedure = "/todo.v1.LabelService/CreateLabel"
+	// LabelServiceListLabelsProcedure is the fully-qualified name of the LabelService's ListLabels RPC.
+	LabelServiceListLabelsProcedure = "/todo.v1.LabelService/ListLabels"
+	// LabelServiceUpdateLabelProcedure is the fully-qualified name of the LabelService's UpdateLabel
+	// RPC.
+	LabelServiceUpdateLabelProcedure = "/todo.v1.LabelService/UpdateLabel"
+	// LabelServiceDeleteLabelProcedure is the fully-qualified name of the LabelService's DeleteLabel
+	// RPC.
+	LabelServiceDeleteLabelProcedure = "/todo.v1.LabelService/DeleteLabel"
+	// CommentServiceCreateCommentProcedure is the fully-qualified name of the CommentService's
+	// CreateComment RPC.
+	CommentServiceCreateCommentProcedure = "/todo.v1.CommentService/CreateComment"
+	// CommentServiceListCommentsProcedure is the fully-qualified name of the CommentService's
+	// ListComments RPC.
+	CommentServiceListCommentsProcedure = "/todo.v1.CommentService/ListComments"
+	// CommentServiceEditCommentProcedure is the fully-qualified name of the CommentService's
+	// EditComment RPC.
+	CommentServiceEditCommentProcedure = "/todo.v1.CommentService/EditComment"
+	// CommentServiceDeleteCommentProcedure is the fully-qualified name of the CommentService's
+	// DeleteComment RPC.
+	CommentServiceDeleteCommentProcedure = "/todo.v1.CommentService/DeleteComment"
+	// NotificationServiceListNotificationsProcedure is the fully-qualified name of the
+	// NotificationService's ListNotifications RPC.
+	NotificationServiceListNotificationsProcedure = "/todo.v1.NotificationService/ListNotifications"
+	// NotificationServiceMarkNotificationsReadProcedure is the fully-qualified name of the
+	// NotificationService's MarkNotificationsRead RPC.
+	NotificationServiceMarkNotificationsReadProcedure = "/todo.v1.NotificationService/MarkNotificationsRead"
+	// NotificationServiceGetUnreadCountProcedure is the fully-qualified name of the
+	// NotificationService's GetUnreadCount RPC.
+	NotificationServiceGetUnreadCountProcedure = "/todo.v1.NotificationService/GetUnreadCount"
+	// CompanyServiceGetCompanyProcedure is the fully-qualified name of the CompanyService's GetCompany
+	// RPC.
+	CompanyServiceGetCompanyProcedure = "/todo.v1.CompanyService/GetCompany"
+	// CompanyServiceUpdateCompanySettingsProcedure is the fully-qualified name of the CompanyService's
+	// UpdateCompanySettings RPC.
+	CompanyServiceUpdateCompanySettingsProcedure = "/todo.v1.CompanyService/UpdateCompanySettings"
+	// CompanyServiceGetWorkflowProcedure is the fully-qualified name of the CompanyService's
+	// GetWorkflow RPC.
+	CompanyServiceGetWorkflowProcedure = "/todo.v1.CompanyService/GetWorkflow"
+	// CompanyServiceUpdateWorkflowProcedure is the fully-qualified name of the CompanyService's
+	// UpdateWorkflow RPC.
+	CompanyServiceUpdateWorkflowProcedure = "/todo.v1.CompanyService/UpdateWorkflow"
+	// UserServiceListUsersProcedure is the fully-qualified name of the UserService's ListUsers RPC.
+	UserServiceListUsersProcedure = "/todo.v1.UserService/ListUsers"
+	// UserServiceGetUserProcedure is the fully-qualified name of the UserService's GetUser RPC.
+	UserServiceGetUserProcedure = "/todo.v1.UserService/GetUser"
+	// UserServiceInviteUserProcedure is the fully-qualified name of the UserService's InviteUser RPC.
+	UserServiceInviteUserProcedure = "/todo.v1.UserService/InviteUser"
+	// UserServiceAcceptInviteProcedure is the fully-qualified name of the UserService's AcceptInvite
+	// RPC.
+	UserServiceAcceptInviteProcedure = "/todo.v1.UserService/AcceptInvite"
+	// UserServiceUpdateUserRoleProcedure is the fully-qualified name of the UserService's
+	// UpdateUserRole RPC.
+	UserServiceUpdateUserRoleProcedure = "/todo.v1.UserService/UpdateUserRole"
+	// UserServiceDeactivateUserProcedure is the fully-qualified name of the UserService's
+	// DeactivateUser RPC.
+	UserServiceDeactivateUserProcedure = "/todo.v1.UserService/DeactivateUser"
+	// UserServiceOffboardUserProcedure is the fully-qualified name of the UserService's OffboardUser
+	// RPC.
+	UserServiceOffboardUserProcedure = "/todo.v1.UserService/OffboardUser"
+	// TeamServiceCreateTeamProcedure is the fully-qualified name of the TeamService's CreateTeam RPC.
+	TeamServiceCreateTeamProcedure = "/todo.v1.TeamService/CreateTeam"
+	// TeamServiceListTeamsProcedure is the fully-qualified name of the TeamService's ListTeams RPC.
+	TeamServiceListTeamsProcedure = "/todo.v1.TeamService/ListTeams"
+	// TeamServiceUpdateTeamProcedure is the fully-qualified name of the TeamService's UpdateTeam RPC.
+	TeamServiceUpdateTeamProcedure = "/todo.v1.TeamService/UpdateTeam"
+	// TeamServiceDeleteTeamProcedure is the fully-qualified name of the TeamService's DeleteTeam RPC.
+	TeamServiceDeleteTeamProcedure = "/todo.v1.TeamService/DeleteTeam"
+	// TeamServiceAddTeamMemberProcedure is the fully-qualified name of the TeamService's AddTeamMember
+	// RPC.
+	TeamServiceAddTeamMemberProcedure = "/todo.v1.TeamService/AddTeamMember"
+	// TeamServiceRemoveTeamMemberProcedure is the fully-qualified name of the TeamService's
+	// RemoveTeamMember RPC.
+	TeamServiceRemoveTeamMemberProcedure = "/todo.v1.TeamService/RemoveTeamMember"
+	// TeamServiceListTeamMembersProcedure is the fully-qualified name of the TeamService's
+	// ListTeamMembers RPC.
+	TeamServiceListTeamMembersProcedure = "/todo.v1.TeamService/ListTeamMembers"
+	// AdminServiceCreateCompanyProcedure is the fully-qualified name of the AdminService's
+	// CreateCompany RPC.
+	AdminServiceCreateCompanyProcedure = "/todo.v1.AdminService/CreateCompany"
+	// AdminServiceListCompaniesProcedure is the fully-qualified name of the AdminService's
+	// ListCompanies RPC.
+	AdminServiceListCompaniesProcedure = "/todo.v1.AdminService/ListCompanies"
+	// AdminServiceRenameCompanyProcedure is the fully-qualified name of the AdminService's
+	// RenameCompany RPC.
+	AdminServiceRenameCompanyProcedure = "/todo.v1.AdminService/RenameCompany"
+	// AdminServiceSuspendCompanyProcedure is the fully-qualified name of the AdminService's
+	// SuspendCompany RPC.
+	AdminServiceSuspendCompanyProcedure = "/todo.v1.AdminService/SuspendCompany"
+	// AdminServiceUnsuspendCompanyProcedure is the fully-qualified name of the AdminService's
+	// UnsuspendCompany RPC.
+	AdminServiceUnsuspendCompanyProcedure = "/todo.v1.AdminService/UnsuspendCompany"
 )
 
 // TodoServiceClient is a client for the todo.v1.TodoService service.
 type TodoServiceClient interface {
-	// CreateTask creates a new task (Editor only)
+	// CreateTask creates a new task (Editor only). Users @mentioned in the
+	// description are notified; mentioning someone who cannot see the task
+	// fails with INVALID_ARGUMENT.
 	CreateTask(context.Context, *connect.Request[v1.CreateTaskRequest]) (*connect.Response[v1.CreateTaskResponse], error)
 	// ListCompanyTasks lists all tasks visible to the user in their company
 	ListCompanyTasks(context.Context, *connect.Request[v1.ListCompanyTasksRequest]) (*connect.Response[v1.ListCompanyTasksResponse], error)
 	// ListMyTasks lists tasks assigned to the authenticated user
 	ListMyTasks(context.Context, *connect.Request[v1.ListMyTasksRequest]) (*connect.Response[v1.ListMyTasksResponse], error)
+	// SearchTasks finds visible tasks by title and description, tolerating typos
+	SearchTasks(context.Context, *connect.Request[v1.SearchTasksRequest]) (*connect.Response[v1.SearchTasksResponse], error)
+	// ListSubtasks returns the direct subtasks of a task
+	ListSubtasks(context.Context, *connect.Request[v1.ListSubtasksRequest]) (*connect.Response[v1.ListSubtasksResponse], error)
+	// GetTaskTree returns a task with its whole subtree
+	GetTaskTree(context.Context, *connect.Request[v1.GetTaskTreeRequest]) (*connect.Response[v1.GetTaskTreeResponse], error)
+	// AddTaskDependency blocks a task until another is done (Editor only).
+	// Edges that would create a cycle are rejected.
+	AddTaskDependency(context.Context, *connect.Request[v1.AddTaskDependencyRequest]) (*connect.Response[v1.AddTaskDependencyResponse], error)
+	// RemoveTaskDependency removes a blocking edge (Editor only)
+	RemoveTaskDependency(context.Context, *connect.Request[v1.RemoveTaskDependencyRequest]) (*connect.Response[v1.RemoveTaskDependencyResponse], error)
+	// ListTaskBlockers returns the tasks blocking a task
+	ListTaskBlockers(context.Context, *connect.Request[v1.ListTaskBlockersRequest]) (*connect.Response[v1.ListTaskBlockersResponse], error)
+	// ListTaskDependents returns the tasks a task is blocking
+	ListTaskDependents(context.Context, *connect.Request[v1.ListTaskDependentsRequest]) (*connect.Response[v1.ListTaskDependentsResponse], error)
 	// GetTask retrieves a single task by ID
 	GetTask(context.Context, *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error)
-	// UpdateTask updates an existing task (Editor only)
+	// UpdateTask updates an existing task (Editor only; viewers may change the
+	// status of tasks assigned to them, and users the task is shared with as
+	// editors may change everything but its visibility, team and assignees).
+	// Moving a task to done fails with FAILED_PRECONDITION while any blocker
+	// is not done. Completing
+	// the latest instance of a recurring task creates the next one; edits
+	// apply to this instance only.
 	UpdateTask(context.Context, *connect.Request[v1.UpdateTaskRequest]) (*connect.Response[v1.UpdateTaskResponse], error)
-	// DeleteTask deletes a task (Editor only)
+	// GetTaskSeries retrieves the series behind a recurring task
+	GetTaskSeries(context.Context, *connect.Request[v1.GetTaskSeriesRequest]) (*connect.Response[v1.GetTaskSeriesResponse], error)
+	// UpdateTaskSeries edits a recurring task's schedule and template (Editor only)
+	UpdateTaskSeries(context.Context, *connect.Request[v1.UpdateTaskSeriesRequest]) (*connect.Response[v1.UpdateTaskSeriesResponse], error)
+	// DeleteTask moves a task to the trash (Editor, or shared with you as an
+	// editor). Fails with FAILED_PRECONDITION while the task still has
+	// subtasks.
 	DeleteTask(context.Context, *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error)
+	// ArchiveTask hides a task from listings without deleting it (Editor only).
+	// Archiving is independent of the task's status.
+	ArchiveTask(context.Context, *connect.Request[v1.ArchiveTaskRequest]) (*connect.Response[v1.ArchiveTaskResponse], error)
+	// UnarchiveTask brings an archived task back into listings (Editor only)
+	UnarchiveTask(context.Context, *connect.Request[v1.UnarchiveTaskRequest]) (*connect.Response[v1.UnarchiveTaskResponse], error)
+	// ListDeletedTasks lists the tasks in the trash visible to the user
+	ListDeletedTasks(context.Context, *connect.Request[v1.ListDeletedTasksRequest]) (*connect.Response[v1.ListDeletedTasksResponse], error)
+	// RestoreTask takes a task out of the trash (Editor only). Fails with
+	// FAILED_PRECONDITION while its parent is still in the trash.
+	RestoreTask(context.Context, *connect.Request[v1.RestoreTaskRequest]) (*connect.Response[v1.RestoreTaskResponse], error)
+	// PurgeTask permanently removes a task from the trash (Editor only). Fails
+	// with FAILED_PRECONDITION while its subtasks are still in the trash.
+	PurgeTask(context.Context, *connect.Request[v1.PurgeTaskRequest]) (*connect.Response[v1.PurgeTaskResponse], error)
+	// WatchTask follows a visible task (any role). Creators and assignees
+	// follow their tasks automatically, and watchers lose the subscription
+	// when a change hides the task from them.
+	WatchTask(context.Context, *connect.Request[v1.WatchTaskRequest]) (*connect.Response[v1.WatchTaskResponse], error)
+	// UnwatchTask stops following a task
+	UnwatchTask(context.Context, *connect.Request[v1.UnwatchTaskRequest]) (*connect.Response[v1.UnwatchTaskResponse], error)
+	// ListTaskWatchers lists who follows a visible task
+	ListTaskWatchers(context.Context, *connect.Request[v1.ListTaskWatchersRequest]) (*connect.Response[v1.ListTaskWatchersResponse], error)
+	// ListTaskHistory lists who changed what on a visible task, newest first
+	ListTaskHistory(context.Context, *connect.Request[v1.ListTaskHistoryRequest]) (*connect.Response[v1.ListTaskHistoryResponse], error)
+	// ShareTask lets the task's creator share it with an active user of the
+	// company as a viewer or an editor, whatever its visibility. Sharing with
+	// the same user again changes their role.
+	ShareTask(context.Context, *connect.Request[v1.ShareTaskRequest]) (*connect.Response[v1.ShareTaskResponse], error)
+	// UnshareTask removes a share (the creator, or the user giving up their
+	// own share)
+	UnshareTask(context.Context, *connect.Request[v1.UnshareTaskRequest]) (*connect.Response[v1.UnshareTaskResponse], error)
+	// ListTaskShares lists who a visible task is shared with
+	ListTaskShares(context.Context, *connect.Request[v1.ListTaskSharesRequest]) (*connect.Response[v1.ListTaskSharesResponse], error)
 }
 
 // NewTodoServiceClient constructs a client for the todo.v1.TodoService service. By default, it uses
@@ -93,6 +316,48 @@ func NewTodoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(todoServiceMethods.ByName("ListMyTasks")),
 			connect.WithClientOptions(opts...),
 		),
+		searchTasks: connect.NewClient[v1.SearchTasksRequest, v1.SearchTasksResponse](
+			httpClient,
+			baseURL+TodoServiceSearchTasksProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("SearchTasks")),
+			connect.WithClientOptions(opts...),
+		),
+		listSubtasks: connect.NewClient[v1.ListSubtasksRequest, v1.ListSubtasksResponse](
+			httpClient,
+			baseURL+TodoServiceListSubtasksProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("ListSubtasks")),
+			connect.WithClientOptions(opts...),
+		),
+		getTaskTree: connect.NewClient[v1.GetTaskTreeRequest, v1.GetTaskTreeResponse](
+			httpClient,
+			baseURL+TodoServiceGetTaskTreeProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("GetTaskTree")),
+			connect.WithClientOptions(opts...),
+		),
+		addTaskDependency: connect.NewClient[v1.AddTaskDependencyRequest, v1.AddTaskDependencyResponse](
+			httpClient,
+			baseURL+TodoServiceAddTaskDependencyProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("AddTaskDependency")),
+			connect.WithClientOptions(opts...),
+		),
+		removeTaskDependency: connect.NewClient[v1.RemoveTaskDependencyRequest, v1.RemoveTaskDependencyResponse](
+			httpClient,
+			baseURL+TodoServiceRemoveTaskDependencyProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("RemoveTaskDependency")),
+			connect.WithClientOptions(opts...),
+		),
+		listTaskBlockers: connect.NewClient[v1.ListTaskBlockersRequest, v1.ListTaskBlockersResponse](
+			httpClient,
+			baseURL+TodoServiceListTaskBlockersProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("ListTaskBlockers")),
+			connect.WithClientOptions(opts...),
+		),
+		listTaskDependents: connect.NewClient[v1.ListTaskDependentsRequest, v1.ListTaskDependentsResponse](
+			httpClient,
+			baseURL+TodoServiceListTaskDependentsProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("ListTaskDependents")),
+			connect.WithClientOptions(opts...),
+		),
 		getTask: connect.NewClient[v1.GetTaskRequest, v1.GetTaskResponse](
 			httpClient,
 			baseURL+TodoServiceGetTaskProcedure,
@@ -105,23 +370,128 @@ func NewTodoServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			connect.WithSchema(todoServiceMethods.ByName("UpdateTask")),
 			connect.WithClientOptions(opts...),
 		),
+		getTaskSeries: connect.NewClient[v1.GetTaskSeriesRequest, v1.GetTaskSeriesResponse](
+			httpClient,
+			baseURL+TodoServiceGetTaskSeriesProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("GetTaskSeries")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTaskSeries: connect.NewClient[v1.UpdateTaskSeriesRequest, v1.UpdateTaskSeriesResponse](
+			httpClient,
+			baseURL+TodoServiceUpdateTaskSeriesProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("UpdateTaskSeries")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteTask: connect.NewClient[v1.DeleteTaskRequest, v1.DeleteTaskResponse](
 			httpClient,
 			baseURL+TodoServiceDeleteTaskProcedure,
 			connect.WithSchema(todoServiceMethods.ByName("DeleteTask")),
 			connect.WithClientOptions(opts...),
 		),
+		archiveTask: connect.NewClient[v1.ArchiveTaskRequest, v1.ArchiveTaskResponse](
+			httpClient,
+			baseURL+TodoServiceArchiveTaskProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("ArchiveTask")),
+			connect.WithClientOptions(opts...),
+		),
+		unarchiveTask: connect.NewClient[v1.UnarchiveTaskRequest, v1.UnarchiveTaskResponse](
+			httpClient,
+			baseURL+TodoServiceUnarchiveTaskProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("UnarchiveTask")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeletedTasks: connect.NewClient[v1.ListDeletedTasksRequest, v1.ListDeletedTasksResponse](
+			httpClient,
+			baseURL+TodoServiceListDeletedTasksProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("ListDeletedTasks")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreTask: connect.NewClient[v1.RestoreTaskRequest, v1.RestoreTaskResponse](
+			httpClient,
+			baseURL+TodoServiceRestoreTaskProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("RestoreTask")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeTask: connect.NewClient[v1.PurgeTaskRequest, v1.PurgeTaskResponse](
+			httpClient,
+			baseURL+TodoServicePurgeTaskProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("PurgeTask")),
+			connect.WithClientOptions(opts...),
+		),
+		watchTask: connect.NewClient[v1.WatchTaskRequest, v1.WatchTaskResponse](
+			httpClient,
+			baseURL+TodoServiceWatchTaskProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("WatchTask")),
+			connect.WithClientOptions(opts...),
+		),
+		unwatchTask: connect.NewClient[v1.UnwatchTaskRequest, v1.UnwatchTaskResponse](
+			httpClient,
+			baseURL+TodoServiceUnwatchTaskProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("UnwatchTask")),
+			connect.WithClientOptions(opts...),
+		),
+		listTaskWatchers: connect.NewClient[v1.ListTaskWatchersRequest, v1.ListTaskWatchersResponse](
+			httpClient,
+			baseURL+TodoServiceListTaskWatchersProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("ListTaskWatchers")),
+			connect.WithClientOptions(opts...),
+		),
+		listTaskHistory: connect.NewClient[v1.ListTaskHistoryRequest, v1.ListTaskHistoryResponse](
+			httpClient,
+			baseURL+TodoServiceListTaskHistoryProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("ListTaskHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		shareTask: connect.NewClient[v1.ShareTaskRequest, v1.ShareTaskResponse](
+			httpClient,
+			baseURL+TodoServiceShareTaskProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("ShareTask")),
+			connect.WithClientOptions(opts...),
+		),
+		unshareTask: connect.NewClient[v1.UnshareTaskRequest, v1.UnshareTaskResponse](
+			httpClient,
+			baseURL+TodoServiceUnshareTaskProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("UnshareTask")),
+			connect.WithClientOptions(opts...),
+		),
+		listTaskShares: connect.NewClient[v1.ListTaskSharesRequest, v1.ListTaskSharesResponse](
+			httpClient,
+			baseURL+TodoServiceListTaskSharesProcedure,
+			connect.WithSchema(todoServiceMethods.ByName("ListTaskShares")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // todoServiceClient implements TodoServiceClient.
 type todoServiceClient struct {
-	createTask       *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
-	listCompanyTasks *connect.Client[v1.ListCompanyTasksRequest, v1.ListCompanyTasksResponse]
-	listMyTasks      *connect.Client[v1.ListMyTasksRequest, v1.ListMyTasksResponse]
-	getTask          *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
-	updateTask       *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
-	deleteTask       *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
+	createTask           *connect.Client[v1.CreateTaskRequest, v1.CreateTaskResponse]
+	listCompanyTasks     *connect.Client[v1.ListCompanyTasksRequest, v1.ListCompanyTasksResponse]
+	listMyTasks          *connect.Client[v1.ListMyTasksRequest, v1.ListMyTasksResponse]
+	searchTasks          *connect.Client[v1.SearchTasksRequest, v1.SearchTasksResponse]
+	listSubtasks         *connect.Client[v1.ListSubtasksRequest, v1.ListSubtasksResponse]
+	getTaskTree          *connect.Client[v1.GetTaskTreeRequest, v1.GetTaskTreeResponse]
+	addTaskDependency    *connect.Client[v1.AddTaskDependencyRequest, v1.AddTaskDependencyResponse]
+	removeTaskDependency *connect.Client[v1.RemoveTaskDependencyRequest, v1.RemoveTaskDependencyResponse]
+	listTaskBlockers     *connect.Client[v1.ListTaskBlockersRequest, v1.ListTaskBlockersResponse]
+	listTaskDependents   *connect.Client[v1.ListTaskDependentsRequest, v1.ListTaskDependentsResponse]
+	getTask              *connect.Client[v1.GetTaskRequest, v1.GetTaskResponse]
+	updateTask           *connect.Client[v1.UpdateTaskRequest, v1.UpdateTaskResponse]
+	getTaskSeries        *connect.Client[v1.GetTaskSeriesRequest, v1.GetTaskSeriesResponse]
+	updateTaskSeries     *connect.Client[v1.UpdateTaskSeriesRequest, v1.UpdateTaskSeriesResponse]
+	deleteTask           *connect.Client[v1.DeleteTaskRequest, v1.DeleteTaskResponse]
+	archiveTask          *connect.Client[v1.ArchiveTaskRequest, v1.ArchiveTaskResponse]
+	unarchiveTask        *connect.Client[v1.UnarchiveTaskRequest, v1.UnarchiveTaskResponse]
+	listDeletedTasks     *connect.Client[v1.ListDeletedTasksRequest, v1.ListDeletedTasksResponse]
+	restoreTask          *connect.Client[v1.RestoreTaskRequest, v1.RestoreTaskResponse]
+	purgeTask            *connect.Client[v1.PurgeTaskRequest, v1.PurgeTaskResponse]
+	watchTask            *connect.Client[v1.WatchTaskRequest, v1.WatchTaskResponse]
+	unwatchTask          *connect.Client[v1.UnwatchTaskRequest, v1.UnwatchTaskResponse]
+	listTaskWatchers     *connect.Client[v1.ListTaskWatchersRequest, v1.ListTaskWatchersResponse]
+	listTaskHistory      *connect.Client[v1.ListTaskHistoryRequest, v1.ListTaskHistoryResponse]
+	shareTask            *connect.Client[v1.ShareTaskRequest, v1.ShareTaskResponse]
+	unshareTask          *connect.Client[v1.UnshareTaskRequest, v1.UnshareTaskResponse]
+	listTaskShares       *connect.Client[v1.ListTaskSharesRequest, v1.ListTaskSharesResponse]
 }
 
 // CreateTask calls todo.v1.TodoService.CreateTask.
@@ -139,6 +509,41 @@ func (c *todoServiceClient) ListMyTasks(ctx context.Context, req *connect.Reques
 	return c.listMyTasks.CallUnary(ctx, req)
 }
 
+// SearchTasks calls todo.v1.TodoService.SearchTasks.
+func (c *todoServiceClient) SearchTasks(ctx context.Context, req *connect.Request[v1.SearchTasksRequest]) (*connect.Response[v1.SearchTasksResponse], error) {
+	return c.searchTasks.CallUnary(ctx, req)
+}
+
+// ListSubtasks calls todo.v1.TodoService.ListSubtasks.
+func (c *todoServiceClient) ListSubtasks(ctx context.Context, req *connect.Request[v1.ListSubtasksRequest]) (*connect.Response[v1.ListSubtasksResponse], error) {
+	return c.listSubtasks.CallUnary(ctx, req)
+}
+
+// GetTaskTree calls todo.v1.TodoService.GetTaskTree.
+func (c *todoServiceClient) GetTaskTree(ctx context.Context, req *connect.Request[v1.GetTaskTreeRequest]) (*connect.Response[v1.GetTaskTreeResponse], error) {
+	return c.getTaskTree.CallUnary(ctx, req)
+}
+
+// AddTaskDependency calls todo.v1.TodoService.AddTaskDependency.
+func (c *todoServiceClient) AddTaskDependency(ctx context.Context, req *connect.Request[v1.AddTaskDependencyRequest]) (*connect.Response[v1.AddTaskDependencyResponse], error) {
+	return c.addTaskDependency.CallUnary(ctx, req)
+}
+
+// RemoveTaskDependency calls todo.v1.TodoService.RemoveTaskDependency.
+func (c *todoServiceClient) RemoveTaskDependency(ctx context.Context, req *connect.Request[v1.RemoveTaskDependencyRequest]) (*connect.Response[v1.RemoveTaskDependencyResponse], error) {
+	return c.removeTaskDependency.CallUnary(ctx, req)
+}
+
+// ListTaskBlockers calls todo.v1.TodoService.ListTaskBlockers.
+func (c *todoServiceClient) ListTaskBlockers(ctx context.Context, req *connect.Request[v1.ListTaskBlockersRequest]) (*connect.Response[v1.ListTaskBlockersResponse], error) {
+	return c.listTaskBlockers.CallUnary(ctx, req)
+}
+
+// ListTaskDependents calls todo.v1.TodoService.ListTaskDependents.
+func (c *todoServiceClient) ListTaskDependents(ctx context.Context, req *connect.Request[v1.ListTaskDependentsRequest]) (*connect.Response[v1.ListTaskDependentsResponse], error) {
+	return c.listTaskDependents.CallUnary(ctx, req)
+}
+
 // GetTask calls todo.v1.TodoService.GetTask.
 func (c *todoServiceClient) GetTask(ctx context.Context, req *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error) {
 	return c.getTask.CallUnary(ctx, req)
@@ -149,25 +554,156 @@ func (c *todoServiceClient) UpdateTask(ctx context.Context, req *connect.Request
 	return c.updateTask.CallUnary(ctx, req)
 }
 
+// GetTaskSeries calls todo.v1.TodoService.GetTaskSeries.
+func (c *todoServiceClient) GetTaskSeries(ctx context.Context, req *connect.Request[v1.GetTaskSeriesRequest]) (*connect.Response[v1.GetTaskSeriesResponse], error) {
+	return c.getTaskSeries.CallUnary(ctx, req)
+}
+
+// UpdateTaskSeries calls todo.v1.TodoService.UpdateTaskSeries.
+func (c *todoServiceClient) UpdateTaskSeries(ctx context.Context, req *connect.Request[v1.UpdateTaskSeriesRequest]) (*connect.Response[v1.UpdateTaskSeriesResponse], error) {
+	return c.updateTaskSeries.CallUnary(ctx, req)
+}
+
 // DeleteTask calls todo.v1.TodoService.DeleteTask.
 func (c *todoServiceClient) DeleteTask(ctx context.Context, req *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error) {
 	return c.deleteTask.CallUnary(ctx, req)
 }
 
+// ArchiveTask calls todo.v1.TodoService.ArchiveTask.
+func (c *todoServiceClient) ArchiveTask(ctx context.Context, req *connect.Request[v1.ArchiveTaskRequest]) (*connect.Response[v1.ArchiveTaskResponse], error) {
+	return c.archiveTask.CallUnary(ctx, req)
+}
+
+// UnarchiveTask calls todo.v1.TodoService.UnarchiveTask.
+func (c *todoServiceClient) UnarchiveTask(ctx context.Context, req *connect.Request[v1.UnarchiveTaskRequest]) (*connect.Response[v1.UnarchiveTaskResponse], error) {
+	return c.unarchiveTask.CallUnary(ctx, req)
+}
+
+// ListDeletedTasks calls todo.v1.TodoService.ListDeletedTasks.
+func (c *todoServiceClient) ListDeletedTasks(ctx context.Context, req *connect.Request[v1.ListDeletedTasksRequest]) (*connect.Response[v1.ListDeletedTasksResponse], error) {
+	return c.listDeletedTasks.CallUnary(ctx, req)
+}
+
+// RestoreTask calls todo.v1.TodoService.RestoreTask.
+func (c *todoServiceClient) RestoreTask(ctx context.Context, req *connect.Request[v1.RestoreTaskRequest]) (*connect.Response[v1.RestoreTaskResponse], error) {
+	return c.restoreTask.CallUnary(ctx, req)
+}
+
+// PurgeTask calls todo.v1.TodoService.PurgeTask.
+func (c *todoServiceClient) PurgeTask(ctx context.Context, req *connect.Request[v1.PurgeTaskRequest]) (*connect.Response[v1.PurgeTaskResponse], error) {
+	return c.purgeTask.CallUnary(ctx, req)
+}
+
+// WatchTask calls todo.v1.TodoService.WatchTask.
+func (c *todoServiceClient) WatchTask(ctx context.Context, req *connect.Request[v1.WatchTaskRequest]) (*connect.Response[v1.WatchTaskResponse], error) {
+	return c.watchTask.CallUnary(ctx, req)
+}
+
+// UnwatchTask calls todo.v1.TodoService.UnwatchTask.
+func (c *todoServiceClient) UnwatchTask(ctx context.Context, req *connect.Request[v1.UnwatchTaskRequest]) (*connect.Response[v1.UnwatchTaskResponse], error) {
+	return c.unwatchTask.CallUnary(ctx, req)
+}
+
+// ListTaskWatchers calls todo.v1.TodoService.ListTaskWatchers.
+func (c *todoServiceClient) ListTaskWatchers(ctx context.Context, req *connect.Request[v1.ListTaskWatchersRequest]) (*connect.Response[v1.ListTaskWatchersResponse], error) {
+	return c.listTaskWatchers.CallUnary(ctx, req)
+}
+
+// ListTaskHistory calls todo.v1.TodoService.ListTaskHistory.
+func (c *todoServiceClient) ListTaskHistory(ctx context.Context, req *connect.Request[v1.ListTaskHistoryRequest]) (*connect.Response[v1.ListTaskHistoryResponse], error) {
+	return c.listTaskHistory.CallUnary(ctx, req)
+}
+
+// ShareTask calls todo.v1.TodoService.ShareTask.
+func (c *todoServiceClient) ShareTask(ctx context.Context, req *connect.Request[v1.ShareTaskRequest]) (*connect.Response[v1.ShareTaskResponse], error) {
+	return c.shareTask.CallUnary(ctx, req)
+}
+
+// UnshareTask calls todo.v1.TodoService.UnshareTask.
+func (c *todoServiceClient) UnshareTask(ctx context.Context, req *connect.Request[v1.UnshareTaskRequest]) (*connect.Response[v1.UnshareTaskResponse], error) {
+	return c.unshareTask.CallUnary(ctx, req)
+}
+
+// ListTaskShares calls todo.v1.TodoService.ListTaskShares.
+func (c *todoServiceClient) ListTaskShares(ctx context.Context, req *connect.Request[v1.ListTaskSharesRequest]) (*connect.Response[v1.ListTaskSharesResponse], error) {
+	return c.listTaskShares.CallUnary(ctx, req)
+}
+
 // TodoServiceHandler is an implementation of the todo.v1.TodoService service.
 type TodoServiceHandler interface {
-	// CreateTask creates a new task (Editor only)
+	// CreateTask creates a new task (Editor only). Users @mentioned in the
+	// description are notified; mentioning someone who cannot see the task
+	// fails with INVALID_ARGUMENT.
 	CreateTask(context.Context, *connect.Request[v1.CreateTaskRequest]) (*connect.Response[v1.CreateTaskResponse], error)
 	// ListCompanyTasks lists all tasks visible to the user in their company
 	ListCompanyTasks(context.Context, *connect.Request[v1.ListCompanyTasksRequest]) (*connect.Response[v1.ListCompanyTasksResponse], error)
 	// ListMyTasks lists tasks assigned to the authenticated user
 	ListMyTasks(context.Context, *connect.Request[v1.ListMyTasksRequest]) (*connect.Response[v1.ListMyTasksResponse], error)
+	// SearchTasks finds visible tasks by title and description, tolerating typos
+	SearchTasks(context.Context, *connect.Request[v1.SearchTasksRequest]) (*connect.Response[v1.SearchTasksResponse], error)
+	// ListSubtasks returns the direct subtasks of a task
+	ListSubtasks(context.Context, *connect.Request[v1.ListSubtasksRequest]) (*connect.Response[v1.ListSubtasksResponse], error)
+	// GetTaskTree returns a task with its whole subtree
+	GetTaskTree(context.Context, *connect.Request[v1.GetTaskTreeRequest]) (*connect.Response[v1.GetTaskTreeResponse], error)
+	// AddTaskDependency blocks a task until another is done (Editor only).
+	// Edges that would create a cycle are rejected.
+	AddTaskDependency(context.Context, *connect.Request[v1.AddTaskDependencyRequest]) (*connect.Response[v1.AddTaskDependencyResponse], error)
+	// RemoveTaskDependency removes a blocking edge (Editor only)
+	RemoveTaskDependency(context.Context, *connect.Request[v1.RemoveTaskDependencyRequest]) (*connect.Response[v1.RemoveTaskDependencyResponse], error)
+	// ListTaskBlockers returns the tasks blocking a task
+	ListTaskBlockers(context.Context, *connect.Request[v1.ListTaskBlockersRequest]) (*connect.Response[v1.ListTaskBlockersResponse], error)
+	// ListTaskDependents returns the tasks a task is blocking
+	ListTaskDependents(context.Context, *connect.Request[v1.ListTaskDependentsRequest]) (*connect.Response[v1.ListTaskDependentsResponse], error)
 	// GetTask retrieves a single task by ID
 	GetTask(context.Context, *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error)
-	// UpdateTask updates an existing task (Editor only)
+	// UpdateTask updates an existing task (Editor only; viewers may change the
+	// status of tasks assigned to them, and users the task is shared with as
+	// editors may change everything but its visibility, team and assignees).
+	// Moving a task to done fails with FAILED_PRECONDITION while any blocker
+	// is not done. Completing
+	// the latest instance of a recurring task creates the next one; edits
+	// apply to this instance only.
 	UpdateTask(context.Context, *connect.Request[v1.UpdateTaskRequest]) (*connect.Response[v1.UpdateTaskResponse], error)
-	// DeleteTask deletes a task (Editor only)
+	// GetTaskSeries retrieves the series behind a recurring task
+	GetTaskSeries(context.Context, *connect.Request[v1.GetTaskSeriesRequest]) (*connect.Response[v1.GetTaskSeriesResponse], error)
+	// UpdateTaskSeries edits a recurring task's schedule and template (Editor only)
+	UpdateTaskSeries(context.Context, *connect.Request[v1.UpdateTaskSeriesRequest]) (*connect.Response[v1.UpdateTaskSeriesResponse], error)
+	// DeleteTask moves a task to the trash (Editor, or shared with you as an
+	// editor). Fails with FAILED_PRECONDITION while the task still has
+	// subtasks.
 	DeleteTask(context.Context, *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error)
+	// ArchiveTask hides a task from listings without deleting it (Editor only).
+	// Archiving is independent of the task's status.
+	ArchiveTask(context.Context, *connect.Request[v1.ArchiveTaskRequest]) (*connect.Response[v1.ArchiveTaskResponse], error)
+	// UnarchiveTask brings an archived task back into listings (Editor only)
+	UnarchiveTask(context.Context, *connect.Request[v1.UnarchiveTaskRequest]) (*connect.Response[v1.UnarchiveTaskResponse], error)
+	// ListDeletedTasks lists the tasks in the trash visible to the user
+	ListDeletedTasks(context.Context, *connect.Request[v1.ListDeletedTasksRequest]) (*connect.Response[v1.ListDeletedTasksResponse], error)
+	// RestoreTask takes a task out of the trash (Editor only). Fails with
+	// FAILED_PRECONDITION while its parent is still in the trash.
+	RestoreTask(context.Context, *connect.Request[v1.RestoreTaskRequest]) (*connect.Response[v1.RestoreTaskResponse], error)
+	// PurgeTask permanently removes a task from the trash (Editor only). Fails
+	// with FAILED_PRECONDITION while its subtasks are still in the trash.
+	PurgeTask(context.Context, *connect.Request[v1.PurgeTaskRequest]) (*connect.Response[v1.PurgeTaskResponse], error)
+	// WatchTask follows a visible task (any role). Creators and assignees
+	// follow their tasks automatically, and watchers lose the subscription
+	// when a change hides the task from them.
+	WatchTask(context.Context, *connect.Request[v1.WatchTaskRequest]) (*connect.Response[v1.WatchTaskResponse], error)
+	// UnwatchTask stops following a task
+	UnwatchTask(context.Context, *connect.Request[v1.UnwatchTaskRequest]) (*connect.Response[v1.UnwatchTaskResponse], error)
+	// ListTaskWatchers lists who follows a visible task
+	ListTaskWatchers(context.Context, *connect.Request[v1.ListTaskWatchersRequest]) (*connect.Response[v1.ListTaskWatchersResponse], error)
+	// ListTaskHistory lists who changed what on a visible task, newest first
+	ListTaskHistory(context.Context, *connect.Request[v1.ListTaskHistoryRequest]) (*connect.Response[v1.ListTaskHistoryResponse], error)
+	// ShareTask lets the task's creator share it with an active user of the
+	// company as a viewer or an editor, whatever its visibility. Sharing with
+	// the same user again changes their role.
+	ShareTask(context.Context, *connect.Request[v1.ShareTaskRequest]) (*connect.Response[v1.ShareTaskResponse], error)
+	// UnshareTask removes a share (the creator, or the user giving up their
+	// own share)
+	UnshareTask(context.Context, *connect.Request[v1.UnshareTaskRequest]) (*connect.Response[v1.UnshareTaskResponse], error)
+	// ListTaskShares lists who a visible task is shared with
+	ListTaskShares(context.Context, *connect.Request[v1.ListTaskSharesRequest]) (*connect.Response[v1.ListTaskSharesResponse], error)
 }
 
 // NewTodoServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -195,6 +731,48 @@ func NewTodoServiceHandler(svc TodoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(todoServiceMethods.ByName("ListMyTasks")),
 		connect.WithHandlerOptions(opts...),
 	)
+	todoServiceSearchTasksHandler := connect.NewUnaryHandler(
+		TodoServiceSearchTasksProcedure,
+		svc.SearchTasks,
+		connect.WithSchema(todoServiceMethods.ByName("SearchTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceListSubtasksHandler := connect.NewUnaryHandler(
+		TodoServiceListSubtasksProcedure,
+		svc.ListSubtasks,
+		connect.WithSchema(todoServiceMethods.ByName("ListSubtasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceGetTaskTreeHandler := connect.NewUnaryHandler(
+		TodoServiceGetTaskTreeProcedure,
+		svc.GetTaskTree,
+		connect.WithSchema(todoServiceMethods.ByName("GetTaskTree")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceAddTaskDependencyHandler := connect.NewUnaryHandler(
+		TodoServiceAddTaskDependencyProcedure,
+		svc.AddTaskDependency,
+		connect.WithSchema(todoServiceMethods.ByName("AddTaskDependency")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceRemoveTaskDependencyHandler := connect.NewUnaryHandler(
+		TodoServiceRemoveTaskDependencyProcedure,
+		svc.RemoveTaskDependency,
+		connect.WithSchema(todoServiceMethods.ByName("RemoveTaskDependency")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceListTaskBlockersHandler := connect.NewUnaryHandler(
+		TodoServiceListTaskBlockersProcedure,
+		svc.ListTaskBlockers,
+		connect.WithSchema(todoServiceMethods.ByName("ListTaskBlockers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceListTaskDependentsHandler := connect.NewUnaryHandler(
+		TodoServiceListTaskDependentsProcedure,
+		svc.ListTaskDependents,
+		connect.WithSchema(todoServiceMethods.ByName("ListTaskDependents")),
+		connect.WithHandlerOptions(opts...),
+	)
 	todoServiceGetTaskHandler := connect.NewUnaryHandler(
 		TodoServiceGetTaskProcedure,
 		svc.GetTask,
@@ -207,12 +785,96 @@ func NewTodoServiceHandler(svc TodoServiceHandler, opts ...connect.HandlerOption
 		connect.WithSchema(todoServiceMethods.ByName("UpdateTask")),
 		connect.WithHandlerOptions(opts...),
 	)
+	todoServiceGetTaskSeriesHandler := connect.NewUnaryHandler(
+		TodoServiceGetTaskSeriesProcedure,
+		svc.GetTaskSeries,
+		connect.WithSchema(todoServiceMethods.ByName("GetTaskSeries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceUpdateTaskSeriesHandler := connect.NewUnaryHandler(
+		TodoServiceUpdateTaskSeriesProcedure,
+		svc.UpdateTaskSeries,
+		connect.WithSchema(todoServiceMethods.ByName("UpdateTaskSeries")),
+		connect.WithHandlerOptions(opts...),
+	)
 	todoServiceDeleteTaskHandler := connect.NewUnaryHandler(
 		TodoServiceDeleteTaskProcedure,
 		svc.DeleteTask,
 		connect.WithSchema(todoServiceMethods.ByName("DeleteTask")),
 		connect.WithHandlerOptions(opts...),
 	)
+	todoServiceArchiveTaskHandler := connect.NewUnaryHandler(
+		TodoServiceArchiveTaskProcedure,
+		svc.ArchiveTask,
+		connect.WithSchema(todoServiceMethods.ByName("ArchiveTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceUnarchiveTaskHandler := connect.NewUnaryHandler(
+		TodoServiceUnarchiveTaskProcedure,
+		svc.UnarchiveTask,
+		connect.WithSchema(todoServiceMethods.ByName("UnarchiveTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceListDeletedTasksHandler := connect.NewUnaryHandler(
+		TodoServiceListDeletedTasksProcedure,
+		svc.ListDeletedTasks,
+		connect.WithSchema(todoServiceMethods.ByName("ListDeletedTasks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceRestoreTaskHandler := connect.NewUnaryHandler(
+		TodoServiceRestoreTaskProcedure,
+		svc.RestoreTask,
+		connect.WithSchema(todoServiceMethods.ByName("RestoreTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServicePurgeTaskHandler := connect.NewUnaryHandler(
+		TodoServicePurgeTaskProcedure,
+		svc.PurgeTask,
+		connect.WithSchema(todoServiceMethods.ByName("PurgeTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceWatchTaskHandler := connect.NewUnaryHandler(
+		TodoServiceWatchTaskProcedure,
+		svc.WatchTask,
+		connect.WithSchema(todoServiceMethods.ByName("WatchTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceUnwatchTaskHandler := connect.NewUnaryHandler(
+		TodoServiceUnwatchTaskProcedure,
+		svc.UnwatchTask,
+		connect.WithSchema(todoServiceMethods.ByName("UnwatchTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceListTaskWatchersHandler := connect.NewUnaryHandler(
+		TodoServiceListTaskWatchersProcedure,
+		svc.ListTaskWatchers,
+		connect.WithSchema(todoServiceMethods.ByName("ListTaskWatchers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceListTaskHistoryHandler := connect.NewUnaryHandler(
+		TodoServiceListTaskHistoryProcedure,
+		svc.ListTaskHistory,
+		connect.WithSchema(todoServiceMethods.ByName("ListTaskHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceShareTaskHandler := connect.NewUnaryHandler(
+		TodoServiceShareTaskProcedure,
+		svc.ShareTask,
+		connect.WithSchema(todoServiceMethods.ByName("ShareTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceUnshareTaskHandler := connect.NewUnaryHandler(
+		TodoServiceUnshareTaskProcedure,
+		svc.UnshareTask,
+		connect.WithSchema(todoServiceMethods.ByName("UnshareTask")),
+		connect.WithHandlerOptions(opts...),
+	)
+	todoServiceListTaskSharesHandler := connect.NewUnaryHandler(
+		TodoServiceListTaskSharesProcedure,
+		svc.ListTaskShares,
+		connect.WithSchema(todoServiceMethods.ByName("ListTaskShares")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/todo.v1.TodoService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case TodoServiceCreateTaskProcedure:
@@ -221,12 +883,54 @@ func NewTodoServiceHandler(svc TodoServiceHandler, opts ...connect.HandlerOption
 			todoServiceListCompanyTasksHandler.ServeHTTP(w, r)
 		case TodoServiceListMyTasksProcedure:
 			todoServiceListMyTasksHandler.ServeHTTP(w, r)
+		case TodoServiceSearchTasksProcedure:
+			todoServiceSearchTasksHandler.ServeHTTP(w, r)
+		case TodoServiceListSubtasksProcedure:
+			todoServiceListSubtasksHandler.ServeHTTP(w, r)
+		case TodoServiceGetTaskTreeProcedure:
+			todoServiceGetTaskTreeHandler.ServeHTTP(w, r)
+		case TodoServiceAddTaskDependencyProcedure:
+			todoServiceAddTaskDependencyHandler.ServeHTTP(w, r)
+		case TodoServiceRemoveTaskDependencyProcedure:
+			todoServiceRemoveTaskDependencyHandler.ServeHTTP(w, r)
+		case TodoServiceListTaskBlockersProcedure:
+			todoServiceListTaskBlockersHandler.ServeHTTP(w, r)
+		case TodoServiceListTaskDependentsProcedure:
+			todoServiceListTaskDependentsHandler.ServeHTTP(w, r)
 		case TodoServiceGetTaskProcedure:
 			todoServiceGetTaskHandler.ServeHTTP(w, r)
 		case TodoServiceUpdateTaskProcedure:
 			todoServiceUpdateTaskHandler.ServeHTTP(w, r)
+		case TodoServiceGetTaskSeriesProcedure:
+			todoServiceGetTaskSeriesHandler.ServeHTTP(w, r)
+		case TodoServiceUpdateTaskSeriesProcedure:
+			todoServiceUpdateTaskSeriesHandler.ServeHTTP(w, r)
 		case TodoServiceDeleteTaskProcedure:
 			todoServiceDeleteTaskHandler.ServeHTTP(w, r)
+		case TodoServiceArchiveTaskProcedure:
+			todoServiceArchiveTaskHandler.ServeHTTP(w, r)
+		case TodoServiceUnarchiveTaskProcedure:
+			todoServiceUnarchiveTaskHandler.ServeHTTP(w, r)
+		case TodoServiceListDeletedTasksProcedure:
+			todoServiceListDeletedTasksHandler.ServeHTTP(w, r)
+		case TodoServiceRestoreTaskProcedure:
+			todoServiceRestoreTaskHandler.ServeHTTP(w, r)
+		case TodoServicePurgeTaskProcedure:
+			todoServicePurgeTaskHandler.ServeHTTP(w, r)
+		case TodoServiceWatchTaskProcedure:
+			todoServiceWatchTaskHandler.ServeHTTP(w, r)
+		case TodoServiceUnwatchTaskProcedure:
+			todoServiceUnwatchTaskHandler.ServeHTTP(w, r)
+		case TodoServiceListTaskWatchersProcedure:
+			todoServiceListTaskWatchersHandler.ServeHTTP(w, r)
+		case TodoServiceListTaskHistoryProcedure:
+			todoServiceListTaskHistoryHandler.ServeHTTP(w, r)
+		case TodoServiceShareTaskProcedure:
+			todoServiceShareTaskHandler.ServeHTTP(w, r)
+		case TodoServiceUnshareTaskProcedure:
+			todoServiceUnshareTaskHandler.ServeHTTP(w, r)
+		case TodoServiceListTaskSharesProcedure:
+			todoServiceListTaskSharesHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -248,6 +952,34 @@ func (UnimplementedTodoServiceHandler) ListMyTasks(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.ListMyTasks is not implemented"))
 }
 
+func (UnimplementedTodoServiceHandler) SearchTasks(context.Context, *connect.Request[v1.SearchTasksRequest]) (*connect.Response[v1.SearchTasksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.SearchTasks is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) ListSubtasks(context.Context, *connect.Request[v1.ListSubtasksRequest]) (*connect.Response[v1.ListSubtasksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.ListSubtasks is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) GetTaskTree(context.Context, *connect.Request[v1.GetTaskTreeRequest]) (*connect.Response[v1.GetTaskTreeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.GetTaskTree is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) AddTaskDependency(context.Context, *connect.Request[v1.AddTaskDependencyRequest]) (*connect.Response[v1.AddTaskDependencyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.AddTaskDependency is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) RemoveTaskDependency(context.Context, *connect.Request[v1.RemoveTaskDependencyRequest]) (*connect.Response[v1.RemoveTaskDependencyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.RemoveTaskDependency is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) ListTaskBlockers(context.Context, *connect.Request[v1.ListTaskBlockersRequest]) (*connect.Response[v1.ListTaskBlockersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.ListTaskBlockers is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) ListTaskDependents(context.Context, *connect.Request[v1.ListTaskDependentsRequest]) (*connect.Response[v1.ListTaskDependentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.ListTaskDependents is not implemented"))
+}
+
 func (UnimplementedTodoServiceHandler) GetTask(context.Context, *connect.Request[v1.GetTaskRequest]) (*connect.Response[v1.GetTaskResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.GetTask is not implemented"))
 }
@@ -256,6 +988,1348 @@ func (UnimplementedTodoServiceHandler) UpdateTask(context.Context, *connect.Requ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.UpdateTask is not implemented"))
 }
 
+func (UnimplementedTodoServiceHandler) GetTaskSeries(context.Context, *connect.Request[v1.GetTaskSeriesRequest]) (*connect.Response[v1.GetTaskSeriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.GetTaskSeries is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) UpdateTaskSeries(context.Context, *connect.Request[v1.UpdateTaskSeriesRequest]) (*connect.Response[v1.UpdateTaskSeriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.UpdateTaskSeries is not implemented"))
+}
+
 func (UnimplementedTodoServiceHandler) DeleteTask(context.Context, *connect.Request[v1.DeleteTaskRequest]) (*connect.Response[v1.DeleteTaskResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.DeleteTask is not implemented"))
 }
+
+func (UnimplementedTodoServiceHandler) ArchiveTask(context.Context, *connect.Request[v1.ArchiveTaskRequest]) (*connect.Response[v1.ArchiveTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.ArchiveTask is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) UnarchiveTask(context.Context, *connect.Request[v1.UnarchiveTaskRequest]) (*connect.Response[v1.UnarchiveTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.UnarchiveTask is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) ListDeletedTasks(context.Context, *connect.Request[v1.ListDeletedTasksRequest]) (*connect.Response[v1.ListDeletedTasksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.ListDeletedTasks is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) RestoreTask(context.Context, *connect.Request[v1.RestoreTaskRequest]) (*connect.Response[v1.RestoreTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.RestoreTask is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) PurgeTask(context.Context, *connect.Request[v1.PurgeTaskRequest]) (*connect.Response[v1.PurgeTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.PurgeTask is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) WatchTask(context.Context, *connect.Request[v1.WatchTaskRequest]) (*connect.Response[v1.WatchTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.WatchTask is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) UnwatchTask(context.Context, *connect.Request[v1.UnwatchTaskRequest]) (*connect.Response[v1.UnwatchTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.UnwatchTask is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) ListTaskWatchers(context.Context, *connect.Request[v1.ListTaskWatchersRequest]) (*connect.Response[v1.ListTaskWatchersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.ListTaskWatchers is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) ListTaskHistory(context.Context, *connect.Request[v1.ListTaskHistoryRequest]) (*connect.Response[v1.ListTaskHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.ListTaskHistory is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) ShareTask(context.Context, *connect.Request[v1.ShareTaskRequest]) (*connect.Response[v1.ShareTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.ShareTask is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) UnshareTask(context.Context, *connect.Request[v1.UnshareTaskRequest]) (*connect.Response[v1.UnshareTaskResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.UnshareTask is not implemented"))
+}
+
+func (UnimplementedTodoServiceHandler) ListTaskShares(context.Context, *connect.Request[v1.ListTaskSharesRequest]) (*connect.Response[v1.ListTaskSharesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TodoService.ListTaskShares is not implemented"))
+}
+
+// LabelServiceClient is a client for the todo.v1.LabelService service.
+type LabelServiceClient interface {
+	// CreateLabel adds a label to the catalog (Editor only)
+	CreateLabel(context.Context, *connect.Request[v1.CreateLabelRequest]) (*connect.Response[v1.CreateLabelResponse], error)
+	// ListLabels lists the catalog of the user's company
+	ListLabels(context.Context, *connect.Request[v1.ListLabelsRequest]) (*connect.Response[v1.ListLabelsResponse], error)
+	// UpdateLabel renames or recolors a label (Editor only)
+	UpdateLabel(context.Context, *connect.Request[v1.UpdateLabelRequest]) (*connect.Response[v1.UpdateLabelResponse], error)
+	// DeleteLabel removes a label from the catalog and all tasks (Editor only)
+	DeleteLabel(context.Context, *connect.Request[v1.DeleteLabelRequest]) (*connect.Response[v1.DeleteLabelResponse], error)
+}
+
+// NewLabelServiceClient constructs a client for the todo.v1.LabelService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewLabelServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) LabelServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	labelServiceMethods := v1.File_todo_v1_service_proto.Services().ByName("LabelService").Methods()
+	return &labelServiceClient{
+		createLabel: connect.NewClient[v1.CreateLabelRequest, v1.CreateLabelResponse](
+			httpClient,
+			baseURL+LabelServiceCreateLabelProcedure,
+			connect.WithSchema(labelServiceMethods.ByName("CreateLabel")),
+			connect.WithClientOptions(opts...),
+		),
+		listLabels: connect.NewClient[v1.ListLabelsRequest, v1.ListLabelsResponse](
+			httpClient,
+			baseURL+LabelServiceListLabelsProcedure,
+			connect.WithSchema(labelServiceMethods.ByName("ListLabels")),
+			connect.WithClientOptions(opts...),
+		),
+		updateLabel: connect.NewClient[v1.UpdateLabelRequest, v1.UpdateLabelResponse](
+			httpClient,
+			baseURL+LabelServiceUpdateLabelProcedure,
+			connect.WithSchema(labelServiceMethods.ByName("UpdateLabel")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteLabel: connect.NewClient[v1.DeleteLabelRequest, v1.DeleteLabelResponse](
+			httpClient,
+			baseURL+LabelServiceDeleteLabelProcedure,
+			connect.WithSchema(labelServiceMethods.ByName("DeleteLabel")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// labelServiceClient implements LabelServiceClient.
+type labelServiceClient struct {
+	createLabel *connect.Client[v1.CreateLabelRequest, v1.CreateLabelResponse]
+	listLabels  *connect.Client[v1.ListLabelsRequest, v1.ListLabelsResponse]
+	updateLabel *connect.Client[v1.UpdateLabelRequest, v1.UpdateLabelResponse]
+	deleteLabel *connect.Client[v1.DeleteLabelRequest, v1.DeleteLabelResponse]
+}
+
+// CreateLabel calls todo.v1.LabelService.CreateLabel.
+func (c *labelServiceClient) CreateLabel(ctx context.Context, req *connect.Request[v1.CreateLabelRequest]) (*connect.Response[v1.CreateLabelResponse], error) {
+	return c.createLabel.CallUnary(ctx, req)
+}
+
+// ListLabels calls todo.v1.LabelService.ListLabels.
+func (c *labelServiceClient) ListLabels(ctx context.Context, req *connect.Request[v1.ListLabelsRequest]) (*connect.Response[v1.ListLabelsResponse], error) {
+	return c.listLabels.CallUnary(ctx, req)
+}
+
+// UpdateLabel calls todo.v1.LabelService.UpdateLabel.
+func (c *labelServiceClient) UpdateLabel(ctx context.Context, req *connect.Request[v1.UpdateLabelRequest]) (*connect.Response[v1.UpdateLabelResponse], error) {
+	return c.updateLabel.CallUnary(ctx, req)
+}
+
+// DeleteLabel calls todo.v1.LabelService.DeleteLabel.
+func (c *labelServiceClient) DeleteLabel(ctx context.Context, req *connect.Request[v1.DeleteLabelRequest]) (*connect.Response[v1.DeleteLabelResponse], error) {
+	return c.deleteLabel.CallUnary(ctx, req)
+}
+
+// LabelServiceHandler is an implementation of the todo.v1.LabelService service.
+type LabelServiceHandler interface {
+	// CreateLabel adds a label to the catalog (Editor only)
+	CreateLabel(context.Context, *connect.Request[v1.CreateLabelRequest]) (*connect.Response[v1.CreateLabelResponse], error)
+	// ListLabels lists the catalog of the user's company
+	ListLabels(context.Context, *connect.Request[v1.ListLabelsRequest]) (*connect.Response[v1.ListLabelsResponse], error)
+	// UpdateLabel renames or recolors a label (Editor only)
+	UpdateLabel(context.Context, *connect.Request[v1.UpdateLabelRequest]) (*connect.Response[v1.UpdateLabelResponse], error)
+	// DeleteLabel removes a label from the catalog and all tasks (Editor only)
+	DeleteLabel(context.Context, *connect.Request[v1.DeleteLabelRequest]) (*connect.Response[v1.DeleteLabelResponse], error)
+}
+
+// NewLabelServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewLabelServiceHandler(svc LabelServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	labelServiceMethods := v1.File_todo_v1_service_proto.Services().ByName("LabelService").Methods()
+	labelServiceCreateLabelHandler := connect.NewUnaryHandler(
+		LabelServiceCreateLabelProcedure,
+		svc.CreateLabel,
+		connect.WithSchema(labelServiceMethods.ByName("CreateLabel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	labelServiceListLabelsHandler := connect.NewUnaryHandler(
+		LabelServiceListLabelsProcedure,
+		svc.ListLabels,
+		connect.WithSchema(labelServiceMethods.ByName("ListLabels")),
+		connect.WithHandlerOptions(opts...),
+	)
+	labelServiceUpdateLabelHandler := connect.NewUnaryHandler(
+		LabelServiceUpdateLabelProcedure,
+		svc.UpdateLabel,
+		connect.WithSchema(labelServiceMethods.ByName("UpdateLabel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	labelServiceDeleteLabelHandler := connect.NewUnaryHandler(
+		LabelServiceDeleteLabelProcedure,
+		svc.DeleteLabel,
+		connect.WithSchema(labelServiceMethods.ByName("DeleteLabel")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/todo.v1.LabelService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case LabelServiceCreateLabelProcedure:
+			labelServiceCreateLabelHandler.ServeHTTP(w, r)
+		case LabelServiceListLabelsProcedure:
+			labelServiceListLabelsHandler.ServeHTTP(w, r)
+		case LabelServiceUpdateLabelProcedure:
+			labelServiceUpdateLabelHandler.ServeHTTP(w, r)
+		case LabelServiceDeleteLabelProcedure:
+			labelServiceDeleteLabelHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedLabelServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedLabelServiceHandler struct{}
+
+func (UnimplementedLabelServiceHandler) CreateLabel(context.Context, *connect.Request[v1.CreateLabelRequest]) (*connect.Response[v1.CreateLabelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.LabelService.CreateLabel is not implemented"))
+}
+
+func (UnimplementedLabelServiceHandler) ListLabels(context.Context, *connect.Request[v1.ListLabelsRequest]) (*connect.Response[v1.ListLabelsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.LabelService.ListLabels is not implemented"))
+}
+
+func (UnimplementedLabelServiceHandler) UpdateLabel(context.Context, *connect.Request[v1.UpdateLabelRequest]) (*connect.Response[v1.UpdateLabelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.LabelService.UpdateLabel is not implemented"))
+}
+
+func (UnimplementedLabelServiceHandler) DeleteLabel(context.Context, *connect.Request[v1.DeleteLabelRequest]) (*connect.Response[v1.DeleteLabelResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.LabelService.DeleteLabel is not implemented"))
+}
+
+// CommentServiceClient is a client for the todo.v1.CommentService service.
+type CommentServiceClient interface {
+	// CreateComment comments on a visible task (any role)
+	CreateComment(context.Context, *connect.Request[v1.CreateCommentRequest]) (*connect.Response[v1.CreateCommentResponse], error)
+	// ListComments lists the comments on a visible task
+	ListComments(context.Context, *connect.Request[v1.ListCommentsRequest]) (*connect.Response[v1.ListCommentsResponse], error)
+	// EditComment edits a comment (author or Editor)
+	EditComment(context.Context, *connect.Request[v1.EditCommentRequest]) (*connect.Response[v1.EditCommentResponse], error)
+	// DeleteComment deletes a comment (author or Editor). Fails with
+	// FAILED_PRECONDITION while the comment has replies.
+	DeleteComment(context.Context, *connect.Request[v1.DeleteCommentRequest]) (*connect.Response[v1.DeleteCommentResponse], error)
+}
+
+// NewCommentServiceClient constructs a client for the todo.v1.CommentService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCommentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CommentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	commentServiceMethods := v1.File_todo_v1_service_proto.Services().ByName("CommentService").Methods()
+	return &commentServiceClient{
+		createComment: connect.NewClient[v1.CreateCommentRequest, v1.CreateCommentResponse](
+			httpClient,
+			baseURL+CommentServiceCreateCommentProcedure,
+			connect.WithSchema(commentServiceMethods.ByName("CreateComment")),
+			connect.WithClientOptions(opts...),
+		),
+		listComments: connect.NewClient[v1.ListCommentsRequest, v1.ListCommentsResponse](
+			httpClient,
+			baseURL+CommentServiceListCommentsProcedure,
+			connect.WithSchema(commentServiceMethods.ByName("ListComments")),
+			connect.WithClientOptions(opts...),
+		),
+		editComment: connect.NewClient[v1.EditCommentRequest, v1.EditCommentResponse](
+			httpClient,
+			baseURL+CommentServiceEditCommentProcedure,
+			connect.WithSchema(commentServiceMethods.ByName("EditComment")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteComment: connect.NewClient[v1.DeleteCommentRequest, v1.DeleteCommentResponse](
+			httpClient,
+			baseURL+CommentServiceDeleteCommentProcedure,
+			connect.WithSchema(commentServiceMethods.ByName("DeleteComment")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// commentServiceClient implements CommentServiceClient.
+type commentServiceClient struct {
+	createComment *connect.Client[v1.CreateCommentRequest, v1.CreateCommentResponse]
+	listComments  *connect.Client[v1.ListCommentsRequest, v1.ListCommentsResponse]
+	editComment   *connect.Client[v1.EditCommentRequest, v1.EditCommentResponse]
+	deleteComment *connect.Client[v1.DeleteCommentRequest, v1.DeleteCommentResponse]
+}
+
+// CreateComment calls todo.v1.CommentService.CreateComment.
+func (c *commentServiceClient) CreateComment(ctx context.Context, req *connect.Request[v1.CreateCommentRequest]) (*connect.Response[v1.CreateCommentResponse], error) {
+	return c.createComment.CallUnary(ctx, req)
+}
+
+// ListComments calls todo.v1.CommentService.ListComments.
+func (c *commentServiceClient) ListComments(ctx context.Context, req *connect.Request[v1.ListCommentsRequest]) (*connect.Response[v1.ListCommentsResponse], error) {
+	return c.listComments.CallUnary(ctx, req)
+}
+
+// EditComment calls todo.v1.CommentService.EditComment.
+func (c *commentServiceClient) EditComment(ctx context.Context, req *connect.Request[v1.EditCommentRequest]) (*connect.Response[v1.EditCommentResponse], error) {
+	return c.editComment.CallUnary(ctx, req)
+}
+
+// DeleteComment calls todo.v1.CommentService.DeleteComment.
+func (c *commentServiceClient) DeleteComment(ctx context.Context, req *connect.Request[v1.DeleteCommentRequest]) (*connect.Response[v1.DeleteCommentResponse], error) {
+	return c.deleteComment.CallUnary(ctx, req)
+}
+
+// CommentServiceHandler is an implementation of the todo.v1.CommentService service.
+type CommentServiceHandler interface {
+	// CreateComment comments on a visible task (any role)
+	CreateComment(context.Context, *connect.Request[v1.CreateCommentRequest]) (*connect.Response[v1.CreateCommentResponse], error)
+	// ListComments lists the comments on a visible task
+	ListComments(context.Context, *connect.Request[v1.ListCommentsRequest]) (*connect.Response[v1.ListCommentsResponse], error)
+	// EditComment edits a comment (author or Editor)
+	EditComment(context.Context, *connect.Request[v1.EditCommentRequest]) (*connect.Response[v1.EditCommentResponse], error)
+	// DeleteComment deletes a comment (author or Editor). Fails with
+	// FAILED_PRECONDITION while the comment has replies.
+	DeleteComment(context.Context, *connect.Request[v1.DeleteCommentRequest]) (*connect.Response[v1.DeleteCommentResponse], error)
+}
+
+// NewCommentServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCommentServiceHandler(svc CommentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	commentServiceMethods := v1.File_todo_v1_service_proto.Services().ByName("CommentService").Methods()
+	commentServiceCreateCommentHandler := connect.NewUnaryHandler(
+		CommentServiceCreateCommentProcedure,
+		svc.CreateComment,
+		connect.WithSchema(commentServiceMethods.ByName("CreateComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	commentServiceListCommentsHandler := connect.NewUnaryHandler(
+		CommentServiceListCommentsProcedure,
+		svc.ListComments,
+		connect.WithSchema(commentServiceMethods.ByName("ListComments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	commentServiceEditCommentHandler := connect.NewUnaryHandler(
+		CommentServiceEditCommentProcedure,
+		svc.EditComment,
+		connect.WithSchema(commentServiceMethods.ByName("EditComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	commentServiceDeleteCommentHandler := connect.NewUnaryHandler(
+		CommentServiceDeleteCommentProcedure,
+		svc.DeleteComment,
+		connect.WithSchema(commentServiceMethods.ByName("DeleteComment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/todo.v1.CommentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CommentServiceCreateCommentProcedure:
+			commentServiceCreateCommentHandler.ServeHTTP(w, r)
+		case CommentServiceListCommentsProcedure:
+			commentServiceListCommentsHandler.ServeHTTP(w, r)
+		case CommentServiceEditCommentProcedure:
+			commentServiceEditCommentHandler.ServeHTTP(w, r)
+		case CommentServiceDeleteCommentProcedure:
+			commentServiceDeleteCommentHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCommentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCommentServiceHandler struct{}
+
+func (UnimplementedCommentServiceHandler) CreateComment(context.Context, *connect.Request[v1.CreateCommentRequest]) (*connect.Response[v1.CreateCommentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.CommentService.CreateComment is not implemented"))
+}
+
+func (UnimplementedCommentServiceHandler) ListComments(context.Context, *connect.Request[v1.ListCommentsRequest]) (*connect.Response[v1.ListCommentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.CommentService.ListComments is not implemented"))
+}
+
+func (UnimplementedCommentServiceHandler) EditComment(context.Context, *connect.Request[v1.EditCommentRequest]) (*connect.Response[v1.EditCommentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.CommentService.EditComment is not implemented"))
+}
+
+func (UnimplementedCommentServiceHandler) DeleteComment(context.Context, *connect.Request[v1.DeleteCommentRequest]) (*connect.Response[v1.DeleteCommentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.CommentService.DeleteComment is not implemented"))
+}
+
+// NotificationServiceClient is a client for the todo.v1.NotificationService service.
+type NotificationServiceClient interface {
+	// ListNotifications lists your notifications
+	ListNotifications(context.Context, *connect.Request[v1.ListNotificationsRequest]) (*connect.Response[v1.ListNotificationsResponse], error)
+	// MarkNotificationsRead marks some or all of your notifications read
+	MarkNotificationsRead(context.Context, *connect.Request[v1.MarkNotificationsReadRequest]) (*connect.Response[v1.MarkNotificationsReadResponse], error)
+	// GetUnreadCount counts your unread notifications
+	GetUnreadCount(context.Context, *connect.Request[v1.GetUnreadCountRequest]) (*connect.Response[v1.GetUnreadCountResponse], error)
+}
+
+// NewNotificationServiceClient constructs a client for the todo.v1.NotificationService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewNotificationServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) NotificationServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	notificationServiceMethods := v1.File_todo_v1_service_proto.Services().ByName("NotificationService").Methods()
+	return &notificationServiceClient{
+		listNotifications: connect.NewClient[v1.ListNotificationsRequest, v1.ListNotificationsResponse](
+			httpClient,
+			baseURL+NotificationServiceListNotificationsProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("ListNotifications")),
+			connect.WithClientOptions(opts...),
+		),
+		markNotificationsRead: connect.NewClient[v1.MarkNotificationsReadRequest, v1.MarkNotificationsReadResponse](
+			httpClient,
+			baseURL+NotificationServiceMarkNotificationsReadProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("MarkNotificationsRead")),
+			connect.WithClientOptions(opts...),
+		),
+		getUnreadCount: connect.NewClient[v1.GetUnreadCountRequest, v1.GetUnreadCountResponse](
+			httpClient,
+			baseURL+NotificationServiceGetUnreadCountProcedure,
+			connect.WithSchema(notificationServiceMethods.ByName("GetUnreadCount")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// notificationServiceClient implements NotificationServiceClient.
+type notificationServiceClient struct {
+	listNotifications     *connect.Client[v1.ListNotificationsRequest, v1.ListNotificationsResponse]
+	markNotificationsRead *connect.Client[v1.MarkNotificationsReadRequest, v1.MarkNotificationsReadResponse]
+	getUnreadCount        *connect.Client[v1.GetUnreadCountRequest, v1.GetUnreadCountResponse]
+}
+
+// ListNotifications calls todo.v1.NotificationService.ListNotifications.
+func (c *notificationServiceClient) ListNotifications(ctx context.Context, req *connect.Request[v1.ListNotificationsRequest]) (*connect.Response[v1.ListNotificationsResponse], error) {
+	return c.listNotifications.CallUnary(ctx, req)
+}
+
+// MarkNotificationsRead calls todo.v1.NotificationService.MarkNotificationsRead.
+func (c *notificationServiceClient) MarkNotificationsRead(ctx context.Context, req *connect.Request[v1.MarkNotificationsReadRequest]) (*connect.Response[v1.MarkNotificationsReadResponse], error) {
+	return c.markNotificationsRead.CallUnary(ctx, req)
+}
+
+// GetUnreadCount calls todo.v1.NotificationService.GetUnreadCount.
+func (c *notificationServiceClient) GetUnreadCount(ctx context.Context, req *connect.Request[v1.GetUnreadCountRequest]) (*connect.Response[v1.GetUnreadCountResponse], error) {
+	return c.getUnreadCount.CallUnary(ctx, req)
+}
+
+// NotificationServiceHandler is an implementation of the todo.v1.NotificationService service.
+type NotificationServiceHandler interface {
+	// ListNotifications lists your notifications
+	ListNotifications(context.Context, *connect.Request[v1.ListNotificationsRequest]) (*connect.Response[v1.ListNotificationsResponse], error)
+	// MarkNotificationsRead marks some or all of your notifications read
+	MarkNotificationsRead(context.Context, *connect.Request[v1.MarkNotificationsReadRequest]) (*connect.Response[v1.MarkNotificationsReadResponse], error)
+	// GetUnreadCount counts your unread notifications
+	GetUnreadCount(context.Context, *connect.Request[v1.GetUnreadCountRequest]) (*connect.Response[v1.GetUnreadCountResponse], error)
+}
+
+// NewNotificationServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewNotificationServiceHandler(svc NotificationServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	notificationServiceMethods := v1.File_todo_v1_service_proto.Services().ByName("NotificationService").Methods()
+	notificationServiceListNotificationsHandler := connect.NewUnaryHandler(
+		NotificationServiceListNotificationsProcedure,
+		svc.ListNotifications,
+		connect.WithSchema(notificationServiceMethods.ByName("ListNotifications")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceMarkNotificationsReadHandler := connect.NewUnaryHandler(
+		NotificationServiceMarkNotificationsReadProcedure,
+		svc.MarkNotificationsRead,
+		connect.WithSchema(notificationServiceMethods.ByName("MarkNotificationsRead")),
+		connect.WithHandlerOptions(opts...),
+	)
+	notificationServiceGetUnreadCountHandler := connect.NewUnaryHandler(
+		NotificationServiceGetUnreadCountProcedure,
+		svc.GetUnreadCount,
+		connect.WithSchema(notificationServiceMethods.ByName("GetUnreadCount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/todo.v1.NotificationService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case NotificationServiceListNotificationsProcedure:
+			notificationServiceListNotificationsHandler.ServeHTTP(w, r)
+		case NotificationServiceMarkNotificationsReadProcedure:
+			notificationServiceMarkNotificationsReadHandler.ServeHTTP(w, r)
+		case NotificationServiceGetUnreadCountProcedure:
+			notificationServiceGetUnreadCountHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedNotificationServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedNotificationServiceHandler struct{}
+
+func (UnimplementedNotificationServiceHandler) ListNotifications(context.Context, *connect.Request[v1.ListNotificationsRequest]) (*connect.Response[v1.ListNotificationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.NotificationService.ListNotifications is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) MarkNotificationsRead(context.Context, *connect.Request[v1.MarkNotificationsReadRequest]) (*connect.Response[v1.MarkNotificationsReadResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.NotificationService.MarkNotificationsRead is not implemented"))
+}
+
+func (UnimplementedNotificationServiceHandler) GetUnreadCount(context.Context, *connect.Request[v1.GetUnreadCountRequest]) (*connect.Response[v1.GetUnreadCountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.NotificationService.GetUnreadCount is not implemented"))
+}
+
+// CompanyServiceClient is a client for the todo.v1.CompanyService service.
+type CompanyServiceClient interface {
+	// GetCompany returns your company and its settings
+	GetCompany(context.Context, *connect.Request[v1.GetCompanyRequest]) (*connect.Response[v1.GetCompanyResponse], error)
+	// UpdateCompanySettings changes company-wide settings (Admin only)
+	UpdateCompanySettings(context.Context, *connect.Request[v1.UpdateCompanySettingsRequest]) (*connect.Response[v1.UpdateCompanySettingsResponse], error)
+	// GetWorkflow returns your company's task statuses and allowed transitions
+	GetWorkflow(context.Context, *connect.Request[v1.GetWorkflowRequest]) (*connect.Response[v1.GetWorkflowResponse], error)
+	// UpdateWorkflow replaces your company's task statuses and allowed
+	// transitions (Admin only)
+	UpdateWorkflow(context.Context, *connect.Request[v1.UpdateWorkflowRequest]) (*connect.Response[v1.UpdateWorkflowResponse], error)
+}
+
+// NewCompanyServiceClient constructs a client for the todo.v1.CompanyService service. By default,
+// it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and
+// sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC()
+// or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewCompanyServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) CompanyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	companyServiceMethods := v1.File_todo_v1_service_proto.Services().ByName("CompanyService").Methods()
+	return &companyServiceClient{
+		getCompany: connect.NewClient[v1.GetCompanyRequest, v1.GetCompanyResponse](
+			httpClient,
+			baseURL+CompanyServiceGetCompanyProcedure,
+			connect.WithSchema(companyServiceMethods.ByName("GetCompany")),
+			connect.WithClientOptions(opts...),
+		),
+		updateCompanySettings: connect.NewClient[v1.UpdateCompanySettingsRequest, v1.UpdateCompanySettingsResponse](
+			httpClient,
+			baseURL+CompanyServiceUpdateCompanySettingsProcedure,
+			connect.WithSchema(companyServiceMethods.ByName("UpdateCompanySettings")),
+			connect.WithClientOptions(opts...),
+		),
+		getWorkflow: connect.NewClient[v1.GetWorkflowRequest, v1.GetWorkflowResponse](
+			httpClient,
+			baseURL+CompanyServiceGetWorkflowProcedure,
+			connect.WithSchema(companyServiceMethods.ByName("GetWorkflow")),
+			connect.WithClientOptions(opts...),
+		),
+		updateWorkflow: connect.NewClient[v1.UpdateWorkflowRequest, v1.UpdateWorkflowResponse](
+			httpClient,
+			baseURL+CompanyServiceUpdateWorkflowProcedure,
+			connect.WithSchema(companyServiceMethods.ByName("UpdateWorkflow")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// companyServiceClient implements CompanyServiceClient.
+type companyServiceClient struct {
+	getCompany            *connect.Client[v1.GetCompanyRequest, v1.GetCompanyResponse]
+	updateCompanySettings *connect.Client[v1.UpdateCompanySettingsRequest, v1.UpdateCompanySettingsResponse]
+	getWorkflow           *connect.Client[v1.GetWorkflowRequest, v1.GetWorkflowResponse]
+	updateWorkflow        *connect.Client[v1.UpdateWorkflowRequest, v1.UpdateWorkflowResponse]
+}
+
+// GetCompany calls todo.v1.CompanyService.GetCompany.
+func (c *companyServiceClient) GetCompany(ctx context.Context, req *connect.Request[v1.GetCompanyRequest]) (*connect.Response[v1.GetCompanyResponse], error) {
+	return c.getCompany.CallUnary(ctx, req)
+}
+
+// UpdateCompanySettings calls todo.v1.CompanyService.UpdateCompanySettings.
+func (c *companyServiceClient) UpdateCompanySettings(ctx context.Context, req *connect.Request[v1.UpdateCompanySettingsRequest]) (*connect.Response[v1.UpdateCompanySettingsResponse], error) {
+	return c.updateCompanySettings.CallUnary(ctx, req)
+}
+
+// GetWorkflow calls todo.v1.CompanyService.GetWorkflow.
+func (c *companyServiceClient) GetWorkflow(ctx context.Context, req *connect.Request[v1.GetWorkflowRequest]) (*connect.Response[v1.GetWorkflowResponse], error) {
+	return c.getWorkflow.CallUnary(ctx, req)
+}
+
+// UpdateWorkflow calls todo.v1.CompanyService.UpdateWorkflow.
+func (c *companyServiceClient) UpdateWorkflow(ctx context.Context, req *connect.Request[v1.UpdateWorkflowRequest]) (*connect.Response[v1.UpdateWorkflowResponse], error) {
+	return c.updateWorkflow.CallUnary(ctx, req)
+}
+
+// CompanyServiceHandler is an implementation of the todo.v1.CompanyService service.
+type CompanyServiceHandler interface {
+	// GetCompany returns your company and its settings
+	GetCompany(context.Context, *connect.Request[v1.GetCompanyRequest]) (*connect.Response[v1.GetCompanyResponse], error)
+	// UpdateCompanySettings changes company-wide settings (Admin only)
+	UpdateCompanySettings(context.Context, *connect.Request[v1.UpdateCompanySettingsRequest]) (*connect.Response[v1.UpdateCompanySettingsResponse], error)
+	// GetWorkflow returns your company's task statuses and allowed transitions
+	GetWorkflow(context.Context, *connect.Request[v1.GetWorkflowRequest]) (*connect.Response[v1.GetWorkflowResponse], error)
+	// UpdateWorkflow replaces your company's task statuses and allowed
+	// transitions (Admin only)
+	UpdateWorkflow(context.Context, *connect.Request[v1.UpdateWorkflowRequest]) (*connect.Response[v1.UpdateWorkflowResponse], error)
+}
+
+// NewCompanyServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewCompanyServiceHandler(svc CompanyServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	companyServiceMethods := v1.File_todo_v1_service_proto.Services().ByName("CompanyService").Methods()
+	companyServiceGetCompanyHandler := connect.NewUnaryHandler(
+		CompanyServiceGetCompanyProcedure,
+		svc.GetCompany,
+		connect.WithSchema(companyServiceMethods.ByName("GetCompany")),
+		connect.WithHandlerOptions(opts...),
+	)
+	companyServiceUpdateCompanySettingsHandler := connect.NewUnaryHandler(
+		CompanyServiceUpdateCompanySettingsProcedure,
+		svc.UpdateCompanySettings,
+		connect.WithSchema(companyServiceMethods.ByName("UpdateCompanySettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	companyServiceGetWorkflowHandler := connect.NewUnaryHandler(
+		CompanyServiceGetWorkflowProcedure,
+		svc.GetWorkflow,
+		connect.WithSchema(companyServiceMethods.ByName("GetWorkflow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	companyServiceUpdateWorkflowHandler := connect.NewUnaryHandler(
+		CompanyServiceUpdateWorkflowProcedure,
+		svc.UpdateWorkflow,
+		connect.WithSchema(companyServiceMethods.ByName("UpdateWorkflow")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/todo.v1.CompanyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case CompanyServiceGetCompanyProcedure:
+			companyServiceGetCompanyHandler.ServeHTTP(w, r)
+		case CompanyServiceUpdateCompanySettingsProcedure:
+			companyServiceUpdateCompanySettingsHandler.ServeHTTP(w, r)
+		case CompanyServiceGetWorkflowProcedure:
+			companyServiceGetWorkflowHandler.ServeHTTP(w, r)
+		case CompanyServiceUpdateWorkflowProcedure:
+			companyServiceUpdateWorkflowHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedCompanyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedCompanyServiceHandler struct{}
+
+func (UnimplementedCompanyServiceHandler) GetCompany(context.Context, *connect.Request[v1.GetCompanyRequest]) (*connect.Response[v1.GetCompanyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.CompanyService.GetCompany is not implemented"))
+}
+
+func (UnimplementedCompanyServiceHandler) UpdateCompanySettings(context.Context, *connect.Request[v1.UpdateCompanySettingsRequest]) (*connect.Response[v1.UpdateCompanySettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.CompanyService.UpdateCompanySettings is not implemented"))
+}
+
+func (UnimplementedCompanyServiceHandler) GetWorkflow(context.Context, *connect.Request[v1.GetWorkflowRequest]) (*connect.Response[v1.GetWorkflowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.CompanyService.GetWorkflow is not implemented"))
+}
+
+func (UnimplementedCompanyServiceHandler) UpdateWorkflow(context.Context, *connect.Request[v1.UpdateWorkflowRequest]) (*connect.Response[v1.UpdateWorkflowResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.CompanyService.UpdateWorkflow is not implemented"))
+}
+
+// UserServiceClient is a client for the todo.v1.UserService service.
+type UserServiceClient interface {
+	// ListUsers lists your company's users (any role)
+	ListUsers(context.Context, *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error)
+	// GetUser returns a user of your company (any role)
+	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
+	// InviteUser creates a single-use invite valid for 7 days (Admin only).
+	// Fails with ALREADY_EXISTS when the email is already in use.
+	InviteUser(context.Context, *connect.Request[v1.InviteUserRequest]) (*connect.Response[v1.InviteUserResponse], error)
+	// AcceptInvite creates the invited user. It needs no authentication; the
+	// token is the credential. Fails with FAILED_PRECONDITION when the invite
+	// was used or has expired.
+	AcceptInvite(context.Context, *connect.Request[v1.AcceptInviteRequest]) (*connect.Response[v1.AcceptInviteResponse], error)
+	// UpdateUserRole changes another user's role (Admin only). Nobody can
+	// change a user above their own role or grant a role above it.
+	UpdateUserRole(context.Context, *connect.Request[v1.UpdateUserRoleRequest]) (*connect.Response[v1.UpdateUserRoleResponse], error)
+	// DeactivateUser stops another user from signing in while keeping their
+	// tasks and history (Admin only)
+	DeactivateUser(context.Context, *connect.Request[v1.DeactivateUserRequest]) (*connect.Response[v1.DeactivateUserResponse], error)
+	// OffboardUser transfers the tasks another user created, reassigns or
+	// unassigns their tasks, and then deactivates them (Admin only). Nothing is
+	// deleted, and it can be retried for an already deactivated user.
+	OffboardUser(context.Context, *connect.Request[v1.OffboardUserRequest]) (*connect.Response[v1.OffboardUserResponse], error)
+}
+
+// NewUserServiceClient constructs a client for the todo.v1.UserService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) UserServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	userServiceMethods := v1.File_todo_v1_service_proto.Services().ByName("UserService").Methods()
+	return &userServiceClient{
+		listUsers: connect.NewClient[v1.ListUsersRequest, v1.ListUsersResponse](
+			httpClient,
+			baseURL+UserServiceListUsersProcedure,
+			connect.WithSchema(userServiceMethods.ByName("ListUsers")),
+			connect.WithClientOptions(opts...),
+		),
+		getUser: connect.NewClient[v1.GetUserRequest, v1.GetUserResponse](
+			httpClient,
+			baseURL+UserServiceGetUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("GetUser")),
+			connect.WithClientOptions(opts...),
+		),
+		inviteUser: connect.NewClient[v1.InviteUserRequest, v1.InviteUserResponse](
+			httpClient,
+			baseURL+UserServiceInviteUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("InviteUser")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptInvite: connect.NewClient[v1.AcceptInviteRequest, v1.AcceptInviteResponse](
+			httpClient,
+			baseURL+UserServiceAcceptInviteProcedure,
+			connect.WithSchema(userServiceMethods.ByName("AcceptInvite")),
+			connect.WithClientOptions(opts...),
+		),
+		updateUserRole: connect.NewClient[v1.UpdateUserRoleRequest, v1.UpdateUserRoleResponse](
+			httpClient,
+			baseURL+UserServiceUpdateUserRoleProcedure,
+			connect.WithSchema(userServiceMethods.ByName("UpdateUserRole")),
+			connect.WithClientOptions(opts...),
+		),
+		deactivateUser: connect.NewClient[v1.DeactivateUserRequest, v1.DeactivateUserResponse](
+			httpClient,
+			baseURL+UserServiceDeactivateUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("DeactivateUser")),
+			connect.WithClientOptions(opts...),
+		),
+		offboardUser: connect.NewClient[v1.OffboardUserRequest, v1.OffboardUserResponse](
+			httpClient,
+			baseURL+UserServiceOffboardUserProcedure,
+			connect.WithSchema(userServiceMethods.ByName("OffboardUser")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// userServiceClient implements UserServiceClient.
+type userServiceClient struct {
+	listUsers      *connect.Client[v1.ListUsersRequest, v1.ListUsersResponse]
+	getUser        *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
+	inviteUser     *connect.Client[v1.InviteUserRequest, v1.InviteUserResponse]
+	acceptInvite   *connect.Client[v1.AcceptInviteRequest, v1.AcceptInviteResponse]
+	updateUserRole *connect.Client[v1.UpdateUserRoleRequest, v1.UpdateUserRoleResponse]
+	deactivateUser *connect.Client[v1.DeactivateUserRequest, v1.DeactivateUserResponse]
+	offboardUser   *connect.Client[v1.OffboardUserRequest, v1.OffboardUserResponse]
+}
+
+// ListUsers calls todo.v1.UserService.ListUsers.
+func (c *userServiceClient) ListUsers(ctx context.Context, req *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error) {
+	return c.listUsers.CallUnary(ctx, req)
+}
+
+// GetUser calls todo.v1.UserService.GetUser.
+func (c *userServiceClient) GetUser(ctx context.Context, req *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
+	return c.getUser.CallUnary(ctx, req)
+}
+
+// InviteUser calls todo.v1.UserService.InviteUser.
+func (c *userServiceClient) InviteUser(ctx context.Context, req *connect.Request[v1.InviteUserRequest]) (*connect.Response[v1.InviteUserResponse], error) {
+	return c.inviteUser.CallUnary(ctx, req)
+}
+
+// AcceptInvite calls todo.v1.UserService.AcceptInvite.
+func (c *userServiceClient) AcceptInvite(ctx context.Context, req *connect.Request[v1.AcceptInviteRequest]) (*connect.Response[v1.AcceptInviteResponse], error) {
+	return c.acceptInvite.CallUnary(ctx, req)
+}
+
+// UpdateUserRole calls todo.v1.UserService.UpdateUserRole.
+func (c *userServiceClient) UpdateUserRole(ctx context.Context, req *connect.Request[v1.UpdateUserRoleRequest]) (*connect.Response[v1.UpdateUserRoleResponse], error) {
+	return c.updateUserRole.CallUnary(ctx, req)
+}
+
+// DeactivateUser calls todo.v1.UserService.DeactivateUser.
+func (c *userServiceClient) DeactivateUser(ctx context.Context, req *connect.Request[v1.DeactivateUserRequest]) (*connect.Response[v1.DeactivateUserResponse], error) {
+	return c.deactivateUser.CallUnary(ctx, req)
+}
+
+// OffboardUser calls todo.v1.UserService.OffboardUser.
+func (c *userServiceClient) OffboardUser(ctx context.Context, req *connect.Request[v1.OffboardUserRequest]) (*connect.Response[v1.OffboardUserResponse], error) {
+	return c.offboardUser.CallUnary(ctx, req)
+}
+
+// UserServiceHandler is an implementation of the todo.v1.UserService service.
+type UserServiceHandler interface {
+	// ListUsers lists your company's users (any role)
+	ListUsers(context.Context, *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error)
+	// GetUser returns a user of your company (any role)
+	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
+	// InviteUser creates a single-use invite valid for 7 days (Admin only).
+	// Fails with ALREADY_EXISTS when the email is already in use.
+	InviteUser(context.Context, *connect.Request[v1.InviteUserRequest]) (*connect.Response[v1.InviteUserResponse], error)
+	// AcceptInvite creates the invited user. It needs no authentication; the
+	// token is the credential. Fails with FAILED_PRECONDITION when the invite
+	// was used or has expired.
+	AcceptInvite(context.Context, *connect.Request[v1.AcceptInviteRequest]) (*connect.Response[v1.AcceptInviteResponse], error)
+	// UpdateUserRole changes another user's role (Admin only). Nobody can
+	// change a user above their own role or grant a role above it.
+	UpdateUserRole(context.Context, *connect.Request[v1.UpdateUserRoleRequest]) (*connect.Response[v1.UpdateUserRoleResponse], error)
+	// DeactivateUser stops another user from signing in while keeping their
+	// tasks and history (Admin only)
+	DeactivateUser(context.Context, *connect.Request[v1.DeactivateUserRequest]) (*connect.Response[v1.DeactivateUserResponse], error)
+	// OffboardUser transfers the tasks another user created, reassigns or
+	// unassigns their tasks, and then deactivates them (Admin only). Nothing is
+	// deleted, and it can be retried for an already deactivated user.
+	OffboardUser(context.Context, *connect.Request[v1.OffboardUserRequest]) (*connect.Response[v1.OffboardUserResponse], error)
+}
+
+// NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	userServiceMethods := v1.File_todo_v1_service_proto.Services().ByName("UserService").Methods()
+	userServiceListUsersHandler := connect.NewUnaryHandler(
+		UserServiceListUsersProcedure,
+		svc.ListUsers,
+		connect.WithSchema(userServiceMethods.ByName("ListUsers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetUserHandler := connect.NewUnaryHandler(
+		UserServiceGetUserProcedure,
+		svc.GetUser,
+		connect.WithSchema(userServiceMethods.ByName("GetUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceInviteUserHandler := connect.NewUnaryHandler(
+		UserServiceInviteUserProcedure,
+		svc.InviteUser,
+		connect.WithSchema(userServiceMethods.ByName("InviteUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceAcceptInviteHandler := connect.NewUnaryHandler(
+		UserServiceAcceptInviteProcedure,
+		svc.AcceptInvite,
+		connect.WithSchema(userServiceMethods.ByName("AcceptInvite")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceUpdateUserRoleHandler := connect.NewUnaryHandler(
+		UserServiceUpdateUserRoleProcedure,
+		svc.UpdateUserRole,
+		connect.WithSchema(userServiceMethods.ByName("UpdateUserRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeactivateUserHandler := connect.NewUnaryHandler(
+		UserServiceDeactivateUserProcedure,
+		svc.DeactivateUser,
+		connect.WithSchema(userServiceMethods.ByName("DeactivateUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceOffboardUserHandler := connect.NewUnaryHandler(
+		UserServiceOffboardUserProcedure,
+		svc.OffboardUser,
+		connect.WithSchema(userServiceMethods.ByName("OffboardUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/todo.v1.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case UserServiceListUsersProcedure:
+			userServiceListUsersHandler.ServeHTTP(w, r)
+		case UserServiceGetUserProcedure:
+			userServiceGetUserHandler.ServeHTTP(w, r)
+		case UserServiceInviteUserProcedure:
+			userServiceInviteUserHandler.ServeHTTP(w, r)
+		case UserServiceAcceptInviteProcedure:
+			userServiceAcceptInviteHandler.ServeHTTP(w, r)
+		case UserServiceUpdateUserRoleProcedure:
+			userServiceUpdateUserRoleHandler.ServeHTTP(w, r)
+		case UserServiceDeactivateUserProcedure:
+			userServiceDeactivateUserHandler.ServeHTTP(w, r)
+		case UserServiceOffboardUserProcedure:
+			userServiceOffboardUserHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedUserServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedUserServiceHandler struct{}
+
+func (UnimplementedUserServiceHandler) ListUsers(context.Context, *connect.Request[v1.ListUsersRequest]) (*connect.Response[v1.ListUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.UserService.ListUsers is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.UserService.GetUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) InviteUser(context.Context, *connect.Request[v1.InviteUserRequest]) (*connect.Response[v1.InviteUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.UserService.InviteUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) AcceptInvite(context.Context, *connect.Request[v1.AcceptInviteRequest]) (*connect.Response[v1.AcceptInviteResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.UserService.AcceptInvite is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) UpdateUserRole(context.Context, *connect.Request[v1.UpdateUserRoleRequest]) (*connect.Response[v1.UpdateUserRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.UserService.UpdateUserRole is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) DeactivateUser(context.Context, *connect.Request[v1.DeactivateUserRequest]) (*connect.Response[v1.DeactivateUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.UserService.DeactivateUser is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) OffboardUser(context.Context, *connect.Request[v1.OffboardUserRequest]) (*connect.Response[v1.OffboardUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.UserService.OffboardUser is not implemented"))
+}
+
+// TeamServiceClient is a client for the todo.v1.TeamService service.
+type TeamServiceClient interface {
+	// CreateTeam adds a team (Admin only)
+	CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error)
+	// ListTeams lists your company's teams (any role)
+	ListTeams(context.Context, *connect.Request[v1.ListTeamsRequest]) (*connect.Response[v1.ListTeamsResponse], error)
+	// UpdateTeam renames a team (Admin only)
+	UpdateTeam(context.Context, *connect.Request[v1.UpdateTeamRequest]) (*connect.Response[v1.UpdateTeamResponse], error)
+	// DeleteTeam removes a team and its memberships (Admin only). Fails with
+	// FAILED_PRECONDITION while tasks or series are visible to the team.
+	DeleteTeam(context.Context, *connect.Request[v1.DeleteTeamRequest]) (*connect.Response[v1.DeleteTeamResponse], error)
+	// AddTeamMember adds a user to a team (Admin only). Adding an existing
+	// member succeeds without changes.
+	AddTeamMember(context.Context, *connect.Request[v1.AddTeamMemberRequest]) (*connect.Response[v1.AddTeamMemberResponse], error)
+	// RemoveTeamMember takes a user out of a team (Admin only)
+	RemoveTeamMember(context.Context, *connect.Request[v1.RemoveTeamMemberRequest]) (*connect.Response[v1.RemoveTeamMemberResponse], error)
+	// ListTeamMembers lists a team's members (any role)
+	ListTeamMembers(context.Context, *connect.Request[v1.ListTeamMembersRequest]) (*connect.Response[v1.ListTeamMembersResponse], error)
+}
+
+// NewTeamServiceClient constructs a client for the todo.v1.TeamService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewTeamServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) TeamServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	teamServiceMethods := v1.File_todo_v1_service_proto.Services().ByName("TeamService").Methods()
+	return &teamServiceClient{
+		createTeam: connect.NewClient[v1.CreateTeamRequest, v1.CreateTeamResponse](
+			httpClient,
+			baseURL+TeamServiceCreateTeamProcedure,
+			connect.WithSchema(teamServiceMethods.ByName("CreateTeam")),
+			connect.WithClientOptions(opts...),
+		),
+		listTeams: connect.NewClient[v1.ListTeamsRequest, v1.ListTeamsResponse](
+			httpClient,
+			baseURL+TeamServiceListTeamsProcedure,
+			connect.WithSchema(teamServiceMethods.ByName("ListTeams")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTeam: connect.NewClient[v1.UpdateTeamRequest, v1.UpdateTeamResponse](
+			httpClient,
+			baseURL+TeamServiceUpdateTeamProcedure,
+			connect.WithSchema(teamServiceMethods.ByName("UpdateTeam")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteTeam: connect.NewClient[v1.DeleteTeamRequest, v1.DeleteTeamResponse](
+			httpClient,
+			baseURL+TeamServiceDeleteTeamProcedure,
+			connect.WithSchema(teamServiceMethods.ByName("DeleteTeam")),
+			connect.WithClientOptions(opts...),
+		),
+		addTeamMember: connect.NewClient[v1.AddTeamMemberRequest, v1.AddTeamMemberResponse](
+			httpClient,
+			baseURL+TeamServiceAddTeamMemberProcedure,
+			connect.WithSchema(teamServiceMethods.ByName("AddTeamMember")),
+			connect.WithClientOptions(opts...),
+		),
+		removeTeamMember: connect.NewClient[v1.RemoveTeamMemberRequest, v1.RemoveTeamMemberResponse](
+			httpClient,
+			baseURL+TeamServiceRemoveTeamMemberProcedure,
+			connect.WithSchema(teamServiceMethods.ByName("RemoveTeamMember")),
+			connect.WithClientOptions(opts...),
+		),
+		listTeamMembers: connect.NewClient[v1.ListTeamMembersRequest, v1.ListTeamMembersResponse](
+			httpClient,
+			baseURL+TeamServiceListTeamMembersProcedure,
+			connect.WithSchema(teamServiceMethods.ByName("ListTeamMembers")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// teamServiceClient implements TeamServiceClient.
+type teamServiceClient struct {
+	createTeam       *connect.Client[v1.CreateTeamRequest, v1.CreateTeamResponse]
+	listTeams        *connect.Client[v1.ListTeamsRequest, v1.ListTeamsResponse]
+	updateTeam       *connect.Client[v1.UpdateTeamRequest, v1.UpdateTeamResponse]
+	deleteTeam       *connect.Client[v1.DeleteTeamRequest, v1.DeleteTeamResponse]
+	addTeamMember    *connect.Client[v1.AddTeamMemberRequest, v1.AddTeamMemberResponse]
+	removeTeamMember *connect.Client[v1.RemoveTeamMemberRequest, v1.RemoveTeamMemberResponse]
+	listTeamMembers  *connect.Client[v1.ListTeamMembersRequest, v1.ListTeamMembersResponse]
+}
+
+// CreateTeam calls todo.v1.TeamService.CreateTeam.
+func (c *teamServiceClient) CreateTeam(ctx context.Context, req *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error) {
+	return c.createTeam.CallUnary(ctx, req)
+}
+
+// ListTeams calls todo.v1.TeamService.ListTeams.
+func (c *teamServiceClient) ListTeams(ctx context.Context, req *connect.Request[v1.ListTeamsRequest]) (*connect.Response[v1.ListTeamsResponse], error) {
+	return c.listTeams.CallUnary(ctx, req)
+}
+
+// UpdateTeam calls todo.v1.TeamService.UpdateTeam.
+func (c *teamServiceClient) UpdateTeam(ctx context.Context, req *connect.Request[v1.UpdateTeamRequest]) (*connect.Response[v1.UpdateTeamResponse], error) {
+	return c.updateTeam.CallUnary(ctx, req)
+}
+
+// DeleteTeam calls todo.v1.TeamService.DeleteTeam.
+func (c *teamServiceClient) DeleteTeam(ctx context.Context, req *connect.Request[v1.DeleteTeamRequest]) (*connect.Response[v1.DeleteTeamResponse], error) {
+	return c.deleteTeam.CallUnary(ctx, req)
+}
+
+// AddTeamMember calls todo.v1.TeamService.AddTeamMember.
+func (c *teamServiceClient) AddTeamMember(ctx context.Context, req *connect.Request[v1.AddTeamMemberRequest]) (*connect.Response[v1.AddTeamMemberResponse], error) {
+	return c.addTeamMember.CallUnary(ctx, req)
+}
+
+// RemoveTeamMember calls todo.v1.TeamService.RemoveTeamMember.
+func (c *teamServiceClient) RemoveTeamMember(ctx context.Context, req *connect.Request[v1.RemoveTeamMemberRequest]) (*connect.Response[v1.RemoveTeamMemberResponse], error) {
+	return c.removeTeamMember.CallUnary(ctx, req)
+}
+
+// ListTeamMembers calls todo.v1.TeamService.ListTeamMembers.
+func (c *teamServiceClient) ListTeamMembers(ctx context.Context, req *connect.Request[v1.ListTeamMembersRequest]) (*connect.Response[v1.ListTeamMembersResponse], error) {
+	return c.listTeamMembers.CallUnary(ctx, req)
+}
+
+// TeamServiceHandler is an implementation of the todo.v1.TeamService service.
+type TeamServiceHandler interface {
+	// CreateTeam adds a team (Admin only)
+	CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error)
+	// ListTeams lists your company's teams (any role)
+	ListTeams(context.Context, *connect.Request[v1.ListTeamsRequest]) (*connect.Response[v1.ListTeamsResponse], error)
+	// UpdateTeam renames a team (Admin only)
+	UpdateTeam(context.Context, *connect.Request[v1.UpdateTeamRequest]) (*connect.Response[v1.UpdateTeamResponse], error)
+	// DeleteTeam removes a team and its memberships (Admin only). Fails with
+	// FAILED_PRECONDITION while tasks or series are visible to the team.
+	DeleteTeam(context.Context, *connect.Request[v1.DeleteTeamRequest]) (*connect.Response[v1.DeleteTeamResponse], error)
+	// AddTeamMember adds a user to a team (Admin only). Adding an existing
+	// member succeeds without changes.
+	AddTeamMember(context.Context, *connect.Request[v1.AddTeamMemberRequest]) (*connect.Response[v1.AddTeamMemberResponse], error)
+	// RemoveTeamMember takes a user out of a team (Admin only)
+	RemoveTeamMember(context.Context, *connect.Request[v1.RemoveTeamMemberRequest]) (*connect.Response[v1.RemoveTeamMemberResponse], error)
+	// ListTeamMembers lists a team's members (any role)
+	ListTeamMembers(context.Context, *connect.Request[v1.ListTeamMembersRequest]) (*connect.Response[v1.ListTeamMembersResponse], error)
+}
+
+// NewTeamServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewTeamServiceHandler(svc TeamServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	teamServiceMethods := v1.File_todo_v1_service_proto.Services().ByName("TeamService").Methods()
+	teamServiceCreateTeamHandler := connect.NewUnaryHandler(
+		TeamServiceCreateTeamProcedure,
+		svc.CreateTeam,
+		connect.WithSchema(teamServiceMethods.ByName("CreateTeam")),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceListTeamsHandler := connect.NewUnaryHandler(
+		TeamServiceListTeamsProcedure,
+		svc.ListTeams,
+		connect.WithSchema(teamServiceMethods.ByName("ListTeams")),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceUpdateTeamHandler := connect.NewUnaryHandler(
+		TeamServiceUpdateTeamProcedure,
+		svc.UpdateTeam,
+		connect.WithSchema(teamServiceMethods.ByName("UpdateTeam")),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceDeleteTeamHandler := connect.NewUnaryHandler(
+		TeamServiceDeleteTeamProcedure,
+		svc.DeleteTeam,
+		connect.WithSchema(teamServiceMethods.ByName("DeleteTeam")),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceAddTeamMemberHandler := connect.NewUnaryHandler(
+		TeamServiceAddTeamMemberProcedure,
+		svc.AddTeamMember,
+		connect.WithSchema(teamServiceMethods.ByName("AddTeamMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceRemoveTeamMemberHandler := connect.NewUnaryHandler(
+		TeamServiceRemoveTeamMemberProcedure,
+		svc.RemoveTeamMember,
+		connect.WithSchema(teamServiceMethods.ByName("RemoveTeamMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	teamServiceListTeamMembersHandler := connect.NewUnaryHandler(
+		TeamServiceListTeamMembersProcedure,
+		svc.ListTeamMembers,
+		connect.WithSchema(teamServiceMethods.ByName("ListTeamMembers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/todo.v1.TeamService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case TeamServiceCreateTeamProcedure:
+			teamServiceCreateTeamHandler.ServeHTTP(w, r)
+		case TeamServiceListTeamsProcedure:
+			teamServiceListTeamsHandler.ServeHTTP(w, r)
+		case TeamServiceUpdateTeamProcedure:
+			teamServiceUpdateTeamHandler.ServeHTTP(w, r)
+		case TeamServiceDeleteTeamProcedure:
+			teamServiceDeleteTeamHandler.ServeHTTP(w, r)
+		case TeamServiceAddTeamMemberProcedure:
+			teamServiceAddTeamMemberHandler.ServeHTTP(w, r)
+		case TeamServiceRemoveTeamMemberProcedure:
+			teamServiceRemoveTeamMemberHandler.ServeHTTP(w, r)
+		case TeamServiceListTeamMembersProcedure:
+			teamServiceListTeamMembersHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedTeamServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedTeamServiceHandler struct{}
+
+func (UnimplementedTeamServiceHandler) CreateTeam(context.Context, *connect.Request[v1.CreateTeamRequest]) (*connect.Response[v1.CreateTeamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TeamService.CreateTeam is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) ListTeams(context.Context, *connect.Request[v1.ListTeamsRequest]) (*connect.Response[v1.ListTeamsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TeamService.ListTeams is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) UpdateTeam(context.Context, *connect.Request[v1.UpdateTeamRequest]) (*connect.Response[v1.UpdateTeamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TeamService.UpdateTeam is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) DeleteTeam(context.Context, *connect.Request[v1.DeleteTeamRequest]) (*connect.Response[v1.DeleteTeamResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TeamService.DeleteTeam is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) AddTeamMember(context.Context, *connect.Request[v1.AddTeamMemberRequest]) (*connect.Response[v1.AddTeamMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TeamService.AddTeamMember is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) RemoveTeamMember(context.Context, *connect.Request[v1.RemoveTeamMemberRequest]) (*connect.Response[v1.RemoveTeamMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TeamService.RemoveTeamMember is not implemented"))
+}
+
+func (UnimplementedTeamServiceHandler) ListTeamMembers(context.Context, *connect.Request[v1.ListTeamMembersRequest]) (*connect.Response[v1.ListTeamMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.TeamService.ListTeamMembers is not implemented"))
+}
+
+// AdminServiceClient is a client for the todo.v1.AdminService service.
+type AdminServiceClient interface {
+	// CreateCompany creates a company with the default workflow and an owner.
+	// Fails with ALREADY_EXISTS when the owner's email is already in use.
+	CreateCompany(context.Context, *connect.Request[v1.CreateCompanyRequest]) (*connect.Response[v1.CreateCompanyResponse], error)
+	// ListCompanies lists every company, suspended ones included
+	ListCompanies(context.Context, *connect.Request[v1.ListCompaniesRequest]) (*connect.Response[v1.ListCompaniesResponse], error)
+	// RenameCompany changes a company's name
+	RenameCompany(context.Context, *connect.Request[v1.RenameCompanyRequest]) (*connect.Response[v1.RenameCompanyResponse], error)
+	// SuspendCompany rejects every request from the company's users with
+	// PERMISSION_DENIED until it is unsuspended. Nothing is deleted.
+	SuspendCompany(context.Context, *connect.Request[v1.SuspendCompanyRequest]) (*connect.Response[v1.SuspendCompanyResponse], error)
+	// UnsuspendCompany lets the company's users sign in again
+	UnsuspendCompany(context.Context, *connect.Request[v1.UnsuspendCompanyRequest]) (*connect.Response[v1.UnsuspendCompanyResponse], error)
+}
+
+// NewAdminServiceClient constructs a client for the todo.v1.AdminService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	adminServiceMethods := v1.File_todo_v1_service_proto.Services().ByName("AdminService").Methods()
+	return &adminServiceClient{
+		createCompany: connect.NewClient[v1.CreateCompanyRequest, v1.CreateCompanyResponse](
+			httpClient,
+			baseURL+AdminServiceCreateCompanyProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("CreateCompany")),
+			connect.WithClientOptions(opts...),
+		),
+		listCompanies: connect.NewClient[v1.ListCompaniesRequest, v1.ListCompaniesResponse](
+			httpClient,
+			baseURL+AdminServiceListCompaniesProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListCompanies")),
+			connect.WithClientOptions(opts...),
+		),
+		renameCompany: connect.NewClient[v1.RenameCompanyRequest, v1.RenameCompanyResponse](
+			httpClient,
+			baseURL+AdminServiceRenameCompanyProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("RenameCompany")),
+			connect.WithClientOptions(opts...),
+		),
+		suspendCompany: connect.NewClient[v1.SuspendCompanyRequest, v1.SuspendCompanyResponse](
+			httpClient,
+			baseURL+AdminServiceSuspendCompanyProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SuspendCompany")),
+			connect.WithClientOptions(opts...),
+		),
+		unsuspendCompany: connect.NewClient[v1.UnsuspendCompanyRequest, v1.UnsuspendCompanyResponse](
+			httpClient,
+			baseURL+AdminServiceUnsuspendCompanyProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("UnsuspendCompany")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	createCompany    *connect.Client[v1.CreateCompanyRequest, v1.CreateCompanyResponse]
+	listCompanies    *connect.Client[v1.ListCompaniesRequest, v1.ListCompaniesResponse]
+	renameCompany    *connect.Client[v1.RenameCompanyRequest, v1.RenameCompanyResponse]
+	suspendCompany   *connect.Client[v1.SuspendCompanyRequest, v1.SuspendCompanyResponse]
+	unsuspendCompany *connect.Client[v1.UnsuspendCompanyRequest, v1.UnsuspendCompanyResponse]
+}
+
+// CreateCompany calls todo.v1.AdminService.CreateCompany.
+func (c *adminServiceClient) CreateCompany(ctx context.Context, req *connect.Request[v1.CreateCompanyRequest]) (*connect.Response[v1.CreateCompanyResponse], error) {
+	return c.createCompany.CallUnary(ctx, req)
+}
+
+// ListCompanies calls todo.v1.AdminService.ListCompanies.
+func (c *adminServiceClient) ListCompanies(ctx context.Context, req *connect.Request[v1.ListCompaniesRequest]) (*connect.Response[v1.ListCompaniesResponse], error) {
+	return c.listCompanies.CallUnary(ctx, req)
+}
+
+// RenameCompany calls todo.v1.AdminService.RenameCompany.
+func (c *adminServiceClient) RenameCompany(ctx context.Context, req *connect.Request[v1.RenameCompanyRequest]) (*connect.Response[v1.RenameCompanyResponse], error) {
+	return c.renameCompany.CallUnary(ctx, req)
+}
+
+// SuspendCompany calls todo.v1.AdminService.SuspendCompany.
+func (c *adminServiceClient) SuspendCompany(ctx context.Context, req *connect.Request[v1.SuspendCompanyRequest]) (*connect.Response[v1.SuspendCompanyResponse], error) {
+	return c.suspendCompany.CallUnary(ctx, req)
+}
+
+// UnsuspendCompany calls todo.v1.AdminService.UnsuspendCompany.
+func (c *adminServiceClient) UnsuspendCompany(ctx context.Context, req *connect.Request[v1.UnsuspendCompanyRequest]) (*connect.Response[v1.UnsuspendCompanyResponse], error) {
+	return c.unsuspendCompany.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the todo.v1.AdminService service.
+type AdminServiceHandler interface {
+	// CreateCompany creates a company with the default workflow and an owner.
+	// Fails with ALREADY_EXISTS when the owner's email is already in use.
+	CreateCompany(context.Context, *connect.Request[v1.CreateCompanyRequest]) (*connect.Response[v1.CreateCompanyResponse], error)
+	// ListCompanies lists every company, suspended ones included
+	ListCompanies(context.Context, *connect.Request[v1.ListCompaniesRequest]) (*connect.Response[v1.ListCompaniesResponse], error)
+	// RenameCompany changes a company's name
+	RenameCompany(context.Context, *connect.Request[v1.RenameCompanyRequest]) (*connect.Response[v1.RenameCompanyResponse], error)
+	// SuspendCompany rejects every request from the company's users with
+	// PERMISSION_DENIED until it is unsuspended. Nothing is deleted.
+	SuspendCompany(context.Context, *connect.Request[v1.SuspendCompanyRequest]) (*connect.Response[v1.SuspendCompanyResponse], error)
+	// UnsuspendCompany lets the company's users sign in again
+	UnsuspendCompany(context.Context, *connect.Request[v1.UnsuspendCompanyRequest]) (*connect.Response[v1.UnsuspendCompanyResponse], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceMethods := v1.File_todo_v1_service_proto.Services().ByName("AdminService").Methods()
+	adminServiceCreateCompanyHandler := connect.NewUnaryHandler(
+		AdminServiceCreateCompanyProcedure,
+		svc.CreateCompany,
+		connect.WithSchema(adminServiceMethods.ByName("CreateCompany")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListCompaniesHandler := connect.NewUnaryHandler(
+		AdminServiceListCompaniesProcedure,
+		svc.ListCompanies,
+		connect.WithSchema(adminServiceMethods.ByName("ListCompanies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceRenameCompanyHandler := connect.NewUnaryHandler(
+		AdminServiceRenameCompanyProcedure,
+		svc.RenameCompany,
+		connect.WithSchema(adminServiceMethods.ByName("RenameCompany")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSuspendCompanyHandler := connect.NewUnaryHandler(
+		AdminServiceSuspendCompanyProcedure,
+		svc.SuspendCompany,
+		connect.WithSchema(adminServiceMethods.ByName("SuspendCompany")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceUnsuspendCompanyHandler := connect.NewUnaryHandler(
+		AdminServiceUnsuspendCompanyProcedure,
+		svc.UnsuspendCompany,
+		connect.WithSchema(adminServiceMethods.ByName("UnsuspendCompany")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/todo.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceCreateCompanyProcedure:
+			adminServiceCreateCompanyHandler.ServeHTTP(w, r)
+		case AdminServiceListCompaniesProcedure:
+			adminServiceListCompaniesHandler.ServeHTTP(w, r)
+		case AdminServiceRenameCompanyProcedure:
+			adminServiceRenameCompanyHandler.ServeHTTP(w, r)
+		case AdminServiceSuspendCompanyProcedure:
+			adminServiceSuspendCompanyHandler.ServeHTTP(w, r)
+		case AdminServiceUnsuspendCompanyProcedure:
+			adminServiceUnsuspendCompanyHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) CreateCompany(context.Context, *connect.Request[v1.CreateCompanyRequest]) (*connect.Response[v1.CreateCompanyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.AdminService.CreateCompany is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListCompanies(context.Context, *connect.Request[v1.ListCompaniesRequest]) (*connect.Response[v1.ListCompaniesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.AdminService.ListCompanies is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) RenameCompany(context.Context, *connect.Request[v1.RenameCompanyRequest]) (*connect.Response[v1.RenameCompanyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.AdminService.RenameCompany is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SuspendCompany(context.Context, *connect.Request[v1.SuspendCompanyRequest]) (*connect.Response[v1.SuspendCompanyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.AdminService.SuspendCompany is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) UnsuspendCompany(context.Context, *connect.Request[v1.UnsuspendCompanyRequest]) (*connect.Response[v1.UnsuspendCompanyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("todo.v1.AdminService.UnsuspendCompany is not implemented"))
+}
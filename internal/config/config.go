@@ -15,15 +15,40 @@ type Config struct {
 	Version         string
 	JWTSecret       string
 	JWTDuration     time.Duration
+	// OperatorToken authenticates operators calling the AdminService; empty
+	// disables the service.
+	OperatorToken string
+	// RecurrenceInterval is how often due recurring tasks are generated;
+	// zero disables the scheduler.
+	RecurrenceInterval time.Duration
+	// DueReminderInterval is how often tasks are checked for approaching due
+	// dates; zero disables reminders. DueReminderWindow is how far ahead of
+	// the due date the reminder goes out.
+	DueReminderInterval time.Duration
+	DueReminderWindow   time.Duration
+	// TrashPurgeInterval is how often the trash is swept; zero disables the
+	// sweeper. TrashRetention is how long a deleted task can be restored.
+	TrashPurgeInterval time.Duration
+	TrashRetention     time.Duration
+	// AutoArchiveInterval is how often done tasks are checked against their
+	// company's auto-archive period; zero disables auto-archiving.
+	AutoArchiveInterval time.Duration
 }
 
 func Load() (*Config, error) {
 	cfg := &Config{
-		LogLevel:        getEnv("LOG_LEVEL", "info"),
-		ShutdownTimeout: getDurationEnv("SHUTDOWN_TIMEOUT", 30*time.Second),
-		Version:         getEnv("VERSION", "dev"),
-		JWTSecret:       getEnv("JWT_SECRET", "default-secret-change-in-production"),
-		JWTDuration:     getDurationEnv("JWT_DURATION", 24*time.Hour),
+		LogLevel:            getEnv("LOG_LEVEL", "info"),
+		ShutdownTimeout:     getDurationEnv("SHUTDOWN_TIMEOUT", 30*time.Second),
+		Version:             getEnv("VERSION", "dev"),
+		JWTSecret:           getEnv("JWT_SECRET", "default-secret-change-in-production"),
+		JWTDuration:         getDurationEnv("JWT_DURATION", 24*time.Hour),
+		OperatorToken:       os.Getenv("OPERATOR_TOKEN"),
+		RecurrenceInterval:  getDurationEnv("RECURRENCE_INTERVAL", time.Minute),
+		DueReminderInterval: getDurationEnv("DUE_REMINDER_INTERVAL", 5*time.Minute),
+		DueReminderWindow:   getDurationEnv("DUE_REMINDER_WINDOW", 24*time.Hour),
+		TrashPurgeInterval:  getDurationEnv("TRASH_PURGE_INTERVAL", time.Hour),
+		TrashRetention:      getDurationEnv("TRASH_RETENTION", 30*24*time.Hour),
+		AutoArchiveInterval: getDurationEnv("AUTO_ARCHIVE_INTERVAL", time.Hour),
 	}
 
 	cfg.DatabaseURL = os.Getenv("DATABASE_URL")
@@ -7,58 +7,291 @@ import (
 
 	grpcserver "github.com/pyshx/todoapp/internal/infra/grpc"
 	"github.com/pyshx/todoapp/internal/infra/postgres"
+	"github.com/pyshx/todoapp/internal/usecase/adminuc"
+	"github.com/pyshx/todoapp/internal/usecase/commentuc"
+	"github.com/pyshx/todoapp/internal/usecase/companyuc"
+	"github.com/pyshx/todoapp/internal/usecase/labeluc"
+	"github.com/pyshx/todoapp/internal/usecase/mentionuc"
+	"github.com/pyshx/todoapp/internal/usecase/notificationuc"
 	"github.com/pyshx/todoapp/internal/usecase/taskuc"
+	"github.com/pyshx/todoapp/internal/usecase/teamuc"
+	"github.com/pyshx/todoapp/internal/usecase/useruc"
+	"github.com/pyshx/todoapp/internal/worker"
 	"github.com/pyshx/todoapp/pkg/auth"
 	"github.com/pyshx/todoapp/pkg/idempotency"
 	"github.com/pyshx/todoapp/pkg/user"
 )
 
 type Container struct {
-	DBClient         *postgres.Client
-	UserRepo         user.Repo
-	TaskHandler      *grpcserver.TaskHandler
-	Server           *grpcserver.Server
-	JWTService       *auth.JWTService
-	IdempotencyStore idempotency.Store
+	DBClient            *postgres.Client
+	UserRepo            user.Repo
+	TaskHandler         *grpcserver.TaskHandler
+	LabelHandler        *grpcserver.LabelHandler
+	CommentHandler      *grpcserver.CommentHandler
+	NotificationHandler *grpcserver.NotificationHandler
+	CompanyHandler      *grpcserver.CompanyHandler
+	UserHandler         *grpcserver.UserHandler
+	TeamHandler         *grpcserver.TeamHandler
+	AdminHandler        *grpcserver.AdminHandler
+	Server              *grpcserver.Server
+	JWTService          *auth.JWTService
+	IdempotencyStore    idempotency.Store
+	Worker              *worker.Runner
 }
 
-func New(ctx context.Context, databaseURL string, grpcPort int, jwtSecret string, jwtDuration time.Duration, logger *slog.Logger) (*Container, error) {
+func New(ctx context.Context, databaseURL string, grpcPort int, jwtSecret string, jwtDuration time.Duration, operatorToken string, recurrenceInterval time.Duration, dueReminderInterval time.Duration, dueReminderWindow time.Duration, trashPurgeInterval time.Duration, trashRetention time.Duration, autoArchiveInterval time.Duration, logger *slog.Logger) (*Container, error) {
 	dbClient, err := postgres.NewClient(ctx, databaseURL)
 	if err != nil {
 		return nil, err
 	}
 
 	userRepo := postgres.NewUserRepo(dbClient)
+	companyRepo := postgres.NewCompanyRepo(dbClient)
 	taskRepo := postgres.NewTaskRepo(dbClient)
+	labelRepo := postgres.NewLabelRepo(dbClient)
+	dependencyRepo := postgres.NewTaskDependencyRepo(dbClient)
+	seriesRepo := postgres.NewTaskSeriesRepo(dbClient)
+	commentRepo := postgres.NewCommentRepo(dbClient)
+	mentionRepo := postgres.NewMentionRepo(dbClient)
+	notificationRepo := postgres.NewNotificationRepo(dbClient)
+	watcherRepo := postgres.NewTaskWatcherRepo(dbClient)
+	shareRepo := postgres.NewTaskShareRepo(dbClient)
+	historyRepo := postgres.NewTaskHistoryRepo(dbClient)
+	workflowRepo := postgres.NewWorkflowRepo(dbClient)
+	inviteRepo := postgres.NewInviteRepo(dbClient)
+	teamRepo := postgres.NewTeamRepo(dbClient)
 
 	jwtService := auth.NewJWTService(jwtSecret, jwtDuration)
 	idempotencyStore := idempotency.NewInMemoryStore(10 * time.Minute)
 
-	createTask := taskuc.NewCreateTask(taskRepo, userRepo)
+	recordMentions := mentionuc.NewRecordMentions(userRepo, mentionRepo, notificationRepo)
+	taskNotifier := notificationuc.NewTaskNotifier(notificationRepo, watcherRepo)
+
+	createTask := taskuc.NewCreateTask(taskRepo, userRepo, seriesRepo, workflowRepo, watcherRepo, recordMentions, taskNotifier)
 	listCompanyTasks := taskuc.NewListCompanyTasks(taskRepo)
 	listMyTasks := taskuc.NewListMyTasks(taskRepo)
+	searchTasks := taskuc.NewSearchTasks(taskRepo)
+	listSubtasks := taskuc.NewListSubtasks(taskRepo)
+	getTaskTree := taskuc.NewGetTaskTree(taskRepo)
 	getTask := taskuc.NewGetTask(taskRepo)
-	updateTask := taskuc.NewUpdateTask(taskRepo, userRepo)
+	updateTask := taskuc.NewUpdateTask(taskRepo, userRepo, dependencyRepo, seriesRepo, workflowRepo, watcherRepo, recordMentions, taskNotifier)
 	deleteTask := taskuc.NewDeleteTask(taskRepo)
+	addTaskDependency := taskuc.NewAddTaskDependency(taskRepo, dependencyRepo)
+	removeTaskDependency := taskuc.NewRemoveTaskDependency(taskRepo, dependencyRepo)
+	listTaskBlockers := taskuc.NewListTaskBlockers(taskRepo, dependencyRepo)
+	listTaskDependents := taskuc.NewListTaskDependents(taskRepo, dependencyRepo)
+	getTaskSeries := taskuc.NewGetTaskSeries(seriesRepo)
+	updateTaskSeries := taskuc.NewUpdateTaskSeries(seriesRepo, userRepo, watcherRepo, recordMentions, taskNotifier)
+	watchTask := taskuc.NewWatchTask(taskRepo, watcherRepo)
+	unwatchTask := taskuc.NewUnwatchTask(taskRepo, watcherRepo)
+	listTaskWatchers := taskuc.NewListTaskWatchers(taskRepo, watcherRepo)
+	shareTask := taskuc.NewShareTask(taskRepo, userRepo, shareRepo, watcherRepo)
+	unshareTask := taskuc.NewUnshareTask(taskRepo, shareRepo, watcherRepo)
+	listTaskShares := taskuc.NewListTaskShares(taskRepo)
+	listTaskHistory := taskuc.NewListTaskHistory(taskRepo, historyRepo)
+	listDeletedTasks := taskuc.NewListDeletedTasks(taskRepo)
+	restoreTask := taskuc.NewRestoreTask(taskRepo)
+	purgeTask := taskuc.NewPurgeTask(taskRepo)
+	purgeTrash := taskuc.NewPurgeTrash(taskRepo, trashRetention)
+	archiveTask := taskuc.NewArchiveTask(taskRepo, taskNotifier)
+	unarchiveTask := taskuc.NewUnarchiveTask(taskRepo, taskNotifier)
+	archiveCompletedTasks := taskuc.NewArchiveCompletedTasks(taskRepo)
+	generateRecurringTasks := taskuc.NewGenerateRecurringTasks(seriesRepo, workflowRepo, watcherRepo)
+	handOverTasks := taskuc.NewHandOverTasks(taskRepo, watcherRepo, taskNotifier)
 
 	taskHandler := grpcserver.NewTaskHandler(
 		createTask,
 		listCompanyTasks,
 		listMyTasks,
+		searchTasks,
+		listSubtasks,
+		getTaskTree,
 		getTask,
 		updateTask,
 		deleteTask,
+		addTaskDependency,
+		removeTaskDependency,
+		listTaskBlockers,
+		listTaskDependents,
+		getTaskSeries,
+		updateTaskSeries,
+		watchTask,
+		unwatchTask,
+		listTaskWatchers,
+		shareTask,
+		unshareTask,
+		listTaskShares,
+		listTaskHistory,
+		listDeletedTasks,
+		restoreTask,
+		purgeTask,
+		archiveTask,
+		unarchiveTask,
+	)
+
+	createLabel := labeluc.NewCreateLabel(labelRepo)
+	listLabels := labeluc.NewListLabels(labelRepo)
+	updateLabel := labeluc.NewUpdateLabel(labelRepo)
+	deleteLabel := labeluc.NewDeleteLabel(labelRepo)
+
+	labelHandler := grpcserver.NewLabelHandler(
+		createLabel,
+		listLabels,
+		updateLabel,
+		deleteLabel,
+	)
+
+	createComment := commentuc.NewCreateComment(commentRepo, taskRepo, recordMentions)
+	listComments := commentuc.NewListComments(commentRepo, taskRepo)
+	editComment := commentuc.NewEditComment(commentRepo, taskRepo, recordMentions)
+	deleteComment := commentuc.NewDeleteComment(commentRepo, taskRepo)
+
+	commentHandler := grpcserver.NewCommentHandler(
+		createComment,
+		listComments,
+		editComment,
+		deleteComment,
+	)
+
+	listNotifications := notificationuc.NewListNotifications(notificationRepo)
+	markNotificationsRead := notificationuc.NewMarkNotificationsRead(notificationRepo)
+	getUnreadCount := notificationuc.NewGetUnreadCount(notificationRepo)
+	sendDueReminders := notificationuc.NewSendDueReminders(notificationRepo, dueReminderWindow)
+
+	notificationHandler := grpcserver.NewNotificationHandler(
+		listNotifications,
+		markNotificationsRead,
+		getUnreadCount,
+	)
+
+	getCompany := companyuc.NewGetCompany(companyRepo)
+	updateCompanySettings := companyuc.NewUpdateCompanySettings(companyRepo)
+	getWorkflow := companyuc.NewGetWorkflow(workflowRepo)
+	updateWorkflow := companyuc.NewUpdateWorkflow(workflowRepo)
+
+	companyHandler := grpcserver.NewCompanyHandler(
+		getCompany,
+		updateCompanySettings,
+		getWorkflow,
+		updateWorkflow,
+	)
+
+	listUsers := useruc.NewListUsers(userRepo)
+	getUser := useruc.NewGetUser(userRepo)
+	inviteUser := useruc.NewInviteUser(userRepo, inviteRepo)
+	acceptInvite := useruc.NewAcceptInvite(inviteRepo)
+	updateUserRole := useruc.NewUpdateUserRole(userRepo)
+	deactivateUser := useruc.NewDeactivateUser(userRepo)
+	offboardUser := useruc.NewOffboardUser(userRepo, handOverTasks)
+
+	userHandler := grpcserver.NewUserHandler(
+		listUsers,
+		getUser,
+		inviteUser,
+		acceptInvite,
+		updateUserRole,
+		deactivateUser,
+		offboardUser,
+		jwtService,
+	)
+
+	createTeam := teamuc.NewCreateTeam(teamRepo)
+	listTeams := teamuc.NewListTeams(teamRepo)
+	updateTeam := teamuc.NewUpdateTeam(teamRepo)
+	deleteTeam := teamuc.NewDeleteTeam(teamRepo)
+	addTeamMember := teamuc.NewAddTeamMember(teamRepo, userRepo)
+	removeTeamMember := teamuc.NewRemoveTeamMember(teamRepo, userRepo, watcherRepo)
+	listTeamMembers := teamuc.NewListTeamMembers(teamRepo)
+
+	teamHandler := grpcserver.NewTeamHandler(
+		createTeam,
+		listTeams,
+		updateTeam,
+		deleteTeam,
+		addTeamMember,
+		removeTeamMember,
+		listTeamMembers,
+	)
+
+	createCompany := adminuc.NewCreateCompany(companyRepo)
+	listCompanies := adminuc.NewListCompanies(companyRepo)
+	renameCompany := adminuc.NewRenameCompany(companyRepo)
+	suspendCompany := adminuc.NewSuspendCompany(companyRepo)
+	unsuspendCompany := adminuc.NewUnsuspendCompany(companyRepo)
+
+	adminHandler := grpcserver.NewAdminHandler(
+		createCompany,
+		listCompanies,
+		renameCompany,
+		suspendCompany,
+		unsuspendCompany,
+		jwtService,
 	)
 
-	server := grpcserver.NewServer(grpcPort, taskHandler, userRepo, jwtService, idempotencyStore, logger)
+	server := grpcserver.NewServer(grpcPort, taskHandler, labelHandler, commentHandler, notificationHandler, companyHandler, userHandler, teamHandler, adminHandler, userRepo, companyRepo, operatorToken, jwtService, idempotencyStore, logger)
+
+	runner := worker.NewRunner(logger,
+		worker.Job{
+			Name:     "generate_recurring_tasks",
+			Interval: recurrenceInterval,
+			Run: func(ctx context.Context, now time.Time) error {
+				created, err := generateRecurringTasks.Execute(ctx, now)
+				if created > 0 {
+					logger.Info("generated recurring tasks", "count", created)
+				}
+				return err
+			},
+		},
+		worker.Job{
+			Name:     "send_due_reminders",
+			Interval: dueReminderInterval,
+			Run: func(ctx context.Context, now time.Time) error {
+				sent, err := sendDueReminders.Execute(ctx, now)
+				if sent > 0 {
+					logger.Info("sent due date reminders", "count", sent)
+				}
+				return err
+			},
+		},
+		worker.Job{
+			Name:     "purge_trash",
+			Interval: trashPurgeInterval,
+			Run: func(ctx context.Context, now time.Time) error {
+				purged, err := purgeTrash.Execute(ctx, now)
+				if purged > 0 {
+					logger.Info("purged deleted tasks", "count", purged)
+				}
+				return err
+			},
+		},
+		worker.Job{
+			Name:     "archive_completed_tasks",
+			Interval: autoArchiveInterval,
+			Run: func(ctx context.Context, now time.Time) error {
+				archived, err := archiveCompletedTasks.Execute(ctx, now)
+				if archived > 0 {
+					logger.Info("archived completed tasks", "count", archived)
+				}
+				return err
+			},
+		},
+	)
 
 	return &Container{
-		DBClient:         dbClient,
-		UserRepo:         userRepo,
-		TaskHandler:      taskHandler,
-		Server:           server,
-		JWTService:       jwtService,
-		IdempotencyStore: idempotencyStore,
+		DBClient:            dbClient,
+		UserRepo:            userRepo,
+		TaskHandler:         taskHandler,
+		LabelHandler:        labelHandler,
+		CommentHandler:      commentHandler,
+		NotificationHandler: notificationHandler,
+		CompanyHandler:      companyHandler,
+		UserHandler:         userHandler,
+		TeamHandler:         teamHandler,
+		AdminHandler:        adminHandler,
+		Server:              server,
+		JWTService:          jwtService,
+		IdempotencyStore:    idempotencyStore,
+		Worker:              runner,
 	}, nil
 }
 
@@ -10,6 +10,7 @@ type contextKey string
 
 const (
 	userContextKey      contextKey = "user"
+	operatorContextKey  contextKey = "operator"
 	requestIDContextKey contextKey = "request_id"
 )
 
@@ -22,6 +23,17 @@ func ContextWithUser(ctx context.Context, u *user.User) context.Context {
 	return context.WithValue(ctx, userContextKey, u)
 }
 
+// IsOperator reports whether the request was authenticated with the
+// operator token.
+func IsOperator(ctx context.Context) bool {
+	ok, _ := ctx.Value(operatorContextKey).(bool)
+	return ok
+}
+
+func ContextWithOperator(ctx context.Context) context.Context {
+	return context.WithValue(ctx, operatorContextKey, true)
+}
+
 func RequestIDFromContext(ctx context.Context) (string, bool) {
 	id, ok := ctx.Value(requestIDContextKey).(string)
 	return id, ok
@@ -37,6 +37,21 @@ func MapError(err error) error {
 		return connect.NewError(connect.CodeInvalidArgument, invalidInput)
 	}
 
+	var alreadyExists *apperr.ErrAlreadyExists
+	if errors.As(err, &alreadyExists) {
+		return connect.NewError(connect.CodeAlreadyExists, alreadyExists)
+	}
+
+	var failedPrecondition *apperr.ErrFailedPrecondition
+	if errors.As(err, &failedPrecondition) {
+		return connect.NewError(connect.CodeFailedPrecondition, failedPrecondition)
+	}
+
+	var taskBlocked *apperr.ErrTaskBlocked
+	if errors.As(err, &taskBlocked) {
+		return connect.NewError(connect.CodeFailedPrecondition, taskBlocked)
+	}
+
 	var unauth *apperr.ErrUnauthenticated
 	if errors.As(err, &unauth) {
 		return connect.NewError(connect.CodeUnauthenticated, unauth)
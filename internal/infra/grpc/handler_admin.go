@@ -0,0 +1,152 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	todov1 "github.com/pyshx/todoapp/gen/todo/v1"
+	"github.com/pyshx/todoapp/gen/todo/v1/todov1connect"
+	"github.com/pyshx/todoapp/internal/usecase/adminuc"
+	"github.com/pyshx/todoapp/pkg/auth"
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+// AdminHandler serves operators. The auth interceptor only lets requests
+// carrying the operator token through; each method checks for it again.
+type AdminHandler struct {
+	createCompany    *adminuc.CreateCompany
+	listCompanies    *adminuc.ListCompanies
+	renameCompany    *adminuc.RenameCompany
+	suspendCompany   *adminuc.SuspendCompany
+	unsuspendCompany *adminuc.UnsuspendCompany
+	jwtService       *auth.JWTService
+}
+
+func NewAdminHandler(
+	createCompany *adminuc.CreateCompany,
+	listCompanies *adminuc.ListCompanies,
+	renameCompany *adminuc.RenameCompany,
+	suspendCompany *adminuc.SuspendCompany,
+	unsuspendCompany *adminuc.UnsuspendCompany,
+	jwtService *auth.JWTService,
+) *AdminHandler {
+	return &AdminHandler{
+		createCompany:    createCompany,
+		listCompanies:    listCompanies,
+		renameCompany:    renameCompany,
+		suspendCompany:   suspendCompany,
+		unsuspendCompany: unsuspendCompany,
+		jwtService:       jwtService,
+	}
+}
+
+func (h *AdminHandler) CreateCompany(ctx context.Context, req *connect.Request[todov1.CreateCompanyRequest]) (*connect.Response[todov1.CreateCompanyResponse], error) {
+	if !IsOperator(ctx) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	c, owner, err := h.createCompany.Execute(ctx, adminuc.CreateCompanyInput{
+		Name:       req.Msg.Name,
+		OwnerEmail: req.Msg.OwnerEmail,
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	accessToken, err := h.jwtService.GenerateToken(owner.ID(), owner.CompanyID(), owner.Role().String())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&todov1.CreateCompanyResponse{
+		Company:          companyToProto(c),
+		Owner:            userToProto(owner),
+		OwnerAccessToken: accessToken,
+	}), nil
+}
+
+func (h *AdminHandler) ListCompanies(ctx context.Context, req *connect.Request[todov1.ListCompaniesRequest]) (*connect.Response[todov1.ListCompaniesResponse], error) {
+	if !IsOperator(ctx) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	companies, err := h.listCompanies.Execute(ctx)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	pbCompanies := make([]*todov1.Company, len(companies))
+	for i, c := range companies {
+		pbCompanies[i] = companyToProto(c)
+	}
+
+	return connect.NewResponse(&todov1.ListCompaniesResponse{
+		Companies: pbCompanies,
+	}), nil
+}
+
+func (h *AdminHandler) RenameCompany(ctx context.Context, req *connect.Request[todov1.RenameCompanyRequest]) (*connect.Response[todov1.RenameCompanyResponse], error) {
+	if !IsOperator(ctx) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	companyID, err := id.ParseCompanyID(req.Msg.CompanyId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	c, err := h.renameCompany.Execute(ctx, adminuc.RenameCompanyInput{
+		CompanyID: companyID,
+		Name:      req.Msg.Name,
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.RenameCompanyResponse{
+		Company: companyToProto(c),
+	}), nil
+}
+
+func (h *AdminHandler) SuspendCompany(ctx context.Context, req *connect.Request[todov1.SuspendCompanyRequest]) (*connect.Response[todov1.SuspendCompanyResponse], error) {
+	if !IsOperator(ctx) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	companyID, err := id.ParseCompanyID(req.Msg.CompanyId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	c, err := h.suspendCompany.Execute(ctx, companyID)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.SuspendCompanyResponse{
+		Company: companyToProto(c),
+	}), nil
+}
+
+func (h *AdminHandler) UnsuspendCompany(ctx context.Context, req *connect.Request[todov1.UnsuspendCompanyRequest]) (*connect.Response[todov1.UnsuspendCompanyResponse], error) {
+	if !IsOperator(ctx) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	companyID, err := id.ParseCompanyID(req.Msg.CompanyId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	c, err := h.unsuspendCompany.Execute(ctx, companyID)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.UnsuspendCompanyResponse{
+		Company: companyToProto(c),
+	}), nil
+}
+
+var _ todov1connect.AdminServiceHandler = (*AdminHandler)(nil)
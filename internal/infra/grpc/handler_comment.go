@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/pyshx/todoapp/gen/todo/v1"
+	"github.com/pyshx/todoapp/gen/todo/v1/todov1connect"
+	"github.com/pyshx/todoapp/internal/infra/postgres"
+	"github.com/pyshx/todoapp/internal/usecase/commentuc"
+	"github.com/pyshx/todoapp/pkg/comment"
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+type CommentHandler struct {
+	createComment *commentuc.CreateComment
+	listComments  *commentuc.ListComments
+	editComment   *commentuc.EditComment
+	deleteComment *commentuc.DeleteComment
+}
+
+func NewCommentHandler(
+	createComment *commentuc.CreateComment,
+	listComments *commentuc.ListComments,
+	editComment *commentuc.EditComment,
+	deleteComment *commentuc.DeleteComment,
+) *CommentHandler {
+	return &CommentHandler{
+		createComment: createComment,
+		listComments:  listComments,
+		editComment:   editComment,
+		deleteComment: deleteComment,
+	}
+}
+
+func (h *CommentHandler) CreateComment(ctx context.Context, req *connect.Request[todov1.CreateCommentRequest]) (*connect.Response[todov1.CreateCommentResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var parentID *id.CommentID
+	if req.Msg.ParentId != nil && *req.Msg.ParentId != "" {
+		pid, err := id.ParseCommentID(*req.Msg.ParentId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		parentID = &pid
+	}
+
+	c, err := h.createComment.Execute(ctx, actor, commentuc.CreateCommentInput{
+		TaskID:   taskID,
+		ParentID: parentID,
+		Body:     req.Msg.Body,
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.CreateCommentResponse{
+		Comment: commentToProto(c),
+	}), nil
+}
+
+func (h *CommentHandler) ListComments(ctx context.Context, req *connect.Request[todov1.ListCommentsRequest]) (*connect.Response[todov1.ListCommentsResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	cursor, err := postgres.DecodeCommentCursor(req.Msg.PageToken, taskID)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	result, err := h.listComments.Execute(ctx, actor, commentuc.ListCommentsInput{
+		TaskID:   taskID,
+		PageSize: int(req.Msg.PageSize),
+		Cursor:   cursor,
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	comments := make([]*todov1.Comment, len(result.Comments))
+	for i, c := range result.Comments {
+		comments[i] = commentToProto(c)
+	}
+
+	return connect.NewResponse(&todov1.ListCommentsResponse{
+		Comments:      comments,
+		NextPageToken: postgres.EncodeCommentCursor(result.NextCursor, taskID),
+	}), nil
+}
+
+func (h *CommentHandler) EditComment(ctx context.Context, req *connect.Request[todov1.EditCommentRequest]) (*connect.Response[todov1.EditCommentResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	commentID, err := id.ParseCommentID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	c, err := h.editComment.Execute(ctx, actor, commentuc.EditCommentInput{
+		CommentID: commentID,
+		Body:      req.Msg.Body,
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.EditCommentResponse{
+		Comment: commentToProto(c),
+	}), nil
+}
+
+func (h *CommentHandler) DeleteComment(ctx context.Context, req *connect.Request[todov1.DeleteCommentRequest]) (*connect.Response[todov1.DeleteCommentResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	commentID, err := id.ParseCommentID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.deleteComment.Execute(ctx, actor, commentID); err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.DeleteCommentResponse{}), nil
+}
+
+func commentToProto(c *comment.Comment) *todov1.Comment {
+	pb := &todov1.Comment{
+		Id:        c.ID().String(),
+		TaskId:    c.TaskID().String(),
+		AuthorId:  c.AuthorID().String(),
+		Body:      c.Body(),
+		CreatedAt: timestamppb.New(c.CreatedAt()),
+	}
+
+	if c.ParentID() != nil {
+		s := c.ParentID().String()
+		pb.ParentId = &s
+	}
+	if c.EditedAt() != nil {
+		pb.EditedAt = timestamppb.New(*c.EditedAt())
+	}
+
+	return pb
+}
+
+var _ todov1connect.CommentServiceHandler = (*CommentHandler)(nil)
@@ -0,0 +1,153 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/pyshx/todoapp/gen/todo/v1"
+	"github.com/pyshx/todoapp/gen/todo/v1/todov1connect"
+	"github.com/pyshx/todoapp/internal/usecase/companyuc"
+	"github.com/pyshx/todoapp/pkg/company"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+type CompanyHandler struct {
+	getCompany            *companyuc.GetCompany
+	updateCompanySettings *companyuc.UpdateCompanySettings
+	getWorkflow           *companyuc.GetWorkflow
+	updateWorkflow        *companyuc.UpdateWorkflow
+}
+
+func NewCompanyHandler(
+	getCompany *companyuc.GetCompany,
+	updateCompanySettings *companyuc.UpdateCompanySettings,
+	getWorkflow *companyuc.GetWorkflow,
+	updateWorkflow *companyuc.UpdateWorkflow,
+) *CompanyHandler {
+	return &CompanyHandler{
+		getCompany:            getCompany,
+		updateCompanySettings: updateCompanySettings,
+		getWorkflow:           getWorkflow,
+		updateWorkflow:        updateWorkflow,
+	}
+}
+
+func (h *CompanyHandler) GetCompany(ctx context.Context, req *connect.Request[todov1.GetCompanyRequest]) (*connect.Response[todov1.GetCompanyResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	c, err := h.getCompany.Execute(ctx, actor)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.GetCompanyResponse{
+		Company: companyToProto(c),
+	}), nil
+}
+
+func (h *CompanyHandler) UpdateCompanySettings(ctx context.Context, req *connect.Request[todov1.UpdateCompanySettingsRequest]) (*connect.Response[todov1.UpdateCompanySettingsResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	var input companyuc.UpdateCompanySettingsInput
+	if req.Msg.AutoArchiveAfterDays != nil {
+		days := int(*req.Msg.AutoArchiveAfterDays)
+		input.AutoArchiveAfterDays = &days
+	}
+
+	c, err := h.updateCompanySettings.Execute(ctx, actor, input)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.UpdateCompanySettingsResponse{
+		Company: companyToProto(c),
+	}), nil
+}
+
+func (h *CompanyHandler) GetWorkflow(ctx context.Context, req *connect.Request[todov1.GetWorkflowRequest]) (*connect.Response[todov1.GetWorkflowResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	w, err := h.getWorkflow.Execute(ctx, actor)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.GetWorkflowResponse{
+		Workflow: workflowToProto(w),
+	}), nil
+}
+
+func (h *CompanyHandler) UpdateWorkflow(ctx context.Context, req *connect.Request[todov1.UpdateWorkflowRequest]) (*connect.Response[todov1.UpdateWorkflowResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	var input companyuc.UpdateWorkflowInput
+	for _, s := range req.Msg.Workflow.GetStatuses() {
+		input.Statuses = append(input.Statuses, task.WorkflowStatus{
+			Key:      task.Status(s.Key),
+			Name:     s.Name,
+			Category: protoToCategory(s.Category),
+		})
+	}
+	for _, t := range req.Msg.Workflow.GetTransitions() {
+		input.Transitions = append(input.Transitions, task.Transition{
+			From: task.Status(t.From),
+			To:   task.Status(t.To),
+		})
+	}
+
+	w, err := h.updateWorkflow.Execute(ctx, actor, input)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.UpdateWorkflowResponse{
+		Workflow: workflowToProto(w),
+	}), nil
+}
+
+func companyToProto(c *company.Company) *todov1.Company {
+	pb := &todov1.Company{
+		Id:                   c.ID().String(),
+		Name:                 c.Name(),
+		AutoArchiveAfterDays: int32(c.AutoArchiveAfterDays()),
+		CreatedAt:            timestamppb.New(c.CreatedAt()),
+	}
+	if c.SuspendedAt() != nil {
+		pb.SuspendedAt = timestamppb.New(*c.SuspendedAt())
+	}
+	return pb
+}
+
+func workflowToProto(w *task.Workflow) *todov1.Workflow {
+	pb := &todov1.Workflow{}
+	for _, s := range w.Statuses() {
+		pb.Statuses = append(pb.Statuses, &todov1.WorkflowStatus{
+			Key:      s.Key.String(),
+			Name:     s.Name,
+			Category: categoryToProto(s.Category),
+		})
+	}
+	for _, t := range w.Transitions() {
+		pb.Transitions = append(pb.Transitions, &todov1.WorkflowTransition{
+			From: t.From.String(),
+			To:   t.To.String(),
+		})
+	}
+	return pb
+}
+
+var _ todov1connect.CompanyServiceHandler = (*CompanyHandler)(nil)
@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/pyshx/todoapp/gen/todo/v1"
+	"github.com/pyshx/todoapp/gen/todo/v1/todov1connect"
+	"github.com/pyshx/todoapp/internal/usecase/labeluc"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/label"
+)
+
+type LabelHandler struct {
+	createLabel *labeluc.CreateLabel
+	listLabels  *labeluc.ListLabels
+	updateLabel *labeluc.UpdateLabel
+	deleteLabel *labeluc.DeleteLabel
+}
+
+func NewLabelHandler(
+	createLabel *labeluc.CreateLabel,
+	listLabels *labeluc.ListLabels,
+	updateLabel *labeluc.UpdateLabel,
+	deleteLabel *labeluc.DeleteLabel,
+) *LabelHandler {
+	return &LabelHandler{
+		createLabel: createLabel,
+		listLabels:  listLabels,
+		updateLabel: updateLabel,
+		deleteLabel: deleteLabel,
+	}
+}
+
+func (h *LabelHandler) CreateLabel(ctx context.Context, req *connect.Request[todov1.CreateLabelRequest]) (*connect.Response[todov1.CreateLabelResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	l, err := h.createLabel.Execute(ctx, actor, labeluc.CreateLabelInput{
+		Name:  req.Msg.Name,
+		Color: req.Msg.Color,
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.CreateLabelResponse{
+		Label: labelToProto(l),
+	}), nil
+}
+
+func (h *LabelHandler) ListLabels(ctx context.Context, req *connect.Request[todov1.ListLabelsRequest]) (*connect.Response[todov1.ListLabelsResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	labels, err := h.listLabels.Execute(ctx, actor)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	pbLabels := make([]*todov1.Label, len(labels))
+	for i, l := range labels {
+		pbLabels[i] = labelToProto(l)
+	}
+
+	return connect.NewResponse(&todov1.ListLabelsResponse{
+		Labels: pbLabels,
+	}), nil
+}
+
+func (h *LabelHandler) UpdateLabel(ctx context.Context, req *connect.Request[todov1.UpdateLabelRequest]) (*connect.Response[todov1.UpdateLabelResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	labelID, err := id.ParseLabelID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	l, err := h.updateLabel.Execute(ctx, actor, labeluc.UpdateLabelInput{
+		LabelID: labelID,
+		Name:    req.Msg.Name,
+		Color:   req.Msg.Color,
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.UpdateLabelResponse{
+		Label: labelToProto(l),
+	}), nil
+}
+
+func (h *LabelHandler) DeleteLabel(ctx context.Context, req *connect.Request[todov1.DeleteLabelRequest]) (*connect.Response[todov1.DeleteLabelResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	labelID, err := id.ParseLabelID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.deleteLabel.Execute(ctx, actor, labelID); err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.DeleteLabelResponse{}), nil
+}
+
+func labelToProto(l *label.Label) *todov1.Label {
+	return &todov1.Label{
+		Id:        l.ID().String(),
+		CompanyId: l.CompanyID().String(),
+		Name:      l.Name(),
+		Color:     l.Color(),
+		CreatedAt: timestamppb.New(l.CreatedAt()),
+	}
+}
+
+var _ todov1connect.LabelServiceHandler = (*LabelHandler)(nil)
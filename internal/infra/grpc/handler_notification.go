@@ -0,0 +1,154 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/pyshx/todoapp/gen/todo/v1"
+	"github.com/pyshx/todoapp/gen/todo/v1/todov1connect"
+	"github.com/pyshx/todoapp/internal/infra/postgres"
+	"github.com/pyshx/todoapp/internal/usecase/notificationuc"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/notification"
+)
+
+type NotificationHandler struct {
+	listNotifications     *notificationuc.ListNotifications
+	markNotificationsRead *notificationuc.MarkNotificationsRead
+	getUnreadCount        *notificationuc.GetUnreadCount
+}
+
+func NewNotificationHandler(
+	listNotifications *notificationuc.ListNotifications,
+	markNotificationsRead *notificationuc.MarkNotificationsRead,
+	getUnreadCount *notificationuc.GetUnreadCount,
+) *NotificationHandler {
+	return &NotificationHandler{
+		listNotifications:     listNotifications,
+		markNotificationsRead: markNotificationsRead,
+		getUnreadCount:        getUnreadCount,
+	}
+}
+
+func (h *NotificationHandler) ListNotifications(ctx context.Context, req *connect.Request[todov1.ListNotificationsRequest]) (*connect.Response[todov1.ListNotificationsResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	cursor, err := postgres.DecodeNotificationCursor(req.Msg.PageToken, actor.ID())
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	result, err := h.listNotifications.Execute(ctx, actor, notificationuc.ListNotificationsInput{
+		PageSize:   int(req.Msg.PageSize),
+		Cursor:     cursor,
+		UnreadOnly: req.Msg.UnreadOnly,
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	notifications := make([]*todov1.Notification, len(result.Notifications))
+	for i, n := range result.Notifications {
+		notifications[i] = notificationToProto(n)
+	}
+
+	return connect.NewResponse(&todov1.ListNotificationsResponse{
+		Notifications: notifications,
+		NextPageToken: postgres.EncodeNotificationCursor(result.NextCursor, actor.ID()),
+	}), nil
+}
+
+func (h *NotificationHandler) MarkNotificationsRead(ctx context.Context, req *connect.Request[todov1.MarkNotificationsReadRequest]) (*connect.Response[todov1.MarkNotificationsReadResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	notificationIDs := make([]id.NotificationID, len(req.Msg.NotificationIds))
+	for i, s := range req.Msg.NotificationIds {
+		notificationID, err := id.ParseNotificationID(s)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		notificationIDs[i] = notificationID
+	}
+
+	marked, err := h.markNotificationsRead.Execute(ctx, actor, notificationuc.MarkNotificationsReadInput{
+		NotificationIDs: notificationIDs,
+		All:             req.Msg.All,
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.MarkNotificationsReadResponse{
+		MarkedCount: int32(marked),
+	}), nil
+}
+
+func (h *NotificationHandler) GetUnreadCount(ctx context.Context, req *connect.Request[todov1.GetUnreadCountRequest]) (*connect.Response[todov1.GetUnreadCountResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	count, err := h.getUnreadCount.Execute(ctx, actor)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.GetUnreadCountResponse{
+		UnreadCount: int32(count),
+	}), nil
+}
+
+func notificationToProto(n *notification.Notification) *todov1.Notification {
+	pb := &todov1.Notification{
+		Id:            n.ID().String(),
+		Kind:          notificationKindToProto(n.Kind()),
+		TaskId:        n.TaskID().String(),
+		CreatedAt:     timestamppb.New(n.CreatedAt()),
+		ChangedFields: n.ChangedFields(),
+	}
+
+	if n.ActorID() != nil {
+		s := n.ActorID().String()
+		pb.ActorId = &s
+	}
+	if n.CommentID() != nil {
+		s := n.CommentID().String()
+		pb.CommentId = &s
+	}
+	if n.DueDate() != nil {
+		pb.DueDate = timestamppb.New(*n.DueDate())
+	}
+	if n.ReadAt() != nil {
+		pb.ReadAt = timestamppb.New(*n.ReadAt())
+	}
+
+	return pb
+}
+
+func notificationKindToProto(k notification.Kind) todov1.NotificationKind {
+	switch k {
+	case notification.KindMention:
+		return todov1.NotificationKind_NOTIFICATION_KIND_MENTION
+	case notification.KindAssigned:
+		return todov1.NotificationKind_NOTIFICATION_KIND_ASSIGNED
+	case notification.KindStatusChanged:
+		return todov1.NotificationKind_NOTIFICATION_KIND_STATUS_CHANGED
+	case notification.KindDueSoon:
+		return todov1.NotificationKind_NOTIFICATION_KIND_DUE_SOON
+	case notification.KindTaskUpdated:
+		return todov1.NotificationKind_NOTIFICATION_KIND_TASK_UPDATED
+	default:
+		return todov1.NotificationKind_NOTIFICATION_KIND_UNSPECIFIED
+	}
+}
+
+var _ todov1connect.NotificationServiceHandler = (*NotificationHandler)(nil)
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	"connectrpc.com/connect"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -10,6 +11,7 @@ import (
 	"github.com/pyshx/todoapp/gen/todo/v1/todov1connect"
 	"github.com/pyshx/todoapp/internal/infra/postgres"
 	"github.com/pyshx/todoapp/internal/usecase/taskuc"
+	"github.com/pyshx/todoapp/pkg/apperr"
 	"github.com/pyshx/todoapp/pkg/id"
 	"github.com/pyshx/todoapp/pkg/task"
 )
@@ -18,26 +20,103 @@ type TaskHandler struct {
 	createTask       *taskuc.CreateTask
 	listCompanyTasks *taskuc.ListCompanyTasks
 	listMyTasks      *taskuc.ListMyTasks
+	searchTasks      *taskuc.SearchTasks
+	listSubtasks     *taskuc.ListSubtasks
+	getTaskTree      *taskuc.GetTaskTree
 	getTask          *taskuc.GetTask
 	updateTask       *taskuc.UpdateTask
 	deleteTask       *taskuc.DeleteTask
+
+	addTaskDependency    *taskuc.AddTaskDependency
+	removeTaskDependency *taskuc.RemoveTaskDependency
+	listTaskBlockers     *taskuc.ListTaskBlockers
+	listTaskDependents   *taskuc.ListTaskDependents
+
+	getTaskSeries    *taskuc.GetTaskSeries
+	updateTaskSeries *taskuc.UpdateTaskSeries
+
+	watchTask        *taskuc.WatchTask
+	unwatchTask      *taskuc.UnwatchTask
+	listTaskWatchers *taskuc.ListTaskWatchers
+
+	shareTask      *taskuc.ShareTask
+	unshareTask    *taskuc.UnshareTask
+	listTaskShares *taskuc.ListTaskShares
+
+	listTaskHistory *taskuc.ListTaskHistory
+
+	listDeletedTasks *taskuc.ListDeletedTasks
+	restoreTask      *taskuc.RestoreTask
+	purgeTask        *taskuc.PurgeTask
+
+	archiveTask   *taskuc.ArchiveTask
+	unarchiveTask *taskuc.UnarchiveTask
 }
 
 func NewTaskHandler(
 	createTask *taskuc.CreateTask,
 	listCompanyTasks *taskuc.ListCompanyTasks,
 	listMyTasks *taskuc.ListMyTasks,
+	searchTasks *taskuc.SearchTasks,
+	listSubtasks *taskuc.ListSubtasks,
+	getTaskTree *taskuc.GetTaskTree,
 	getTask *taskuc.GetTask,
 	updateTask *taskuc.UpdateTask,
 	deleteTask *taskuc.DeleteTask,
+	addTaskDependency *taskuc.AddTaskDependency,
+	removeTaskDependency *taskuc.RemoveTaskDependency,
+	listTaskBlockers *taskuc.ListTaskBlockers,
+	listTaskDependents *taskuc.ListTaskDependents,
+	getTaskSeries *taskuc.GetTaskSeries,
+	updateTaskSeries *taskuc.UpdateTaskSeries,
+	watchTask *taskuc.WatchTask,
+	unwatchTask *taskuc.UnwatchTask,
+	listTaskWatchers *taskuc.ListTaskWatchers,
+	shareTask *taskuc.ShareTask,
+	unshareTask *taskuc.UnshareTask,
+	listTaskShares *taskuc.ListTaskShares,
+	listTaskHistory *taskuc.ListTaskHistory,
+	listDeletedTasks *taskuc.ListDeletedTasks,
+	restoreTask *taskuc.RestoreTask,
+	purgeTask *taskuc.PurgeTask,
+	archiveTask *taskuc.ArchiveTask,
+	unarchiveTask *taskuc.UnarchiveTask,
 ) *TaskHandler {
 	return &TaskHandler{
 		createTask:       createTask,
 		listCompanyTasks: listCompanyTasks,
 		listMyTasks:      listMyTasks,
+		searchTasks:      searchTasks,
+		listSubtasks:     listSubtasks,
+		getTaskTree:      getTaskTree,
 		getTask:          getTask,
 		updateTask:       updateTask,
 		deleteTask:       deleteTask,
+
+		addTaskDependency:    addTaskDependency,
+		removeTaskDependency: removeTaskDependency,
+		listTaskBlockers:     listTaskBlockers,
+		listTaskDependents:   listTaskDependents,
+
+		getTaskSeries:    getTaskSeries,
+		updateTaskSeries: updateTaskSeries,
+
+		watchTask:        watchTask,
+		unwatchTask:      unwatchTask,
+		listTaskWatchers: listTaskWatchers,
+
+		shareTask:      shareTask,
+		unshareTask:    unshareTask,
+		listTaskShares: listTaskShares,
+
+		listTaskHistory: listTaskHistory,
+
+		listDeletedTasks: listDeletedTasks,
+		restoreTask:      restoreTask,
+		purgeTask:        purgeTask,
+
+		archiveTask:   archiveTask,
+		unarchiveTask: unarchiveTask,
 	}
 }
 
@@ -61,11 +140,41 @@ func (h *TaskHandler) CreateTask(ctx context.Context, req *connect.Request[todov
 		dueDate = req.Msg.DueDate
 	}
 
+	var parentID *id.TaskID
+	if req.Msg.ParentId != nil && *req.Msg.ParentId != "" {
+		pid, err := id.ParseTaskID(*req.Msg.ParentId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		parentID = &pid
+	}
+
+	assigneeIDs, err := parseUserIDs(req.Msg.AssigneeIds)
+	if err != nil {
+		return nil, err
+	}
+
+	labelIDs, err := parseLabelIDs(req.Msg.LabelIds)
+	if err != nil {
+		return nil, err
+	}
+
+	teamID, err := parseTeamID(req.Msg.TeamId)
+	if err != nil {
+		return nil, err
+	}
+
 	input := taskuc.CreateTaskInput{
 		Title:       req.Msg.Title,
 		Description: req.Msg.Description,
 		AssigneeID:  assigneeID,
+		AssigneeIDs: assigneeIDs,
+		ParentID:    parentID,
 		Visibility:  protoToVisibility(req.Msg.Visibility),
+		Priority:    protoToPriority(req.Msg.Priority),
+		LabelIDs:    labelIDs,
+		Recurrence:  protoToRecurrence(req.Msg.Recurrence),
+		TeamID:      teamID,
 	}
 	if dueDate != nil {
 		t := dueDate.AsTime()
@@ -88,7 +197,15 @@ func (h *TaskHandler) ListCompanyTasks(ctx context.Context, req *connect.Request
 		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
 	}
 
-	cursor, err := postgres.DecodeCursor(req.Msg.PageToken)
+	filter, err := protoToFilter(req.Msg.Filter)
+	if err != nil {
+		return nil, err
+	}
+	filter.IncludeArchived = req.Msg.IncludeArchived
+
+	sort := protoToSort(req.Msg.Sort)
+
+	cursor, err := postgres.DecodeCursor(req.Msg.PageToken, filter, sort)
 	if err != nil {
 		return nil, MapError(err)
 	}
@@ -96,6 +213,8 @@ func (h *TaskHandler) ListCompanyTasks(ctx context.Context, req *connect.Request
 	input := taskuc.ListCompanyTasksInput{
 		PageSize: int(req.Msg.PageSize),
 		Cursor:   cursor,
+		Filter:   filter,
+		Sort:     sort,
 	}
 
 	result, err := h.listCompanyTasks.Execute(ctx, actor, input)
@@ -110,7 +229,7 @@ func (h *TaskHandler) ListCompanyTasks(ctx context.Context, req *connect.Request
 
 	return connect.NewResponse(&todov1.ListCompanyTasksResponse{
 		Tasks:         tasks,
-		NextPageToken: postgres.EncodeCursor(result.NextCursor),
+		NextPageToken: postgres.EncodeCursor(result.NextCursor, filter),
 	}), nil
 }
 
@@ -120,7 +239,15 @@ func (h *TaskHandler) ListMyTasks(ctx context.Context, req *connect.Request[todo
 		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
 	}
 
-	cursor, err := postgres.DecodeCursor(req.Msg.PageToken)
+	filter, err := protoToFilter(req.Msg.Filter)
+	if err != nil {
+		return nil, err
+	}
+	filter.IncludeArchived = req.Msg.IncludeArchived
+
+	sort := protoToSort(req.Msg.Sort)
+
+	cursor, err := postgres.DecodeCursor(req.Msg.PageToken, filter, sort)
 	if err != nil {
 		return nil, MapError(err)
 	}
@@ -128,6 +255,8 @@ func (h *TaskHandler) ListMyTasks(ctx context.Context, req *connect.Request[todo
 	input := taskuc.ListMyTasksInput{
 		PageSize: int(req.Msg.PageSize),
 		Cursor:   cursor,
+		Filter:   filter,
+		Sort:     sort,
 	}
 
 	result, err := h.listMyTasks.Execute(ctx, actor, input)
@@ -142,7 +271,117 @@ func (h *TaskHandler) ListMyTasks(ctx context.Context, req *connect.Request[todo
 
 	return connect.NewResponse(&todov1.ListMyTasksResponse{
 		Tasks:         tasks,
-		NextPageToken: postgres.EncodeCursor(result.NextCursor),
+		NextPageToken: postgres.EncodeCursor(result.NextCursor, filter),
+	}), nil
+}
+
+func (h *TaskHandler) SearchTasks(ctx context.Context, req *connect.Request[todov1.SearchTasksRequest]) (*connect.Response[todov1.SearchTasksResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	cursor, err := postgres.DecodeSearchCursor(req.Msg.PageToken, req.Msg.Query)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	input := taskuc.SearchTasksInput{
+		Query:    req.Msg.Query,
+		PageSize: int(req.Msg.PageSize),
+		Cursor:   cursor,
+	}
+
+	result, err := h.searchTasks.Execute(ctx, actor, input)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	results := make([]*todov1.TaskSearchResult, len(result.Hits))
+	for i, hit := range result.Hits {
+		results[i] = &todov1.TaskSearchResult{
+			Task:                 taskToProto(hit.Task),
+			Score:                hit.Score,
+			TitleHighlight:       hit.TitleHighlight,
+			DescriptionHighlight: hit.DescriptionHighlight,
+		}
+	}
+
+	return connect.NewResponse(&todov1.SearchTasksResponse{
+		Results:       results,
+		NextPageToken: postgres.EncodeSearchCursor(result.NextCursor, req.Msg.Query),
+	}), nil
+}
+
+func (h *TaskHandler) ListSubtasks(ctx context.Context, req *connect.Request[todov1.ListSubtasksRequest]) (*connect.Response[todov1.ListSubtasksResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	// Binds the page token to the parent task
+	filter := task.Filter{ParentID: &taskID, IncludeArchived: req.Msg.IncludeArchived}
+	sort := protoToSort(req.Msg.Sort)
+
+	cursor, err := postgres.DecodeCursor(req.Msg.PageToken, filter, sort)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	input := taskuc.ListSubtasksInput{
+		TaskID:          taskID,
+		PageSize:        int(req.Msg.PageSize),
+		Cursor:          cursor,
+		Sort:            sort,
+		IncludeArchived: req.Msg.IncludeArchived,
+	}
+
+	result, err := h.listSubtasks.Execute(ctx, actor, input)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	tasks := make([]*todov1.Task, len(result.Tasks))
+	for i, t := range result.Tasks {
+		tasks[i] = taskToProto(t)
+	}
+
+	return connect.NewResponse(&todov1.ListSubtasksResponse{
+		Tasks:         tasks,
+		NextPageToken: postgres.EncodeCursor(result.NextCursor, filter),
+	}), nil
+}
+
+func (h *TaskHandler) GetTaskTree(ctx context.Context, req *connect.Request[todov1.GetTaskTreeRequest]) (*connect.Response[todov1.GetTaskTreeResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := h.getTaskTree.Execute(ctx, actor, taskID)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	descendants := make([]*todov1.Task, len(result.Descendants))
+	for i, t := range result.Descendants {
+		descendants[i] = taskToProto(t)
+	}
+
+	return connect.NewResponse(&todov1.GetTaskTreeResponse{
+		Root:        taskToProto(result.Root),
+		Descendants: descendants,
+		Truncated:   result.Truncated,
 	}), nil
 }
 
@@ -202,6 +441,19 @@ func (h *TaskHandler) UpdateTask(ctx context.Context, req *connect.Request[todov
 			input.AssigneeID = &aidPtr
 		}
 	}
+	if req.Msg.ParentId != nil {
+		if *req.Msg.ParentId == "" {
+			var nilID *id.TaskID
+			input.ParentID = &nilID
+		} else {
+			pid, err := id.ParseTaskID(*req.Msg.ParentId)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			pidPtr := &pid
+			input.ParentID = &pidPtr
+		}
+	}
 	if req.Msg.DueDate != nil {
 		t := req.Msg.DueDate.AsTime()
 		tPtr := &t
@@ -211,10 +463,36 @@ func (h *TaskHandler) UpdateTask(ctx context.Context, req *connect.Request[todov
 		v := protoToVisibility(*req.Msg.Visibility)
 		input.Visibility = &v
 	}
+	if req.Msg.Status != nil && req.Msg.StatusKey != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, apperr.NewErrInvalidInput("status_key", "cannot be combined with status"))
+	}
 	if req.Msg.Status != nil {
 		s := protoToStatus(*req.Msg.Status)
 		input.Status = &s
 	}
+	if req.Msg.StatusKey != nil {
+		s := task.Status(*req.Msg.StatusKey)
+		input.Status = &s
+	}
+	if req.Msg.Priority != nil {
+		p := protoToPriority(*req.Msg.Priority)
+		input.Priority = &p
+	}
+	if input.AddAssigneeIDs, err = parseUserIDs(req.Msg.AddAssigneeIds); err != nil {
+		return nil, err
+	}
+	if input.RemoveAssigneeIDs, err = parseUserIDs(req.Msg.RemoveAssigneeIds); err != nil {
+		return nil, err
+	}
+	if input.AddLabelIDs, err = parseLabelIDs(req.Msg.AddLabelIds); err != nil {
+		return nil, err
+	}
+	if input.RemoveLabelIDs, err = parseLabelIDs(req.Msg.RemoveLabelIds); err != nil {
+		return nil, err
+	}
+	if input.TeamID, err = parseTeamID(req.Msg.TeamId); err != nil {
+		return nil, err
+	}
 
 	t, err := h.updateTask.Execute(ctx, actor, input)
 	if err != nil {
@@ -246,37 +524,213 @@ func (h *TaskHandler) DeleteTask(ctx context.Context, req *connect.Request[todov
 
 func taskToProto(t *task.Task) *todov1.Task {
 	pb := &todov1.Task{
-		Id:         t.ID().String(),
-		CompanyId:  t.CompanyID().String(),
-		CreatorId:  t.CreatorID().String(),
-		Title:      t.Title(),
-		Visibility: visibilityToProto(t.Visibility()),
-		Status:     statusToProto(t.Status()),
-		Version:    int32(t.Version()),
-		CreatedAt:  timestamppb.New(t.CreatedAt()),
-		UpdatedAt:  timestamppb.New(t.UpdatedAt()),
+		Id:             t.ID().String(),
+		CompanyId:      t.CompanyID().String(),
+		CreatorId:      t.CreatorID().String(),
+		Title:          t.Title(),
+		Visibility:     visibilityToProto(t.Visibility()),
+		Status:         statusToProto(t.Status()),
+		Priority:       priorityToProto(t.Priority()),
+		Version:        int32(t.Version()),
+		CreatedAt:      timestamppb.New(t.CreatedAt()),
+		UpdatedAt:      timestamppb.New(t.UpdatedAt()),
+		StatusKey:      t.Status().String(),
+		StatusCategory: categoryToProto(t.Category()),
+		SubtaskProgress: &todov1.SubtaskProgress{
+			Done:  int32(t.SubtaskProgress().Done),
+			Total: int32(t.SubtaskProgress().Total),
+		},
 	}
 
 	if t.AssigneeID() != nil {
 		s := t.AssigneeID().String()
 		pb.AssigneeId = &s
 	}
+	if t.ParentID() != nil {
+		s := t.ParentID().String()
+		pb.ParentId = &s
+	}
 	if t.Description() != nil {
 		pb.Description = t.Description()
 	}
 	if t.DueDate() != nil {
 		pb.DueDate = timestamppb.New(*t.DueDate())
 	}
+	for _, a := range t.AssigneeIDs() {
+		pb.AssigneeIds = append(pb.AssigneeIds, a.String())
+	}
+	for _, l := range t.LabelIDs() {
+		pb.LabelIds = append(pb.LabelIds, l.String())
+	}
+	if t.TeamID() != nil {
+		s := t.TeamID().String()
+		pb.TeamId = &s
+	}
+	if t.SeriesID() != nil {
+		s := t.SeriesID().String()
+		pb.SeriesId = &s
+		pb.Occurrence = int32(t.Occurrence())
+	}
+	if t.CompletedAt() != nil {
+		pb.CompletedAt = timestamppb.New(*t.CompletedAt())
+	}
+	if t.ArchivedAt() != nil {
+		pb.ArchivedAt = timestamppb.New(*t.ArchivedAt())
+	}
+	if t.DeletedAt() != nil {
+		pb.DeletedAt = timestamppb.New(*t.DeletedAt())
+	}
 
 	return pb
 }
 
+func protoToFilter(f *todov1.TaskFilter) (task.Filter, error) {
+	var filter task.Filter
+	if f == nil {
+		return filter, nil
+	}
+
+	for _, s := range f.Statuses {
+		if s == todov1.TaskStatus_TASK_STATUS_UNSPECIFIED {
+			return filter, connect.NewError(connect.CodeInvalidArgument, apperr.NewErrInvalidInput("filter.statuses", "status must be specified"))
+		}
+		filter.Statuses = append(filter.Statuses, protoToStatus(s))
+	}
+	for _, s := range f.StatusKeys {
+		filter.Statuses = append(filter.Statuses, task.Status(s))
+	}
+
+	for _, p := range f.Priorities {
+		if p == todov1.TaskPriority_TASK_PRIORITY_UNSPECIFIED {
+			return filter, connect.NewError(connect.CodeInvalidArgument, apperr.NewErrInvalidInput("filter.priorities", "priority must be specified"))
+		}
+		filter.Priorities = append(filter.Priorities, protoToPriority(p))
+	}
+
+	if f.AssigneeId != nil {
+		aid, err := id.ParseUserID(*f.AssigneeId)
+		if err != nil {
+			return filter, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		filter.AssigneeID = &aid
+	}
+	filter.Unassigned = f.Unassigned
+
+	if f.CreatorId != nil {
+		cid, err := id.ParseUserID(*f.CreatorId)
+		if err != nil {
+			return filter, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		filter.CreatorID = &cid
+	}
+
+	if f.Visibility != nil {
+		v := protoToVisibility(*f.Visibility)
+		filter.Visibility = &v
+	}
+
+	filter.DueAfter = timestampToTime(f.DueAfter)
+	filter.DueBefore = timestampToTime(f.DueBefore)
+	filter.OverdueOnly = f.OverdueOnly
+	filter.CreatedAfter = timestampToTime(f.CreatedAfter)
+	filter.CreatedBefore = timestampToTime(f.CreatedBefore)
+	filter.UpdatedAfter = timestampToTime(f.UpdatedAfter)
+	filter.UpdatedBefore = timestampToTime(f.UpdatedBefore)
+
+	labelIDs, err := parseLabelIDs(f.LabelIds)
+	if err != nil {
+		return filter, err
+	}
+	filter.LabelIDs = labelIDs
+
+	return filter, nil
+}
+
+func parseUserIDs(ss []string) ([]id.UserID, error) {
+	var userIDs []id.UserID
+	for _, s := range ss {
+		uid, err := id.ParseUserID(s)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		userIDs = append(userIDs, uid)
+	}
+	return userIDs, nil
+}
+
+func parseLabelIDs(ss []string) ([]id.LabelID, error) {
+	var labelIDs []id.LabelID
+	for _, s := range ss {
+		lid, err := id.ParseLabelID(s)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		labelIDs = append(labelIDs, lid)
+	}
+	return labelIDs, nil
+}
+
+// parseTeamID treats an empty ID like a missing one.
+func parseTeamID(s *string) (*id.TeamID, error) {
+	if s == nil || *s == "" {
+		return nil, nil
+	}
+	tid, err := id.ParseTeamID(*s)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return &tid, nil
+}
+
+func protoToSort(s *todov1.TaskSort) task.Sort {
+	var sort task.Sort
+	if s == nil {
+		return sort
+	}
+
+	switch s.Field {
+	case todov1.TaskSortField_TASK_SORT_FIELD_CREATED_AT:
+		sort.Field = task.SortByCreatedAt
+	case todov1.TaskSortField_TASK_SORT_FIELD_DUE_DATE:
+		sort.Field = task.SortByDueDate
+	case todov1.TaskSortField_TASK_SORT_FIELD_UPDATED_AT:
+		sort.Field = task.SortByUpdatedAt
+	case todov1.TaskSortField_TASK_SORT_FIELD_TITLE:
+		sort.Field = task.SortByTitle
+	case todov1.TaskSortField_TASK_SORT_FIELD_STATUS:
+		sort.Field = task.SortByStatus
+	case todov1.TaskSortField_TASK_SORT_FIELD_PRIORITY:
+		sort.Field = task.SortByPriority
+	default:
+		sort.Field = task.SortByCreatedAt
+	}
+
+	switch s.Direction {
+	case todov1.SortDirection_SORT_DIRECTION_ASC:
+		sort.Direction = task.SortAsc
+	case todov1.SortDirection_SORT_DIRECTION_DESC:
+		sort.Direction = task.SortDesc
+	}
+
+	return sort.OrDefault()
+}
+
+func timestampToTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
 func visibilityToProto(v task.Visibility) todov1.Visibility {
 	switch v {
 	case task.VisibilityOnlyMe:
 		return todov1.Visibility_VISIBILITY_ONLY_ME
 	case task.VisibilityCompanyWide:
 		return todov1.Visibility_VISIBILITY_COMPANY_WIDE
+	case task.VisibilityTeam:
+		return todov1.Visibility_VISIBILITY_TEAM
 	default:
 		return todov1.Visibility_VISIBILITY_UNSPECIFIED
 	}
@@ -288,6 +742,8 @@ func protoToVisibility(v todov1.Visibility) task.Visibility {
 		return task.VisibilityOnlyMe
 	case todov1.Visibility_VISIBILITY_COMPANY_WIDE:
 		return task.VisibilityCompanyWide
+	case todov1.Visibility_VISIBILITY_TEAM:
+		return task.VisibilityTeam
 	default:
 		return task.VisibilityOnlyMe
 	}
@@ -306,6 +762,32 @@ func statusToProto(s task.Status) todov1.TaskStatus {
 	}
 }
 
+func categoryToProto(c task.Category) todov1.StatusCategory {
+	switch c {
+	case task.CategoryTodo:
+		return todov1.StatusCategory_STATUS_CATEGORY_TODO
+	case task.CategoryActive:
+		return todov1.StatusCategory_STATUS_CATEGORY_ACTIVE
+	case task.CategoryDone:
+		return todov1.StatusCategory_STATUS_CATEGORY_DONE
+	default:
+		return todov1.StatusCategory_STATUS_CATEGORY_UNSPECIFIED
+	}
+}
+
+func protoToCategory(c todov1.StatusCategory) task.Category {
+	switch c {
+	case todov1.StatusCategory_STATUS_CATEGORY_TODO:
+		return task.CategoryTodo
+	case todov1.StatusCategory_STATUS_CATEGORY_ACTIVE:
+		return task.CategoryActive
+	case todov1.StatusCategory_STATUS_CATEGORY_DONE:
+		return task.CategoryDone
+	default:
+		return ""
+	}
+}
+
 func protoToStatus(s todov1.TaskStatus) task.Status {
 	switch s {
 	case todov1.TaskStatus_TASK_STATUS_TODO:
@@ -320,3 +802,35 @@ func protoToStatus(s todov1.TaskStatus) task.Status {
 }
 
 var _ todov1connect.TodoServiceHandler = (*TaskHandler)(nil)
+
+func priorityToProto(p task.Priority) todov1.TaskPriority {
+	switch p {
+	case task.PriorityNone:
+		return todov1.TaskPriority_TASK_PRIORITY_NONE
+	case task.PriorityLow:
+		return todov1.TaskPriority_TASK_PRIORITY_LOW
+	case task.PriorityMedium:
+		return todov1.TaskPriority_TASK_PRIORITY_MEDIUM
+	case task.PriorityHigh:
+		return todov1.TaskPriority_TASK_PRIORITY_HIGH
+	case task.PriorityUrgent:
+		return todov1.TaskPriority_TASK_PRIORITY_URGENT
+	default:
+		return todov1.TaskPriority_TASK_PRIORITY_UNSPECIFIED
+	}
+}
+
+func protoToPriority(p todov1.TaskPriority) task.Priority {
+	switch p {
+	case todov1.TaskPriority_TASK_PRIORITY_LOW:
+		return task.PriorityLow
+	case todov1.TaskPriority_TASK_PRIORITY_MEDIUM:
+		return task.PriorityMedium
+	case todov1.TaskPriority_TASK_PRIORITY_HIGH:
+		return task.PriorityHigh
+	case todov1.TaskPriority_TASK_PRIORITY_URGENT:
+		return task.PriorityUrgent
+	default:
+		return task.PriorityNone
+	}
+}
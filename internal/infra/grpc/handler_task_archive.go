@@ -0,0 +1,52 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	todov1 "github.com/pyshx/todoapp/gen/todo/v1"
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+func (h *TaskHandler) ArchiveTask(ctx context.Context, req *connect.Request[todov1.ArchiveTaskRequest]) (*connect.Response[todov1.ArchiveTaskResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	t, err := h.archiveTask.Execute(ctx, actor, taskID)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.ArchiveTaskResponse{
+		Task: taskToProto(t),
+	}), nil
+}
+
+func (h *TaskHandler) UnarchiveTask(ctx context.Context, req *connect.Request[todov1.UnarchiveTaskRequest]) (*connect.Response[todov1.UnarchiveTaskResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	t, err := h.unarchiveTask.Execute(ctx, actor, taskID)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.UnarchiveTaskResponse{
+		Task: taskToProto(t),
+	}), nil
+}
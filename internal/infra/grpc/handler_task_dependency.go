@@ -0,0 +1,123 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	todov1 "github.com/pyshx/todoapp/gen/todo/v1"
+	"github.com/pyshx/todoapp/internal/usecase/taskuc"
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+func (h *TaskHandler) AddTaskDependency(ctx context.Context, req *connect.Request[todov1.AddTaskDependencyRequest]) (*connect.Response[todov1.AddTaskDependencyResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	blockerID, blockedID, err := parseDependency(req.Msg.BlockerId, req.Msg.BlockedId)
+	if err != nil {
+		return nil, err
+	}
+
+	input := taskuc.AddTaskDependencyInput{
+		BlockerID: blockerID,
+		BlockedID: blockedID,
+	}
+
+	if err := h.addTaskDependency.Execute(ctx, actor, input); err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.AddTaskDependencyResponse{}), nil
+}
+
+func (h *TaskHandler) RemoveTaskDependency(ctx context.Context, req *connect.Request[todov1.RemoveTaskDependencyRequest]) (*connect.Response[todov1.RemoveTaskDependencyResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	blockerID, blockedID, err := parseDependency(req.Msg.BlockerId, req.Msg.BlockedId)
+	if err != nil {
+		return nil, err
+	}
+
+	input := taskuc.RemoveTaskDependencyInput{
+		BlockerID: blockerID,
+		BlockedID: blockedID,
+	}
+
+	if err := h.removeTaskDependency.Execute(ctx, actor, input); err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.RemoveTaskDependencyResponse{}), nil
+}
+
+func (h *TaskHandler) ListTaskBlockers(ctx context.Context, req *connect.Request[todov1.ListTaskBlockersRequest]) (*connect.Response[todov1.ListTaskBlockersResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := h.listTaskBlockers.Execute(ctx, actor, taskID)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	tasks := make([]*todov1.Task, len(result.Tasks))
+	for i, t := range result.Tasks {
+		tasks[i] = taskToProto(t)
+	}
+
+	return connect.NewResponse(&todov1.ListTaskBlockersResponse{
+		Tasks:       tasks,
+		HiddenCount: int32(result.HiddenCount),
+	}), nil
+}
+
+func (h *TaskHandler) ListTaskDependents(ctx context.Context, req *connect.Request[todov1.ListTaskDependentsRequest]) (*connect.Response[todov1.ListTaskDependentsResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	result, err := h.listTaskDependents.Execute(ctx, actor, taskID)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	tasks := make([]*todov1.Task, len(result.Tasks))
+	for i, t := range result.Tasks {
+		tasks[i] = taskToProto(t)
+	}
+
+	return connect.NewResponse(&todov1.ListTaskDependentsResponse{
+		Tasks:       tasks,
+		HiddenCount: int32(result.HiddenCount),
+	}), nil
+}
+
+func parseDependency(blockerIDStr, blockedIDStr string) (id.TaskID, id.TaskID, error) {
+	blockerID, err := id.ParseTaskID(blockerIDStr)
+	if err != nil {
+		return id.TaskID{}, id.TaskID{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	blockedID, err := id.ParseTaskID(blockedIDStr)
+	if err != nil {
+		return id.TaskID{}, id.TaskID{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return blockerID, blockedID, nil
+}
@@ -0,0 +1,93 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/pyshx/todoapp/gen/todo/v1"
+	"github.com/pyshx/todoapp/internal/infra/postgres"
+	"github.com/pyshx/todoapp/internal/usecase/taskuc"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+func (h *TaskHandler) ListTaskHistory(ctx context.Context, req *connect.Request[todov1.ListTaskHistoryRequest]) (*connect.Response[todov1.ListTaskHistoryResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	cursor, err := postgres.DecodeHistoryCursor(req.Msg.PageToken, taskID)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	result, err := h.listTaskHistory.Execute(ctx, actor, taskuc.ListTaskHistoryInput{
+		TaskID:   taskID,
+		PageSize: int(req.Msg.PageSize),
+		Cursor:   cursor,
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	entries := make([]*todov1.TaskHistoryEntry, len(result.Entries))
+	for i, e := range result.Entries {
+		entries[i] = historyEntryToProto(e)
+	}
+
+	return connect.NewResponse(&todov1.ListTaskHistoryResponse{
+		Entries:       entries,
+		NextPageToken: postgres.EncodeHistoryCursor(result.NextCursor, taskID),
+	}), nil
+}
+
+func historyEntryToProto(e *task.HistoryEntry) *todov1.TaskHistoryEntry {
+	pb := &todov1.TaskHistoryEntry{
+		Id:        e.ID.String(),
+		TaskId:    e.TaskID.String(),
+		Action:    historyActionToProto(e.Action),
+		Version:   int32(e.Version),
+		CreatedAt: timestamppb.New(e.CreatedAt),
+	}
+
+	if e.ActorID != nil {
+		s := e.ActorID.String()
+		pb.ActorId = &s
+	}
+
+	pb.Changes = make([]*todov1.TaskFieldChange, len(e.Changes))
+	for i, c := range e.Changes {
+		pb.Changes[i] = &todov1.TaskFieldChange{
+			Field:  c.Field.String(),
+			Before: c.Before,
+			After:  c.After,
+		}
+	}
+
+	return pb
+}
+
+func historyActionToProto(a task.HistoryAction) todov1.TaskHistoryAction {
+	switch a {
+	case task.HistoryCreated:
+		return todov1.TaskHistoryAction_TASK_HISTORY_ACTION_CREATED
+	case task.HistoryUpdated:
+		return todov1.TaskHistoryAction_TASK_HISTORY_ACTION_UPDATED
+	case task.HistoryDeleted:
+		return todov1.TaskHistoryAction_TASK_HISTORY_ACTION_DELETED
+	case task.HistoryRestored:
+		return todov1.TaskHistoryAction_TASK_HISTORY_ACTION_RESTORED
+	case task.HistoryPurged:
+		return todov1.TaskHistoryAction_TASK_HISTORY_ACTION_PURGED
+	default:
+		return todov1.TaskHistoryAction_TASK_HISTORY_ACTION_UNSPECIFIED
+	}
+}
@@ -0,0 +1,142 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/pyshx/todoapp/gen/todo/v1"
+	"github.com/pyshx/todoapp/internal/usecase/taskuc"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+func (h *TaskHandler) GetTaskSeries(ctx context.Context, req *connect.Request[todov1.GetTaskSeriesRequest]) (*connect.Response[todov1.GetTaskSeriesResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	seriesID, err := id.ParseSeriesID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	s, err := h.getTaskSeries.Execute(ctx, actor, seriesID)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.GetTaskSeriesResponse{
+		Series: seriesToProto(s),
+	}), nil
+}
+
+func (h *TaskHandler) UpdateTaskSeries(ctx context.Context, req *connect.Request[todov1.UpdateTaskSeriesRequest]) (*connect.Response[todov1.UpdateTaskSeriesResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	seriesID, err := id.ParseSeriesID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	input := taskuc.UpdateTaskSeriesInput{
+		SeriesID:   seriesID,
+		Version:    int(req.Msg.Version),
+		Title:      req.Msg.Title,
+		Recurrence: protoToRecurrence(req.Msg.Recurrence),
+	}
+
+	if req.Msg.Description != nil {
+		input.Description = &req.Msg.Description
+	}
+	if req.Msg.AssigneeId != nil {
+		if *req.Msg.AssigneeId == "" {
+			var nilID *id.UserID
+			input.AssigneeID = &nilID
+		} else {
+			aid, err := id.ParseUserID(*req.Msg.AssigneeId)
+			if err != nil {
+				return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			}
+			aidPtr := &aid
+			input.AssigneeID = &aidPtr
+		}
+	}
+	if req.Msg.Visibility != nil {
+		v := protoToVisibility(*req.Msg.Visibility)
+		input.Visibility = &v
+	}
+	if req.Msg.Priority != nil {
+		p := protoToPriority(*req.Msg.Priority)
+		input.Priority = &p
+	}
+	teamID, err := parseTeamID(req.Msg.TeamId)
+	if err != nil {
+		return nil, err
+	}
+	input.TeamID = teamID
+
+	output, err := h.updateTaskSeries.Execute(ctx, actor, input)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	instances := make([]*todov1.Task, len(output.Instances))
+	for i, t := range output.Instances {
+		instances[i] = taskToProto(t)
+	}
+
+	return connect.NewResponse(&todov1.UpdateTaskSeriesResponse{
+		Series:    seriesToProto(output.Series),
+		Instances: instances,
+	}), nil
+}
+
+func seriesToProto(s *task.Series) *todov1.TaskSeries {
+	pb := &todov1.TaskSeries{
+		Id:        s.ID().String(),
+		CompanyId: s.CompanyID().String(),
+		CreatorId: s.CreatorID().String(),
+		Recurrence: &todov1.Recurrence{
+			Rule:     s.Rule().String(),
+			TimeZone: s.TimeZone().String(),
+		},
+		StartsAt:         timestamppb.New(s.StartsAt()),
+		LastOccurrenceAt: timestamppb.New(s.LastOccurrenceAt()),
+		Occurrences:      int32(s.Occurrences()),
+		Ended:            s.Ended(),
+		Title:            s.Title(),
+		Description:      s.Description(),
+		Visibility:       visibilityToProto(s.Visibility()),
+		Priority:         priorityToProto(s.Priority()),
+		Version:          int32(s.Version()),
+		CreatedAt:        timestamppb.New(s.CreatedAt()),
+		UpdatedAt:        timestamppb.New(s.UpdatedAt()),
+	}
+
+	if s.AssigneeID() != nil {
+		a := s.AssigneeID().String()
+		pb.AssigneeId = &a
+	}
+	if s.TeamID() != nil {
+		t := s.TeamID().String()
+		pb.TeamId = &t
+	}
+	if next, ok := s.NextOccurrence(); ok {
+		pb.NextOccurrenceAt = timestamppb.New(next)
+	}
+
+	return pb
+}
+
+func protoToRecurrence(r *todov1.Recurrence) *taskuc.RecurrenceInput {
+	if r == nil {
+		return nil
+	}
+	return &taskuc.RecurrenceInput{Rule: r.Rule, TimeZone: r.TimeZone}
+}
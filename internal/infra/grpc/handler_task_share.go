@@ -0,0 +1,124 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	todov1 "github.com/pyshx/todoapp/gen/todo/v1"
+	"github.com/pyshx/todoapp/internal/usecase/taskuc"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+func (h *TaskHandler) ShareTask(ctx context.Context, req *connect.Request[todov1.ShareTaskRequest]) (*connect.Response[todov1.ShareTaskResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userID, err := id.ParseUserID(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	share, err := h.shareTask.Execute(ctx, actor, taskuc.ShareTaskInput{
+		TaskID: taskID,
+		UserID: userID,
+		Role:   protoToShareRole(req.Msg.Role),
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.ShareTaskResponse{
+		Share: shareToProto(share),
+	}), nil
+}
+
+func (h *TaskHandler) UnshareTask(ctx context.Context, req *connect.Request[todov1.UnshareTaskRequest]) (*connect.Response[todov1.UnshareTaskResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	userID, err := id.ParseUserID(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.unshareTask.Execute(ctx, actor, taskuc.UnshareTaskInput{
+		TaskID: taskID,
+		UserID: userID,
+	}); err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.UnshareTaskResponse{}), nil
+}
+
+func (h *TaskHandler) ListTaskShares(ctx context.Context, req *connect.Request[todov1.ListTaskSharesRequest]) (*connect.Response[todov1.ListTaskSharesResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	shares, err := h.listTaskShares.Execute(ctx, actor, taskID)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	pb := make([]*todov1.TaskShare, len(shares))
+	for i, s := range shares {
+		pb[i] = shareToProto(s)
+	}
+
+	return connect.NewResponse(&todov1.ListTaskSharesResponse{
+		Shares: pb,
+	}), nil
+}
+
+func shareToProto(s task.Share) *todov1.TaskShare {
+	return &todov1.TaskShare{
+		UserId: s.UserID.String(),
+		Role:   shareRoleToProto(s.Role),
+	}
+}
+
+func shareRoleToProto(r task.ShareRole) todov1.ShareRole {
+	switch r {
+	case task.ShareRoleViewer:
+		return todov1.ShareRole_SHARE_ROLE_VIEWER
+	case task.ShareRoleEditor:
+		return todov1.ShareRole_SHARE_ROLE_EDITOR
+	default:
+		return todov1.ShareRole_SHARE_ROLE_UNSPECIFIED
+	}
+}
+
+// protoToShareRole leaves an unspecified role empty so the use case rejects it.
+func protoToShareRole(r todov1.ShareRole) task.ShareRole {
+	switch r {
+	case todov1.ShareRole_SHARE_ROLE_VIEWER:
+		return task.ShareRoleViewer
+	case todov1.ShareRole_SHARE_ROLE_EDITOR:
+		return task.ShareRoleEditor
+	default:
+		return ""
+	}
+}
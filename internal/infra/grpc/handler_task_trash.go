@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	todov1 "github.com/pyshx/todoapp/gen/todo/v1"
+	"github.com/pyshx/todoapp/internal/infra/postgres"
+	"github.com/pyshx/todoapp/internal/usecase/taskuc"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+func (h *TaskHandler) ListDeletedTasks(ctx context.Context, req *connect.Request[todov1.ListDeletedTasksRequest]) (*connect.Response[todov1.ListDeletedTasksResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	sort := protoToSort(req.Msg.Sort)
+
+	cursor, err := postgres.DecodeCursor(req.Msg.PageToken, task.Filter{}, sort)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	result, err := h.listDeletedTasks.Execute(ctx, actor, taskuc.ListDeletedTasksInput{
+		PageSize: int(req.Msg.PageSize),
+		Cursor:   cursor,
+		Sort:     sort,
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	tasks := make([]*todov1.Task, len(result.Tasks))
+	for i, t := range result.Tasks {
+		tasks[i] = taskToProto(t)
+	}
+
+	return connect.NewResponse(&todov1.ListDeletedTasksResponse{
+		Tasks:         tasks,
+		NextPageToken: postgres.EncodeCursor(result.NextCursor, task.Filter{}),
+	}), nil
+}
+
+func (h *TaskHandler) RestoreTask(ctx context.Context, req *connect.Request[todov1.RestoreTaskRequest]) (*connect.Response[todov1.RestoreTaskResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	t, err := h.restoreTask.Execute(ctx, actor, taskID)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.RestoreTaskResponse{
+		Task: taskToProto(t),
+	}), nil
+}
+
+func (h *TaskHandler) PurgeTask(ctx context.Context, req *connect.Request[todov1.PurgeTaskRequest]) (*connect.Response[todov1.PurgeTaskResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.purgeTask.Execute(ctx, actor, taskID); err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.PurgeTaskResponse{}), nil
+}
@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	todov1 "github.com/pyshx/todoapp/gen/todo/v1"
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+func (h *TaskHandler) WatchTask(ctx context.Context, req *connect.Request[todov1.WatchTaskRequest]) (*connect.Response[todov1.WatchTaskResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.watchTask.Execute(ctx, actor, taskID); err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.WatchTaskResponse{}), nil
+}
+
+func (h *TaskHandler) UnwatchTask(ctx context.Context, req *connect.Request[todov1.UnwatchTaskRequest]) (*connect.Response[todov1.UnwatchTaskResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.unwatchTask.Execute(ctx, actor, taskID); err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.UnwatchTaskResponse{}), nil
+}
+
+func (h *TaskHandler) ListTaskWatchers(ctx context.Context, req *connect.Request[todov1.ListTaskWatchersRequest]) (*connect.Response[todov1.ListTaskWatchersResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	taskID, err := id.ParseTaskID(req.Msg.TaskId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	watchers, err := h.listTaskWatchers.Execute(ctx, actor, taskID)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	pb := make([]*todov1.TaskWatcher, len(watchers))
+	for i, w := range watchers {
+		pb[i] = &todov1.TaskWatcher{
+			UserId: w.ID().String(),
+			Email:  w.Email(),
+		}
+	}
+
+	return connect.NewResponse(&todov1.ListTaskWatchersResponse{
+		Watchers: pb,
+	}), nil
+}
@@ -0,0 +1,216 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/pyshx/todoapp/gen/todo/v1"
+	"github.com/pyshx/todoapp/gen/todo/v1/todov1connect"
+	"github.com/pyshx/todoapp/internal/usecase/teamuc"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/team"
+)
+
+type TeamHandler struct {
+	createTeam       *teamuc.CreateTeam
+	listTeams        *teamuc.ListTeams
+	updateTeam       *teamuc.UpdateTeam
+	deleteTeam       *teamuc.DeleteTeam
+	addTeamMember    *teamuc.AddTeamMember
+	removeTeamMember *teamuc.RemoveTeamMember
+	listTeamMembers  *teamuc.ListTeamMembers
+}
+
+func NewTeamHandler(
+	createTeam *teamuc.CreateTeam,
+	listTeams *teamuc.ListTeams,
+	updateTeam *teamuc.UpdateTeam,
+	deleteTeam *teamuc.DeleteTeam,
+	addTeamMember *teamuc.AddTeamMember,
+	removeTeamMember *teamuc.RemoveTeamMember,
+	listTeamMembers *teamuc.ListTeamMembers,
+) *TeamHandler {
+	return &TeamHandler{
+		createTeam:       createTeam,
+		listTeams:        listTeams,
+		updateTeam:       updateTeam,
+		deleteTeam:       deleteTeam,
+		addTeamMember:    addTeamMember,
+		removeTeamMember: removeTeamMember,
+		listTeamMembers:  listTeamMembers,
+	}
+}
+
+func (h *TeamHandler) CreateTeam(ctx context.Context, req *connect.Request[todov1.CreateTeamRequest]) (*connect.Response[todov1.CreateTeamResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	t, err := h.createTeam.Execute(ctx, actor, teamuc.CreateTeamInput{
+		Name: req.Msg.Name,
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.CreateTeamResponse{
+		Team: teamToProto(t),
+	}), nil
+}
+
+func (h *TeamHandler) ListTeams(ctx context.Context, req *connect.Request[todov1.ListTeamsRequest]) (*connect.Response[todov1.ListTeamsResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	teams, err := h.listTeams.Execute(ctx, actor)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	pbTeams := make([]*todov1.Team, len(teams))
+	for i, t := range teams {
+		pbTeams[i] = teamToProto(t)
+	}
+
+	return connect.NewResponse(&todov1.ListTeamsResponse{
+		Teams: pbTeams,
+	}), nil
+}
+
+func (h *TeamHandler) UpdateTeam(ctx context.Context, req *connect.Request[todov1.UpdateTeamRequest]) (*connect.Response[todov1.UpdateTeamResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	teamID, err := id.ParseTeamID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	t, err := h.updateTeam.Execute(ctx, actor, teamuc.UpdateTeamInput{
+		TeamID: teamID,
+		Name:   req.Msg.Name,
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.UpdateTeamResponse{
+		Team: teamToProto(t),
+	}), nil
+}
+
+func (h *TeamHandler) DeleteTeam(ctx context.Context, req *connect.Request[todov1.DeleteTeamRequest]) (*connect.Response[todov1.DeleteTeamResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	teamID, err := id.ParseTeamID(req.Msg.Id)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.deleteTeam.Execute(ctx, actor, teamID); err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.DeleteTeamResponse{}), nil
+}
+
+func (h *TeamHandler) AddTeamMember(ctx context.Context, req *connect.Request[todov1.AddTeamMemberRequest]) (*connect.Response[todov1.AddTeamMemberResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	teamID, userID, err := parseTeamMember(req.Msg.TeamId, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.addTeamMember.Execute(ctx, actor, teamuc.AddTeamMemberInput{
+		TeamID: teamID,
+		UserID: userID,
+	}); err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.AddTeamMemberResponse{}), nil
+}
+
+func (h *TeamHandler) RemoveTeamMember(ctx context.Context, req *connect.Request[todov1.RemoveTeamMemberRequest]) (*connect.Response[todov1.RemoveTeamMemberResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	teamID, userID, err := parseTeamMember(req.Msg.TeamId, req.Msg.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.removeTeamMember.Execute(ctx, actor, teamuc.RemoveTeamMemberInput{
+		TeamID: teamID,
+		UserID: userID,
+	}); err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.RemoveTeamMemberResponse{}), nil
+}
+
+func (h *TeamHandler) ListTeamMembers(ctx context.Context, req *connect.Request[todov1.ListTeamMembersRequest]) (*connect.Response[todov1.ListTeamMembersResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	teamID, err := id.ParseTeamID(req.Msg.TeamId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	members, err := h.listTeamMembers.Execute(ctx, actor, teamID)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	pbUsers := make([]*todov1.User, len(members))
+	for i, u := range members {
+		pbUsers[i] = userToProto(u)
+	}
+
+	return connect.NewResponse(&todov1.ListTeamMembersResponse{
+		Users: pbUsers,
+	}), nil
+}
+
+func parseTeamMember(teamIDStr, userIDStr string) (id.TeamID, id.UserID, error) {
+	teamID, err := id.ParseTeamID(teamIDStr)
+	if err != nil {
+		return id.TeamID{}, id.UserID{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	userID, err := id.ParseUserID(userIDStr)
+	if err != nil {
+		return id.TeamID{}, id.UserID{}, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return teamID, userID, nil
+}
+
+func teamToProto(t *team.Team) *todov1.Team {
+	return &todov1.Team{
+		Id:        t.ID().String(),
+		CompanyId: t.CompanyID().String(),
+		Name:      t.Name(),
+		CreatedAt: timestamppb.New(t.CreatedAt()),
+	}
+}
+
+var _ todov1connect.TeamServiceHandler = (*TeamHandler)(nil)
@@ -0,0 +1,293 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	todov1 "github.com/pyshx/todoapp/gen/todo/v1"
+	"github.com/pyshx/todoapp/gen/todo/v1/todov1connect"
+	"github.com/pyshx/todoapp/internal/usecase/useruc"
+	"github.com/pyshx/todoapp/pkg/auth"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type UserHandler struct {
+	listUsers      *useruc.ListUsers
+	getUser        *useruc.GetUser
+	inviteUser     *useruc.InviteUser
+	acceptInvite   *useruc.AcceptInvite
+	updateUserRole *useruc.UpdateUserRole
+	deactivateUser *useruc.DeactivateUser
+	offboardUser   *useruc.OffboardUser
+	jwtService     *auth.JWTService
+}
+
+func NewUserHandler(
+	listUsers *useruc.ListUsers,
+	getUser *useruc.GetUser,
+	inviteUser *useruc.InviteUser,
+	acceptInvite *useruc.AcceptInvite,
+	updateUserRole *useruc.UpdateUserRole,
+	deactivateUser *useruc.DeactivateUser,
+	offboardUser *useruc.OffboardUser,
+	jwtService *auth.JWTService,
+) *UserHandler {
+	return &UserHandler{
+		listUsers:      listUsers,
+		getUser:        getUser,
+		inviteUser:     inviteUser,
+		acceptInvite:   acceptInvite,
+		updateUserRole: updateUserRole,
+		deactivateUser: deactivateUser,
+		offboardUser:   offboardUser,
+		jwtService:     jwtService,
+	}
+}
+
+func (h *UserHandler) ListUsers(ctx context.Context, req *connect.Request[todov1.ListUsersRequest]) (*connect.Response[todov1.ListUsersResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	users, err := h.listUsers.Execute(ctx, actor, useruc.ListUsersInput{
+		IncludeDeactivated: req.Msg.IncludeDeactivated,
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	pbUsers := make([]*todov1.User, len(users))
+	for i, u := range users {
+		pbUsers[i] = userToProto(u)
+	}
+
+	return connect.NewResponse(&todov1.ListUsersResponse{
+		Users: pbUsers,
+	}), nil
+}
+
+func (h *UserHandler) GetUser(ctx context.Context, req *connect.Request[todov1.GetUserRequest]) (*connect.Response[todov1.GetUserResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	userID, err := id.ParseUserID(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	u, err := h.getUser.Execute(ctx, actor, userID)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.GetUserResponse{
+		User: userToProto(u),
+	}), nil
+}
+
+func (h *UserHandler) InviteUser(ctx context.Context, req *connect.Request[todov1.InviteUserRequest]) (*connect.Response[todov1.InviteUserResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	invite, token, err := h.inviteUser.Execute(ctx, actor, useruc.InviteUserInput{
+		Email: req.Msg.Email,
+		Role:  protoToRole(req.Msg.Role),
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.InviteUserResponse{
+		Invite: inviteToProto(invite),
+		Token:  token,
+	}), nil
+}
+
+// AcceptInvite is the one user RPC that runs unauthenticated; the invite
+// token stands in for credentials.
+func (h *UserHandler) AcceptInvite(ctx context.Context, req *connect.Request[todov1.AcceptInviteRequest]) (*connect.Response[todov1.AcceptInviteResponse], error) {
+	u, err := h.acceptInvite.Execute(ctx, useruc.AcceptInviteInput{
+		Token: req.Msg.Token,
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	accessToken, err := h.jwtService.GenerateToken(u.ID(), u.CompanyID(), u.Role().String())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&todov1.AcceptInviteResponse{
+		User:        userToProto(u),
+		AccessToken: accessToken,
+	}), nil
+}
+
+func (h *UserHandler) UpdateUserRole(ctx context.Context, req *connect.Request[todov1.UpdateUserRoleRequest]) (*connect.Response[todov1.UpdateUserRoleResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	userID, err := id.ParseUserID(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	u, err := h.updateUserRole.Execute(ctx, actor, useruc.UpdateUserRoleInput{
+		UserID: userID,
+		Role:   protoToRole(req.Msg.Role),
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.UpdateUserRoleResponse{
+		User: userToProto(u),
+	}), nil
+}
+
+func (h *UserHandler) DeactivateUser(ctx context.Context, req *connect.Request[todov1.DeactivateUserRequest]) (*connect.Response[todov1.DeactivateUserResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	userID, err := id.ParseUserID(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	u, err := h.deactivateUser.Execute(ctx, actor, userID)
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.DeactivateUserResponse{
+		User: userToProto(u),
+	}), nil
+}
+
+func (h *UserHandler) OffboardUser(ctx context.Context, req *connect.Request[todov1.OffboardUserRequest]) (*connect.Response[todov1.OffboardUserResponse], error) {
+	actor, ok := UserFromContext(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	userID, err := id.ParseUserID(req.Msg.UserId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	transferToID, err := id.ParseUserID(req.Msg.TransferToId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var reassignToID *id.UserID
+	if req.Msg.ReassignToId != nil && *req.Msg.ReassignToId != "" {
+		rid, err := id.ParseUserID(*req.Msg.ReassignToId)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		reassignToID = &rid
+	}
+
+	u, handedOver, err := h.offboardUser.Execute(ctx, actor, useruc.OffboardUserInput{
+		UserID:       userID,
+		TransferToID: transferToID,
+		ReassignToID: reassignToID,
+		OnlyMe:       protoToOnlyMeHandover(req.Msg.OnlyMeTasks),
+	})
+	if err != nil {
+		return nil, MapError(err)
+	}
+
+	return connect.NewResponse(&todov1.OffboardUserResponse{
+		User:         userToProto(u),
+		TasksUpdated: int32(handedOver),
+	}), nil
+}
+
+func userToProto(u *user.User) *todov1.User {
+	pb := &todov1.User{
+		Id:        u.ID().String(),
+		Email:     u.Email(),
+		Role:      roleToProto(u.Role()),
+		CreatedAt: timestamppb.New(u.CreatedAt()),
+	}
+	if u.DeactivatedAt() != nil {
+		pb.DeactivatedAt = timestamppb.New(*u.DeactivatedAt())
+	}
+	for _, t := range u.TeamIDs() {
+		pb.TeamIds = append(pb.TeamIds, t.String())
+	}
+	return pb
+}
+
+func inviteToProto(i *user.Invite) *todov1.Invite {
+	return &todov1.Invite{
+		Id:        i.ID().String(),
+		Email:     i.Email(),
+		Role:      roleToProto(i.Role()),
+		InvitedBy: i.InvitedBy().String(),
+		ExpiresAt: timestamppb.New(i.ExpiresAt()),
+		CreatedAt: timestamppb.New(i.CreatedAt()),
+	}
+}
+
+func roleToProto(r user.Role) todov1.UserRole {
+	switch r {
+	case user.RoleViewer:
+		return todov1.UserRole_USER_ROLE_VIEWER
+	case user.RoleEditor:
+		return todov1.UserRole_USER_ROLE_EDITOR
+	case user.RoleAdmin:
+		return todov1.UserRole_USER_ROLE_ADMIN
+	case user.RoleOwner:
+		return todov1.UserRole_USER_ROLE_OWNER
+	default:
+		return todov1.UserRole_USER_ROLE_UNSPECIFIED
+	}
+}
+
+// protoToRole leaves unspecified roles empty so the use cases reject them.
+func protoToRole(r todov1.UserRole) user.Role {
+	switch r {
+	case todov1.UserRole_USER_ROLE_VIEWER:
+		return user.RoleViewer
+	case todov1.UserRole_USER_ROLE_EDITOR:
+		return user.RoleEditor
+	case todov1.UserRole_USER_ROLE_ADMIN:
+		return user.RoleAdmin
+	case todov1.UserRole_USER_ROLE_OWNER:
+		return user.RoleOwner
+	default:
+		return ""
+	}
+}
+
+// protoToOnlyMeHandover leaves unspecified policies empty so the use case
+// rejects them.
+func protoToOnlyMeHandover(p todov1.OnlyMeHandover) task.OnlyMeHandover {
+	switch p {
+	case todov1.OnlyMeHandover_ONLY_ME_HANDOVER_KEEP_PRIVATE:
+		return task.OnlyMeKeepPrivate
+	case todov1.OnlyMeHandover_ONLY_ME_HANDOVER_SHARE:
+		return task.OnlyMeShare
+	default:
+		return ""
+	}
+}
+
+var _ todov1connect.UserServiceHandler = (*UserHandler)(nil)
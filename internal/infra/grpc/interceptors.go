@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"crypto/subtle"
 	"log/slog"
 	"runtime/debug"
 	"strings"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/pyshx/todoapp/pkg/apperr"
 	"github.com/pyshx/todoapp/pkg/auth"
+	"github.com/pyshx/todoapp/pkg/company"
 	"github.com/pyshx/todoapp/pkg/id"
 	"github.com/pyshx/todoapp/pkg/idempotency"
 	"github.com/pyshx/todoapp/pkg/user"
@@ -39,17 +41,27 @@ var (
 )
 
 type AuthInterceptor struct {
-	jwtService *auth.JWTService
-	userRepo   user.Repo
-	logger     *slog.Logger
+	jwtService  *auth.JWTService
+	userRepo    user.Repo
+	companyRepo company.Repo
+	// operatorToken authenticates AdminService calls; empty disables them.
+	operatorToken string
+	logger        *slog.Logger
 }
 
-func NewAuthInterceptor(jwtService *auth.JWTService, userRepo user.Repo, logger *slog.Logger) *AuthInterceptor {
-	return &AuthInterceptor{jwtService: jwtService, userRepo: userRepo, logger: logger}
+func NewAuthInterceptor(jwtService *auth.JWTService, userRepo user.Repo, companyRepo company.Repo, operatorToken string, logger *slog.Logger) *AuthInterceptor {
+	return &AuthInterceptor{jwtService: jwtService, userRepo: userRepo, companyRepo: companyRepo, operatorToken: operatorToken, logger: logger}
 }
 
 func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if isPublicMethod(req.Spec().Procedure) {
+			return next(ctx, req)
+		}
+		if isOperatorMethod(req.Spec().Procedure) {
+			return i.authenticateOperator(ctx, req, next)
+		}
+
 		// Try JWT authentication first
 		authHeader := req.Header().Get("Authorization")
 		if authHeader != "" {
@@ -75,6 +87,9 @@ func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 			i.logger.Error("failed to find user", "error", err, "user_id", userIDStr)
 			return nil, connect.NewError(connect.CodeInternal, err)
 		}
+		if err := i.checkAccess(ctx, u); err != nil {
+			return nil, err
+		}
 
 		ctx = ContextWithUser(ctx, u)
 		return next(ctx, req)
@@ -108,11 +123,63 @@ func (i *AuthInterceptor) authenticateWithJWT(ctx context.Context, req connect.A
 		i.logger.Error("failed to find user", "error", err, "user_id", claims.UserID.String())
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
+	if err := i.checkAccess(ctx, u); err != nil {
+		return nil, err
+	}
 
 	ctx = ContextWithUser(ctx, u)
 	return next(ctx, req)
 }
 
+// checkAccess rejects authenticated users who may no longer use the API:
+// deactivated users and users of a suspended company.
+func (i *AuthInterceptor) checkAccess(ctx context.Context, u *user.User) error {
+	if !u.IsActive() {
+		return connect.NewError(connect.CodeUnauthenticated, apperr.NewErrUnauthenticated("user is deactivated"))
+	}
+
+	c, err := i.companyRepo.FindByID(ctx, u.CompanyID())
+	if err != nil {
+		i.logger.Error("failed to find company", "error", err, "company_id", u.CompanyID().String())
+		return connect.NewError(connect.CodeInternal, err)
+	}
+	if c.IsSuspended() {
+		return connect.NewError(connect.CodePermissionDenied, apperr.NewErrPermissionDenied("access", "company", "company is suspended"))
+	}
+
+	return nil
+}
+
+// authenticateOperator checks the operator token; user credentials are
+// never accepted for operator methods.
+func (i *AuthInterceptor) authenticateOperator(ctx context.Context, req connect.AnyRequest, next connect.UnaryFunc) (connect.AnyResponse, error) {
+	if i.operatorToken == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, apperr.NewErrUnauthenticated("operator access is disabled"))
+	}
+
+	parts := strings.SplitN(req.Header().Get("Authorization"), " ", 2)
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, apperr.NewErrUnauthenticated("operator token is required"))
+	}
+	if subtle.ConstantTimeCompare([]byte(parts[1]), []byte(i.operatorToken)) != 1 {
+		return nil, connect.NewError(connect.CodeUnauthenticated, apperr.NewErrUnauthenticated("invalid operator token"))
+	}
+
+	ctx = ContextWithOperator(ctx)
+	return next(ctx, req)
+}
+
+// isPublicMethod reports whether a procedure runs without an authenticated
+// user.
+func isPublicMethod(method string) bool {
+	return method == "/todo.v1.UserService/AcceptInvite"
+}
+
+// isOperatorMethod reports whether a procedure is reserved for operators.
+func isOperatorMethod(method string) bool {
+	return strings.HasPrefix(method, "/todo.v1.AdminService/")
+}
+
 func (i *AuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
 	return next
 }
@@ -263,7 +330,7 @@ func errorKindFromConnectCode(code connect.Code) string {
 		return string(apperr.ErrorKindNotFound)
 	case connect.CodePermissionDenied, connect.CodeUnauthenticated:
 		return string(apperr.ErrorKindAuth)
-	case connect.CodeAborted:
+	case connect.CodeAborted, connect.CodeAlreadyExists, connect.CodeFailedPrecondition:
 		return string(apperr.ErrorKindConflict)
 	case connect.CodeInvalidArgument:
 		return string(apperr.ErrorKindValidation)
@@ -335,6 +402,35 @@ func (i *IdempotencyInterceptor) isMutationMethod(method string) bool {
 		"/todo.v1.TodoService/CreateTask",
 		"/todo.v1.TodoService/UpdateTask",
 		"/todo.v1.TodoService/DeleteTask",
+		"/todo.v1.TodoService/RestoreTask",
+		"/todo.v1.TodoService/PurgeTask",
+		"/todo.v1.TodoService/ArchiveTask",
+		"/todo.v1.TodoService/UnarchiveTask",
+		"/todo.v1.TodoService/AddTaskDependency",
+		"/todo.v1.TodoService/RemoveTaskDependency",
+		"/todo.v1.TodoService/UpdateTaskSeries",
+		"/todo.v1.TodoService/WatchTask",
+		"/todo.v1.TodoService/UnwatchTask",
+		"/todo.v1.TodoService/ShareTask",
+		"/todo.v1.TodoService/UnshareTask",
+		"/todo.v1.LabelService/CreateLabel",
+		"/todo.v1.LabelService/UpdateLabel",
+		"/todo.v1.LabelService/DeleteLabel",
+		"/todo.v1.CommentService/CreateComment",
+		"/todo.v1.CommentService/EditComment",
+		"/todo.v1.CommentService/DeleteComment",
+		"/todo.v1.NotificationService/MarkNotificationsRead",
+		"/todo.v1.CompanyService/UpdateCompanySettings",
+		"/todo.v1.CompanyService/UpdateWorkflow",
+		"/todo.v1.UserService/InviteUser",
+		"/todo.v1.UserService/UpdateUserRole",
+		"/todo.v1.UserService/DeactivateUser",
+		"/todo.v1.UserService/OffboardUser",
+		"/todo.v1.TeamService/CreateTeam",
+		"/todo.v1.TeamService/UpdateTeam",
+		"/todo.v1.TeamService/DeleteTeam",
+		"/todo.v1.TeamService/AddTeamMember",
+		"/todo.v1.TeamService/RemoveTeamMember",
 	}
 	for _, m := range mutationMethods {
 		if method == m {
@@ -16,6 +16,7 @@ import (
 
 	"github.com/pyshx/todoapp/gen/todo/v1/todov1connect"
 	"github.com/pyshx/todoapp/pkg/auth"
+	"github.com/pyshx/todoapp/pkg/company"
 	"github.com/pyshx/todoapp/pkg/idempotency"
 	"github.com/pyshx/todoapp/pkg/user"
 )
@@ -25,25 +26,42 @@ type Server struct {
 	logger     *slog.Logger
 }
 
-func NewServer(port int, handler *TaskHandler, userRepo user.Repo, jwtService *auth.JWTService, idempotencyStore idempotency.Store, logger *slog.Logger) *Server {
+func NewServer(port int, taskHandler *TaskHandler, labelHandler *LabelHandler, commentHandler *CommentHandler, notificationHandler *NotificationHandler, companyHandler *CompanyHandler, userHandler *UserHandler, teamHandler *TeamHandler, adminHandler *AdminHandler, userRepo user.Repo, companyRepo company.Repo, operatorToken string, jwtService *auth.JWTService, idempotencyStore idempotency.Store, logger *slog.Logger) *Server {
 	interceptors := connect.WithInterceptors(
 		NewRecoveryInterceptor(logger),
 		NewMetricsInterceptor(),
 		NewRequestIDInterceptor(),
 		NewLoggingInterceptor(logger),
-		NewAuthInterceptor(jwtService, userRepo, logger),
+		NewAuthInterceptor(jwtService, userRepo, companyRepo, operatorToken, logger),
 		NewIdempotencyInterceptor(idempotencyStore, logger),
 	)
 
 	mux := http.NewServeMux()
 
-	path, httpHandler := todov1connect.NewTodoServiceHandler(handler, interceptors)
-	mux.Handle(path, httpHandler)
+	mux.Handle(todov1connect.NewTodoServiceHandler(taskHandler, interceptors))
+	mux.Handle(todov1connect.NewLabelServiceHandler(labelHandler, interceptors))
+	mux.Handle(todov1connect.NewCommentServiceHandler(commentHandler, interceptors))
+	mux.Handle(todov1connect.NewNotificationServiceHandler(notificationHandler, interceptors))
+	mux.Handle(todov1connect.NewCompanyServiceHandler(companyHandler, interceptors))
+	mux.Handle(todov1connect.NewUserServiceHandler(userHandler, interceptors))
+	mux.Handle(todov1connect.NewTeamServiceHandler(teamHandler, interceptors))
+	mux.Handle(todov1connect.NewAdminServiceHandler(adminHandler, interceptors))
 
-	checker := grpchealth.NewStaticChecker(todov1connect.TodoServiceName)
+	services := []string{
+		todov1connect.TodoServiceName,
+		todov1connect.LabelServiceName,
+		todov1connect.CommentServiceName,
+		todov1connect.NotificationServiceName,
+		todov1connect.CompanyServiceName,
+		todov1connect.UserServiceName,
+		todov1connect.TeamServiceName,
+		todov1connect.AdminServiceName,
+	}
+
+	checker := grpchealth.NewStaticChecker(services...)
 	mux.Handle(grpchealth.NewHandler(checker))
 
-	reflector := grpcreflect.NewStaticReflector(todov1connect.TodoServiceName)
+	reflector := grpcreflect.NewStaticReflector(services...)
 	mux.Handle(grpcreflect.NewHandlerV1(reflector))
 	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
 
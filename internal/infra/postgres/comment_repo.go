@@ -0,0 +1,210 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/comment"
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+const commentColumns = "id, company_id, task_id, author_id, parent_id, body, created_at, edited_at"
+
+type CommentRepo struct {
+	client *Client
+}
+
+func NewCommentRepo(client *Client) *CommentRepo {
+	return &CommentRepo{client: client}
+}
+
+func (r *CommentRepo) Create(ctx context.Context, c *comment.Comment) error {
+	query := `
+		INSERT INTO comments (` + commentColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	var parentID interface{}
+	if c.ParentID() != nil {
+		parentID = c.ParentID().UUID()
+	}
+
+	_, err := r.client.pool.Exec(ctx, query,
+		c.ID().UUID(),
+		c.CompanyID().UUID(),
+		c.TaskID().UUID(),
+		c.AuthorID().UUID(),
+		parentID,
+		c.Body(),
+		c.CreatedAt(),
+		c.EditedAt(),
+	)
+	if violatesConstraint(err, "comments_parent_fkey") {
+		return apperr.NewErrInvalidInput("parent_id", "comment not found on this task")
+	}
+	if isForeignKeyViolation(err) {
+		return apperr.NewErrNotFound("task", c.TaskID().String())
+	}
+	return err
+}
+
+func (r *CommentRepo) FindByIDForCompany(ctx context.Context, commentID id.CommentID, companyID id.CompanyID) (*comment.Comment, error) {
+	query := `
+		SELECT ` + commentColumns + `
+		FROM comments
+		WHERE id = $1 AND company_id = $2
+	`
+
+	var cr commentRow
+	if err := r.client.pool.QueryRow(ctx, query, commentID.UUID(), companyID.UUID()).Scan(cr.dest()...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NewErrNotFound("comment", commentID.String())
+		}
+		return nil, err
+	}
+
+	return cr.toComment()
+}
+
+func (r *CommentRepo) ListByTask(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, opts comment.ListOptions) (*comment.ListResult, error) {
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	q := &listQuery{}
+	q.where("company_id = " + q.arg(companyID.UUID()))
+	q.where("task_id = " + q.arg(taskID.UUID()))
+	if opts.Cursor != nil {
+		q.where("(created_at, id) > (" + q.arg(opts.Cursor.CreatedAt) + ", " + q.arg(opts.Cursor.ID.UUID()) + ")")
+	}
+
+	query := `
+		SELECT ` + commentColumns + `
+		FROM comments
+		WHERE ` + strings.Join(q.conds, " AND ") + `
+		ORDER BY created_at, id
+		LIMIT ` + q.arg(pageSize+1)
+
+	rows, err := r.client.pool.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var comments []*comment.Comment
+
+	for rows.Next() {
+		var cr commentRow
+		if err := rows.Scan(cr.dest()...); err != nil {
+			return nil, err
+		}
+
+		c, err := cr.toComment()
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	result := &comment.ListResult{}
+
+	if len(comments) > pageSize {
+		comments = comments[:pageSize]
+		last := comments[len(comments)-1]
+		result.NextCursor = &comment.PageCursor{CreatedAt: last.CreatedAt(), ID: last.ID()}
+	}
+
+	result.Comments = comments
+	return result, nil
+}
+
+func (r *CommentRepo) Update(ctx context.Context, c *comment.Comment) error {
+	query := `
+		UPDATE comments
+		SET body = $1, edited_at = $2
+		WHERE id = $3 AND company_id = $4
+	`
+
+	result, err := r.client.pool.Exec(ctx, query, c.Body(), c.EditedAt(), c.ID().UUID(), c.CompanyID().UUID())
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return apperr.NewErrNotFound("comment", c.ID().String())
+	}
+
+	return nil
+}
+
+func (r *CommentRepo) Delete(ctx context.Context, commentID id.CommentID, companyID id.CompanyID) error {
+	query := `DELETE FROM comments WHERE id = $1 AND company_id = $2`
+
+	result, err := r.client.pool.Exec(ctx, query, commentID.UUID(), companyID.UUID())
+	if err != nil {
+		if violatesConstraint(err, "comments_parent_fkey") {
+			return apperr.NewErrFailedPrecondition("delete", "comment", "comment has replies")
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return apperr.NewErrNotFound("comment", commentID.String())
+	}
+
+	return nil
+}
+
+type commentRow struct {
+	id        string
+	companyID string
+	taskID    string
+	authorID  string
+	parentID  *string
+	body      string
+	createdAt time.Time
+	editedAt  *time.Time
+}
+
+func (cr *commentRow) dest() []interface{} {
+	return []interface{}{&cr.id, &cr.companyID, &cr.taskID, &cr.authorID, &cr.parentID, &cr.body, &cr.createdAt, &cr.editedAt}
+}
+
+func (cr *commentRow) toComment() (*comment.Comment, error) {
+	parsedID, _ := id.ParseCommentID(cr.id)
+	parsedCompanyID, _ := id.ParseCompanyID(cr.companyID)
+	parsedTaskID, _ := id.ParseTaskID(cr.taskID)
+	parsedAuthorID, _ := id.ParseUserID(cr.authorID)
+
+	var parsedParentID *id.CommentID
+	if cr.parentID != nil {
+		pid, _ := id.ParseCommentID(*cr.parentID)
+		parsedParentID = &pid
+	}
+
+	return comment.NewBuilder().
+		ID(parsedID).
+		CompanyID(parsedCompanyID).
+		TaskID(parsedTaskID).
+		AuthorID(parsedAuthorID).
+		ParentID(parsedParentID).
+		Body(cr.body).
+		CreatedAt(cr.createdAt).
+		EditedAt(cr.editedAt).
+		Build()
+}
+
+var _ comment.Repo = (*CommentRepo)(nil)
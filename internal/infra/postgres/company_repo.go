@@ -10,8 +10,12 @@ import (
 	"github.com/pyshx/todoapp/pkg/apperr"
 	"github.com/pyshx/todoapp/pkg/company"
 	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
 )
 
+const companyColumns = `id, name, auto_archive_after_days, suspended_at, created_at`
+
 type CompanyRepo struct {
 	client *Client
 }
@@ -20,31 +24,119 @@ func NewCompanyRepo(client *Client) *CompanyRepo {
 	return &CompanyRepo{client: client}
 }
 
+func (r *CompanyRepo) Create(ctx context.Context, c *company.Company, owner *user.User, workflow *task.Workflow) error {
+	err := pgx.BeginFunc(ctx, r.client.pool, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO companies (id, name, auto_archive_after_days, created_at)
+			VALUES ($1, $2, $3, $4)
+		`, c.ID().UUID(), c.Name(), autoArchiveAfterDays(c), c.CreatedAt()); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO users (id, company_id, email, role, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+		`, owner.ID().UUID(), owner.CompanyID().UUID(), owner.Email(), owner.Role().String(), owner.CreatedAt()); err != nil {
+			return err
+		}
+
+		return saveWorkflow(ctx, tx, workflow)
+	})
+	if isUniqueViolation(err) {
+		return apperr.NewErrAlreadyExists("user", "email is already in use")
+	}
+	return err
+}
+
 func (r *CompanyRepo) FindByID(ctx context.Context, companyID id.CompanyID) (*company.Company, error) {
 	query := `
-		SELECT id, name, created_at
+		SELECT ` + companyColumns + `
 		FROM companies
 		WHERE id = $1
 	`
 
 	row := r.client.pool.QueryRow(ctx, query, companyID.UUID())
+	return scanCompany(row, companyID.String())
+}
+
+func (r *CompanyRepo) List(ctx context.Context) ([]*company.Company, error) {
+	query := `
+		SELECT ` + companyColumns + `
+		FROM companies
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.client.pool.Query(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var companies []*company.Company
+	for rows.Next() {
+		c, err := scanCompany(rows, "")
+		if err != nil {
+			return nil, err
+		}
+		companies = append(companies, c)
+	}
+	return companies, rows.Err()
+}
+
+// Update stores an auto-archive period of zero as NULL.
+func (r *CompanyRepo) Update(ctx context.Context, c *company.Company) error {
+	query := `
+		UPDATE companies
+		SET name = $1, auto_archive_after_days = $2, suspended_at = $3
+		WHERE id = $4
+	`
+
+	result, err := r.client.pool.Exec(ctx, query, c.Name(), autoArchiveAfterDays(c), c.SuspendedAt(), c.ID().UUID())
+	if err != nil {
+		return err
+	}
 
+	if result.RowsAffected() == 0 {
+		return apperr.NewErrNotFound("company", c.ID().String())
+	}
+
+	return nil
+}
+
+// autoArchiveAfterDays maps a period of zero to NULL.
+func autoArchiveAfterDays(c *company.Company) interface{} {
+	if c.AutoArchiveAfterDays() > 0 {
+		return c.AutoArchiveAfterDays()
+	}
+	return nil
+}
+
+func scanCompany(row pgx.Row, key string) (*company.Company, error) {
 	var dbID, name string
+	var autoArchiveAfterDays *int
+	var suspendedAt *time.Time
 	var createdAt time.Time
 
-	err := row.Scan(&dbID, &name, &createdAt)
+	err := row.Scan(&dbID, &name, &autoArchiveAfterDays, &suspendedAt, &createdAt)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, apperr.NewErrNotFound("company", companyID.String())
+			return nil, apperr.NewErrNotFound("company", key)
 		}
 		return nil, err
 	}
 
 	parsedID, _ := id.ParseCompanyID(dbID)
 
+	var days int
+	if autoArchiveAfterDays != nil {
+		days = *autoArchiveAfterDays
+	}
+
 	c, err := company.NewBuilder().
 		ID(parsedID).
 		Name(name).
+		AutoArchiveAfterDays(days).
+		SuspendedAt(suspendedAt).
 		CreatedAt(createdAt).
 		Build()
 	if err != nil {
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type InviteRepo struct {
+	client *Client
+}
+
+func NewInviteRepo(client *Client) *InviteRepo {
+	return &InviteRepo{client: client}
+}
+
+func (r *InviteRepo) Create(ctx context.Context, i *user.Invite) error {
+	_, err := r.client.pool.Exec(ctx, `
+		INSERT INTO user_invites (id, company_id, email, role, invited_by, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`, i.ID().UUID(), i.CompanyID().UUID(), i.Email(), i.Role().String(), i.InvitedBy().UUID(), i.TokenHash(), i.ExpiresAt(), i.CreatedAt())
+	return err
+}
+
+func (r *InviteRepo) FindByTokenHash(ctx context.Context, tokenHash string) (*user.Invite, error) {
+	var dbID, dbCompanyID, dbInvitedBy string
+	var email, role string
+	var expiresAt, createdAt time.Time
+	var acceptedAt *time.Time
+
+	err := r.client.pool.QueryRow(ctx, `
+		SELECT id, company_id, email, role, invited_by, expires_at, accepted_at, created_at
+		FROM user_invites
+		WHERE token_hash = $1
+	`, tokenHash).Scan(&dbID, &dbCompanyID, &email, &role, &dbInvitedBy, &expiresAt, &acceptedAt, &createdAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NewErrNotFound("invite", "token")
+		}
+		return nil, err
+	}
+
+	parsedRole, ok := user.ParseRole(role)
+	if !ok {
+		parsedRole = user.RoleViewer
+	}
+
+	inviteID, _ := id.ParseInviteID(dbID)
+	companyID, _ := id.ParseCompanyID(dbCompanyID)
+	invitedBy, _ := id.ParseUserID(dbInvitedBy)
+
+	return user.NewInviteBuilder().
+		ID(inviteID).
+		CompanyID(companyID).
+		Email(email).
+		Role(parsedRole).
+		InvitedBy(invitedBy).
+		TokenHash(tokenHash).
+		ExpiresAt(expiresAt).
+		AcceptedAt(acceptedAt).
+		CreatedAt(createdAt).
+		Build()
+}
+
+// Accept claims the invite and creates the user in one transaction. The
+// claim only succeeds while the invite is unaccepted, so a token cannot be
+// used twice even by concurrent requests.
+func (r *InviteRepo) Accept(ctx context.Context, i *user.Invite, u *user.User, now time.Time) error {
+	err := pgx.BeginFunc(ctx, r.client.pool, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, `
+			UPDATE user_invites SET accepted_at = $1
+			WHERE id = $2 AND accepted_at IS NULL
+		`, now, i.ID().UUID())
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return apperr.NewErrFailedPrecondition("accept", "invite", "invite was already used")
+		}
+
+		_, err = tx.Exec(ctx, `
+			INSERT INTO users (id, company_id, email, role, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+		`, u.ID().UUID(), u.CompanyID().UUID(), u.Email(), u.Role().String(), u.CreatedAt())
+		return err
+	})
+	if isUniqueViolation(err) {
+		return apperr.NewErrAlreadyExists("user", "email is already in use")
+	}
+	return err
+}
+
+var _ user.InviteRepo = (*InviteRepo)(nil)
@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/label"
+)
+
+type LabelRepo struct {
+	client *Client
+}
+
+func NewLabelRepo(client *Client) *LabelRepo {
+	return &LabelRepo{client: client}
+}
+
+func (r *LabelRepo) Create(ctx context.Context, l *label.Label) error {
+	query := `
+		INSERT INTO labels (id, company_id, name, color, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := r.client.pool.Exec(ctx, query,
+		l.ID().UUID(),
+		l.CompanyID().UUID(),
+		l.Name(),
+		l.Color(),
+		l.CreatedAt(),
+	)
+	if isUniqueViolation(err) {
+		return apperr.NewErrAlreadyExists("label", "name is already used in this company")
+	}
+	return err
+}
+
+func (r *LabelRepo) FindByIDForCompany(ctx context.Context, labelID id.LabelID, companyID id.CompanyID) (*label.Label, error) {
+	query := `
+		SELECT id, company_id, name, color, created_at
+		FROM labels
+		WHERE id = $1 AND company_id = $2
+	`
+
+	l, err := r.scanLabel(r.client.pool.QueryRow(ctx, query, labelID.UUID(), companyID.UUID()))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NewErrNotFound("label", labelID.String())
+		}
+		return nil, err
+	}
+	return l, nil
+}
+
+func (r *LabelRepo) FindByIDsForCompany(ctx context.Context, labelIDs []id.LabelID, companyID id.CompanyID) ([]*label.Label, error) {
+	query := `
+		SELECT id, company_id, name, color, created_at
+		FROM labels
+		WHERE id = ANY($1) AND company_id = $2
+		ORDER BY lower(name)
+	`
+
+	ids := make([]uuid.UUID, len(labelIDs))
+	for i, l := range labelIDs {
+		ids[i] = l.UUID()
+	}
+
+	rows, err := r.client.pool.Query(ctx, query, ids, companyID.UUID())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanLabels(rows)
+}
+
+func (r *LabelRepo) ListByCompany(ctx context.Context, companyID id.CompanyID) ([]*label.Label, error) {
+	query := `
+		SELECT id, company_id, name, color, created_at
+		FROM labels
+		WHERE company_id = $1
+		ORDER BY lower(name)
+	`
+
+	rows, err := r.client.pool.Query(ctx, query, companyID.UUID())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return r.scanLabels(rows)
+}
+
+func (r *LabelRepo) Update(ctx context.Context, l *label.Label) error {
+	query := `
+		UPDATE labels
+		SET name = $1, color = $2
+		WHERE id = $3 AND company_id = $4
+	`
+
+	result, err := r.client.pool.Exec(ctx, query, l.Name(), l.Color(), l.ID().UUID(), l.CompanyID().UUID())
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperr.NewErrAlreadyExists("label", "name is already used in this company")
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return apperr.NewErrNotFound("label", l.ID().String())
+	}
+
+	return nil
+}
+
+func (r *LabelRepo) Delete(ctx context.Context, labelID id.LabelID, companyID id.CompanyID) error {
+	query := `DELETE FROM labels WHERE id = $1 AND company_id = $2`
+
+	result, err := r.client.pool.Exec(ctx, query, labelID.UUID(), companyID.UUID())
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return apperr.NewErrNotFound("label", labelID.String())
+	}
+
+	return nil
+}
+
+func (r *LabelRepo) scanLabels(rows pgx.Rows) ([]*label.Label, error) {
+	var labels []*label.Label
+
+	for rows.Next() {
+		l, err := r.scanLabel(rows)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, l)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return labels, nil
+}
+
+func (r *LabelRepo) scanLabel(row pgx.Row) (*label.Label, error) {
+	var dbID, dbCompanyID string
+	var name, color string
+	var createdAt time.Time
+
+	if err := row.Scan(&dbID, &dbCompanyID, &name, &color, &createdAt); err != nil {
+		return nil, err
+	}
+
+	parsedID, _ := id.ParseLabelID(dbID)
+	parsedCompanyID, _ := id.ParseCompanyID(dbCompanyID)
+
+	return label.NewBuilder().
+		ID(parsedID).
+		CompanyID(parsedCompanyID).
+		Name(name).
+		Color(color).
+		CreatedAt(createdAt).
+		Build()
+}
+
+var _ label.Repo = (*LabelRepo)(nil)
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/mention"
+)
+
+type MentionRepo struct {
+	client *Client
+}
+
+func NewMentionRepo(client *Client) *MentionRepo {
+	return &MentionRepo{client: client}
+}
+
+func (r *MentionRepo) Replace(ctx context.Context, source mention.Source, userIDs []id.UserID) ([]id.UserID, error) {
+	ids := userUUIDs(userIDs)
+
+	var commentID interface{}
+	if source.CommentID != nil {
+		commentID = source.CommentID.UUID()
+	}
+
+	var added []id.UserID
+
+	err := pgx.BeginFunc(ctx, r.client.pool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, `
+			DELETE FROM mentions
+			WHERE task_id = $1 AND comment_id IS NOT DISTINCT FROM $2 AND NOT (user_id = ANY($3))
+		`, source.TaskID.UUID(), commentID, ids)
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, `
+			INSERT INTO mentions (company_id, task_id, comment_id, user_id)
+			SELECT $1::uuid, $2::uuid, $3::uuid, unnest($4::uuid[])
+			ON CONFLICT DO NOTHING
+			RETURNING user_id::text
+		`, source.CompanyID.UUID(), source.TaskID.UUID(), commentID, ids)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var s string
+			if err := rows.Scan(&s); err != nil {
+				return err
+			}
+			userID, _ := id.ParseUserID(s)
+			added = append(added, userID)
+		}
+
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return added, nil
+}
+
+var _ mention.Repo = (*MentionRepo)(nil)
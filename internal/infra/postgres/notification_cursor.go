@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/notification"
+)
+
+// EncodeNotificationCursor serializes a notification cursor into a page token
+// bound to the recipient whose inbox is being listed.
+func EncodeNotificationCursor(cursor *notification.PageCursor, recipientID id.UserID) string {
+	if cursor == nil {
+		return ""
+	}
+	data, _ := json.Marshal(map[string]interface{}{
+		"created_at":   cursor.CreatedAt,
+		"id":           cursor.ID.String(),
+		"recipient_id": recipientID.String(),
+	})
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func DecodeNotificationCursor(token string, recipientID id.UserID) (*notification.PageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+
+	if tokenRecipientID, _ := m["recipient_id"].(string); tokenRecipientID != recipientID.String() {
+		return nil, apperr.NewErrInvalidInput("page_token", "does not match the current user")
+	}
+
+	createdAt, ok := cursorTime(m, "created_at")
+	if !ok {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+
+	idStr, _ := m["id"].(string)
+	notificationID, err := id.ParseNotificationID(idStr)
+	if err != nil {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+
+	return &notification.PageCursor{CreatedAt: createdAt, ID: notificationID}, nil
+}
@@ -0,0 +1,243 @@
+package postgres
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/notification"
+)
+
+const notificationColumns = "id, company_id, recipient_id, actor_id, kind, task_id, comment_id, due_date, changed_fields, created_at, read_at"
+
+type NotificationRepo struct {
+	client *Client
+}
+
+func NewNotificationRepo(client *Client) *NotificationRepo {
+	return &NotificationRepo{client: client}
+}
+
+func (r *NotificationRepo) Create(ctx context.Context, notifications []*notification.Notification) error {
+	query := `
+		INSERT INTO notifications (` + notificationColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	return pgx.BeginFunc(ctx, r.client.pool, func(tx pgx.Tx) error {
+		for _, n := range notifications {
+			var actorID interface{}
+			if n.ActorID() != nil {
+				actorID = n.ActorID().UUID()
+			}
+			var commentID interface{}
+			if n.CommentID() != nil {
+				commentID = n.CommentID().UUID()
+			}
+
+			_, err := tx.Exec(ctx, query,
+				n.ID().UUID(),
+				n.CompanyID().UUID(),
+				n.RecipientID().UUID(),
+				actorID,
+				n.Kind().String(),
+				n.TaskID().UUID(),
+				commentID,
+				n.DueDate(),
+				changedFields(n),
+				n.CreatedAt(),
+				n.ReadAt(),
+			)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *NotificationRepo) ListForRecipient(ctx context.Context, recipientID id.UserID, opts notification.ListOptions) (*notification.ListResult, error) {
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	q := &listQuery{}
+	q.where("recipient_id = " + q.arg(recipientID.UUID()))
+	if opts.UnreadOnly {
+		q.where("read_at IS NULL")
+	}
+	if opts.Cursor != nil {
+		q.where("(created_at, id) < (" + q.arg(opts.Cursor.CreatedAt) + ", " + q.arg(opts.Cursor.ID.UUID()) + ")")
+	}
+
+	query := `
+		SELECT ` + notificationColumns + `
+		FROM notifications
+		WHERE ` + strings.Join(q.conds, " AND ") + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ` + q.arg(pageSize+1)
+
+	rows, err := r.client.pool.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var notifications []*notification.Notification
+
+	for rows.Next() {
+		var nr notificationRow
+		if err := rows.Scan(nr.dest()...); err != nil {
+			return nil, err
+		}
+
+		n, err := nr.toNotification()
+		if err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, n)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	result := &notification.ListResult{}
+
+	if len(notifications) > pageSize {
+		notifications = notifications[:pageSize]
+		last := notifications[len(notifications)-1]
+		result.NextCursor = &notification.PageCursor{CreatedAt: last.CreatedAt(), ID: last.ID()}
+	}
+
+	result.Notifications = notifications
+	return result, nil
+}
+
+func (r *NotificationRepo) MarkRead(ctx context.Context, recipientID id.UserID, ids []id.NotificationID, at time.Time) (int, error) {
+	q := &listQuery{}
+	set := "read_at = " + q.arg(at)
+	q.where("recipient_id = " + q.arg(recipientID.UUID()))
+	q.where("read_at IS NULL")
+	if len(ids) > 0 {
+		uuids := make([]uuid.UUID, len(ids))
+		for i, nid := range ids {
+			uuids[i] = nid.UUID()
+		}
+		q.where("id = ANY(" + q.arg(uuids) + ")")
+	}
+
+	query := `UPDATE notifications SET ` + set + ` WHERE ` + strings.Join(q.conds, " AND ")
+
+	result, err := r.client.pool.Exec(ctx, query, q.args...)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+func (r *NotificationRepo) CountUnread(ctx context.Context, recipientID id.UserID) (int, error) {
+	query := `SELECT COUNT(*) FROM notifications WHERE recipient_id = $1 AND read_at IS NULL`
+
+	var count int
+	if err := r.client.pool.QueryRow(ctx, query, recipientID.UUID()).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// CreateDueSoon sends the reminder to every assignee, or to the creator when
+// nobody is assigned. Archived tasks and deactivated recipients get none.
+// idx_notifications_due_soon turns repeats into no-ops.
+func (r *NotificationRepo) CreateDueSoon(ctx context.Context, now time.Time, window time.Duration) (int, error) {
+	query := `
+		INSERT INTO notifications (id, company_id, recipient_id, kind, task_id, due_date, created_at)
+		SELECT uuid_generate_v4(), tasks.company_id, u.id, 'due_soon', tasks.id, tasks.due_date, $1
+		FROM tasks
+		LEFT JOIN task_assignees ta ON ta.task_id = tasks.id
+		JOIN users u ON u.id = COALESCE(ta.user_id, tasks.creator_id) AND u.deactivated_at IS NULL
+		WHERE ` + statusCategory("tasks.") + ` <> 'done' AND tasks.deleted_at IS NULL AND tasks.archived_at IS NULL
+			AND tasks.due_date > $1 AND tasks.due_date <= $2
+		ON CONFLICT DO NOTHING
+	`
+
+	result, err := r.client.pool.Exec(ctx, query, now, now.Add(window))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// changedFields stores a missing field list as an empty array, matching the
+// column default.
+func changedFields(n *notification.Notification) []string {
+	if n.ChangedFields() == nil {
+		return []string{}
+	}
+	return n.ChangedFields()
+}
+
+type notificationRow struct {
+	id          string
+	companyID   string
+	recipientID string
+	actorID     *string
+	kind        string
+	taskID      string
+	commentID   *string
+	dueDate     *time.Time
+	fields      []string
+	createdAt   time.Time
+	readAt      *time.Time
+}
+
+func (nr *notificationRow) dest() []interface{} {
+	return []interface{}{&nr.id, &nr.companyID, &nr.recipientID, &nr.actorID, &nr.kind, &nr.taskID, &nr.commentID, &nr.dueDate, &nr.fields, &nr.createdAt, &nr.readAt}
+}
+
+func (nr *notificationRow) toNotification() (*notification.Notification, error) {
+	parsedID, _ := id.ParseNotificationID(nr.id)
+	parsedCompanyID, _ := id.ParseCompanyID(nr.companyID)
+	parsedRecipientID, _ := id.ParseUserID(nr.recipientID)
+	parsedTaskID, _ := id.ParseTaskID(nr.taskID)
+	kind, _ := notification.ParseKind(nr.kind)
+
+	var parsedActorID *id.UserID
+	if nr.actorID != nil {
+		aid, _ := id.ParseUserID(*nr.actorID)
+		parsedActorID = &aid
+	}
+
+	var parsedCommentID *id.CommentID
+	if nr.commentID != nil {
+		cid, _ := id.ParseCommentID(*nr.commentID)
+		parsedCommentID = &cid
+	}
+
+	return notification.NewBuilder().
+		ID(parsedID).
+		CompanyID(parsedCompanyID).
+		RecipientID(parsedRecipientID).
+		ActorID(parsedActorID).
+		Kind(kind).
+		TaskID(parsedTaskID).
+		CommentID(parsedCommentID).
+		DueDate(nr.dueDate).
+		ChangedFields(nr.fields).
+		CreatedAt(nr.createdAt).
+		ReadAt(nr.readAt).
+		Build()
+}
+
+var _ notification.Repo = (*NotificationRepo)(nil)
@@ -0,0 +1,209 @@
+package postgres
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+// EncodeCursor serializes a cursor into a page token bound to the filter that
+// produced it, so the token cannot be replayed against a different filter.
+// Tokens for the default sort keep the original {created_at, id} shape.
+func EncodeCursor(cursor *task.PageCursor, filter task.Filter) string {
+	if cursor == nil {
+		return ""
+	}
+
+	sort := cursor.Sort.OrDefault()
+	m := map[string]interface{}{
+		"id": cursor.ID.String(),
+	}
+	if sort != task.DefaultSort {
+		m["sort"] = sort.Field.String()
+		m["direction"] = sort.Direction.String()
+	}
+
+	switch sort.Field {
+	case task.SortByDueDate:
+		m["due_date"] = cursor.DueDate
+	case task.SortByUpdatedAt:
+		m["updated_at"] = cursor.UpdatedAt
+	case task.SortByTitle:
+		m["title"] = cursor.Title
+	case task.SortByStatus:
+		m["status"] = cursor.Status.String()
+	case task.SortByPriority:
+		m["priority"] = cursor.Priority.String()
+	default:
+		m["created_at"] = cursor.CreatedAt
+	}
+
+	if key := filterKey(filter); key != "" {
+		m["filter"] = key
+	}
+	data, _ := json.Marshal(m)
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// DecodeCursor parses a page token and checks that it was issued for the same
+// filter and sort order as the current request.
+func DecodeCursor(token string, filter task.Filter, sort task.Sort) (*task.PageCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+
+	tokenFilter, _ := m["filter"].(string)
+	if tokenFilter != filterKey(filter) {
+		return nil, apperr.NewErrInvalidInput("page_token", "does not match the current filter")
+	}
+
+	tokenSort := task.DefaultSort
+	if field, ok := m["sort"].(string); ok {
+		direction, _ := m["direction"].(string)
+		tokenSort = task.Sort{Field: task.SortField(field), Direction: task.SortDirection(direction)}
+	}
+	if tokenSort != sort.OrDefault() {
+		return nil, apperr.NewErrInvalidInput("page_token", "does not match the current sort order")
+	}
+
+	idStr, ok := m["id"].(string)
+	if !ok {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+	taskID, err := id.ParseTaskID(idStr)
+	if err != nil {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+
+	cursor := &task.PageCursor{Sort: tokenSort, ID: taskID}
+
+	switch tokenSort.Field {
+	case task.SortByDueDate:
+		if m["due_date"] != nil {
+			dueDate, ok := cursorTime(m, "due_date")
+			if !ok {
+				return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+			}
+			cursor.DueDate = &dueDate
+		}
+	case task.SortByUpdatedAt:
+		if cursor.UpdatedAt, ok = cursorTime(m, "updated_at"); !ok {
+			return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+		}
+	case task.SortByTitle:
+		if cursor.Title, ok = m["title"].(string); !ok {
+			return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+		}
+	case task.SortByStatus:
+		status, _ := m["status"].(string)
+		if cursor.Status, ok = task.ParseStatus(status); !ok {
+			return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+		}
+	case task.SortByPriority:
+		priority, _ := m["priority"].(string)
+		if cursor.Priority, ok = task.ParsePriority(priority); !ok {
+			return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+		}
+	default:
+		if cursor.CreatedAt, ok = cursorTime(m, "created_at"); !ok {
+			return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+		}
+	}
+
+	return cursor, nil
+}
+
+// EncodeSearchCursor serializes a search cursor into a page token bound to
+// the query that produced it.
+func EncodeSearchCursor(cursor *task.SearchCursor, query string) string {
+	if cursor == nil {
+		return ""
+	}
+	data, _ := json.Marshal(map[string]interface{}{
+		"score": cursor.Score,
+		"id":    cursor.ID.String(),
+		"query": queryKey(query),
+	})
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func DecodeSearchCursor(token string, query string) (*task.SearchCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+
+	if tokenQuery, _ := m["query"].(string); tokenQuery != queryKey(query) {
+		return nil, apperr.NewErrInvalidInput("page_token", "does not match the current query")
+	}
+
+	score, ok := m["score"].(float64)
+	if !ok {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+
+	idStr, ok := m["id"].(string)
+	if !ok {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+	taskID, err := id.ParseTaskID(idStr)
+	if err != nil {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+
+	return &task.SearchCursor{Score: score, ID: taskID}, nil
+}
+
+func cursorTime(m map[string]interface{}, key string) (time.Time, bool) {
+	s, ok := m[key].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+func filterKey(filter task.Filter) string {
+	if filter.IsEmpty() {
+		return ""
+	}
+	data, _ := json.Marshal(filter)
+	return shortHash(data)
+}
+
+func queryKey(query string) string {
+	return shortHash([]byte(query))
+}
+
+func shortHash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
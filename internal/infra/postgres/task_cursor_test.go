@@ -0,0 +1,164 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/infra/postgres"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+func TestCursor_BoundToFilter(t *testing.T) {
+	cursor := &task.PageCursor{
+		CreatedAt: time.Now().UTC().Truncate(time.Microsecond),
+		ID:        id.NewTaskID(),
+	}
+	filter := task.Filter{Statuses: []task.Status{task.StatusDone}}
+
+	token := postgres.EncodeCursor(cursor, filter)
+
+	decoded, err := postgres.DecodeCursor(token, filter, task.Sort{})
+	if err != nil {
+		t.Fatalf("failed to decode cursor with the same filter: %v", err)
+	}
+	if !decoded.ID.Equal(cursor.ID) || !decoded.CreatedAt.Equal(cursor.CreatedAt) {
+		t.Errorf("decoded cursor = %+v, want %+v", decoded, cursor)
+	}
+
+	if _, err := postgres.DecodeCursor(token, task.Filter{}, task.Sort{}); err == nil {
+		t.Error("expected error when decoding cursor with a different filter")
+	}
+}
+
+func TestCursor_LegacyFormat(t *testing.T) {
+	// Tokens issued before sorting was configurable: {"created_at", "id"}
+	token := "eyJjcmVhdGVkX2F0IjoiMjAyNS0wMS0wMlQwMzowNDowNS4xMjM0NTZaIiwiaWQiOiJkZGRkZGRkZC1kZGRkLWRkZGQtZGRkZC1kZGRkZGRkZGRkZGQifQ=="
+
+	decoded, err := postgres.DecodeCursor(token, task.Filter{}, task.Sort{})
+	if err != nil {
+		t.Fatalf("failed to decode legacy cursor: %v", err)
+	}
+	if decoded.Sort != task.DefaultSort {
+		t.Errorf("Sort = %+v, want %+v", decoded.Sort, task.DefaultSort)
+	}
+	want := time.Date(2025, 1, 2, 3, 4, 5, 123456000, time.UTC)
+	if !decoded.CreatedAt.Equal(want) {
+		t.Errorf("CreatedAt = %v, want %v", decoded.CreatedAt, want)
+	}
+}
+
+func TestCursor_Sort(t *testing.T) {
+	dueDate := time.Now().UTC().Truncate(time.Microsecond)
+
+	tests := []struct {
+		name   string
+		cursor *task.PageCursor
+		check  func(t *testing.T, c *task.PageCursor)
+	}{
+		{
+			name: "due date",
+			cursor: &task.PageCursor{
+				Sort:    task.Sort{Field: task.SortByDueDate, Direction: task.SortAsc},
+				DueDate: &dueDate,
+				ID:      id.NewTaskID(),
+			},
+			check: func(t *testing.T, c *task.PageCursor) {
+				if c.DueDate == nil || !c.DueDate.Equal(dueDate) {
+					t.Errorf("DueDate = %v, want %v", c.DueDate, dueDate)
+				}
+			},
+		},
+		{
+			name: "null due date",
+			cursor: &task.PageCursor{
+				Sort: task.Sort{Field: task.SortByDueDate, Direction: task.SortDesc},
+				ID:   id.NewTaskID(),
+			},
+			check: func(t *testing.T, c *task.PageCursor) {
+				if c.DueDate != nil {
+					t.Errorf("DueDate = %v, want nil", c.DueDate)
+				}
+			},
+		},
+		{
+			name: "title",
+			cursor: &task.PageCursor{
+				Sort:  task.Sort{Field: task.SortByTitle, Direction: task.SortAsc},
+				Title: "Review PR",
+				ID:    id.NewTaskID(),
+			},
+			check: func(t *testing.T, c *task.PageCursor) {
+				if c.Title != "Review PR" {
+					t.Errorf("Title = %q, want %q", c.Title, "Review PR")
+				}
+			},
+		},
+		{
+			name: "status",
+			cursor: &task.PageCursor{
+				Sort:   task.Sort{Field: task.SortByStatus, Direction: task.SortDesc},
+				Status: task.StatusInProgress,
+				ID:     id.NewTaskID(),
+			},
+			check: func(t *testing.T, c *task.PageCursor) {
+				if c.Status != task.StatusInProgress {
+					t.Errorf("Status = %s, want %s", c.Status, task.StatusInProgress)
+				}
+			},
+		},
+		{
+			name: "priority",
+			cursor: &task.PageCursor{
+				Sort:     task.Sort{Field: task.SortByPriority, Direction: task.SortDesc},
+				Priority: task.PriorityHigh,
+				ID:       id.NewTaskID(),
+			},
+			check: func(t *testing.T, c *task.PageCursor) {
+				if c.Priority != task.PriorityHigh {
+					t.Errorf("Priority = %s, want %s", c.Priority, task.PriorityHigh)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := postgres.EncodeCursor(tt.cursor, task.Filter{})
+
+			decoded, err := postgres.DecodeCursor(token, task.Filter{}, tt.cursor.Sort)
+			if err != nil {
+				t.Fatalf("failed to decode cursor: %v", err)
+			}
+			if decoded.Sort != tt.cursor.Sort {
+				t.Errorf("Sort = %+v, want %+v", decoded.Sort, tt.cursor.Sort)
+			}
+			if !decoded.ID.Equal(tt.cursor.ID) {
+				t.Errorf("ID = %s, want %s", decoded.ID, tt.cursor.ID)
+			}
+			tt.check(t, decoded)
+
+			if _, err := postgres.DecodeCursor(token, task.Filter{}, task.DefaultSort); err == nil {
+				t.Error("expected error when decoding cursor with a different sort")
+			}
+		})
+	}
+}
+
+func TestSearchCursor_BoundToQuery(t *testing.T) {
+	cursor := &task.SearchCursor{Score: 0.0759909, ID: id.NewTaskID()}
+
+	token := postgres.EncodeSearchCursor(cursor, "review docs")
+
+	decoded, err := postgres.DecodeSearchCursor(token, "review docs")
+	if err != nil {
+		t.Fatalf("failed to decode search cursor: %v", err)
+	}
+	if decoded.Score != cursor.Score || !decoded.ID.Equal(cursor.ID) {
+		t.Errorf("decoded cursor = %+v, want %+v", decoded, cursor)
+	}
+
+	if _, err := postgres.DecodeSearchCursor(token, "other query"); err == nil {
+		t.Error("expected error when decoding search cursor with a different query")
+	}
+}
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+type TaskDependencyRepo struct {
+	client *Client
+}
+
+func NewTaskDependencyRepo(client *Client) *TaskDependencyRepo {
+	return &TaskDependencyRepo{client: client}
+}
+
+// Add serializes the edges of a company on a transaction-scoped advisory
+// lock, so two concurrent Adds cannot each miss the cycle the other closes.
+func (r *TaskDependencyRepo) Add(ctx context.Context, companyID id.CompanyID, blockerID, blockedID id.TaskID) error {
+	err := pgx.BeginFunc(ctx, r.client.pool, func(tx pgx.Tx) error {
+		if err := lockCompany(ctx, tx, "task_dependencies", companyID); err != nil {
+			return err
+		}
+
+		// The new edge closes a cycle if the blocked task already blocks
+		// the blocker. UNION discards rows already seen, so the walk ends
+		// even on a cycle.
+		var cycle bool
+		if err := tx.QueryRow(ctx, `
+			WITH RECURSIVE blockers(task_id) AS (
+				SELECT blocker_id FROM task_dependencies WHERE blocked_id = $1 AND company_id = $2
+				UNION
+				SELECT d.blocker_id
+				FROM task_dependencies d
+				JOIN blockers b ON d.blocked_id = b.task_id
+				WHERE d.company_id = $2
+			)
+			SELECT EXISTS (SELECT 1 FROM blockers WHERE task_id = $3)
+		`, blockerID.UUID(), companyID.UUID(), blockedID.UUID()).Scan(&cycle); err != nil {
+			return err
+		}
+		if cycle {
+			return apperr.NewErrInvalidInput("blocker_id", "would create a dependency cycle")
+		}
+
+		_, err := tx.Exec(ctx, `
+			INSERT INTO task_dependencies (blocker_id, blocked_id, company_id)
+			VALUES ($1, $2, $3)
+		`, blockerID.UUID(), blockedID.UUID(), companyID.UUID())
+		return err
+	})
+	if isUniqueViolation(err) {
+		return apperr.NewErrAlreadyExists("task dependency", "the task is already blocked by this task")
+	}
+	if isForeignKeyViolation(err) {
+		return apperr.NewErrInvalidInput("blocker_id", "task not found")
+	}
+	return err
+}
+
+func (r *TaskDependencyRepo) Remove(ctx context.Context, companyID id.CompanyID, blockerID, blockedID id.TaskID) error {
+	query := `
+		DELETE FROM task_dependencies
+		WHERE blocker_id = $1 AND blocked_id = $2 AND company_id = $3
+	`
+
+	result, err := r.client.pool.Exec(ctx, query, blockerID.UUID(), blockedID.UUID(), companyID.UUID())
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return apperr.NewErrNotFound("task dependency", blockerID.String()+" -> "+blockedID.String())
+	}
+
+	return nil
+}
+
+func (r *TaskDependencyRepo) ListBlockers(ctx context.Context, companyID id.CompanyID, taskID id.TaskID) ([]*task.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `, ` + taskDerivedColumns + `
+		FROM tasks
+		WHERE id IN (SELECT blocker_id FROM task_dependencies WHERE blocked_id = $1 AND company_id = $2)
+		  AND deleted_at IS NULL
+		ORDER BY created_at, id
+	`
+	return r.listTasks(ctx, query, taskID.UUID(), companyID.UUID())
+}
+
+func (r *TaskDependencyRepo) ListDependents(ctx context.Context, companyID id.CompanyID, taskID id.TaskID) ([]*task.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `, ` + taskDerivedColumns + `
+		FROM tasks
+		WHERE id IN (SELECT blocked_id FROM task_dependencies WHERE blocker_id = $1 AND company_id = $2)
+		  AND deleted_at IS NULL
+		ORDER BY created_at, id
+	`
+	return r.listTasks(ctx, query, taskID.UUID(), companyID.UUID())
+}
+
+func (r *TaskDependencyRepo) listTasks(ctx context.Context, query string, args ...interface{}) ([]*task.Task, error) {
+	rows, err := r.client.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanTasks(rows)
+}
+
+var _ task.DependencyRepo = (*TaskDependencyRepo)(nil)
@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+// EncodeHistoryCursor serializes a history cursor into a page token bound to
+// the task whose history is being listed.
+func EncodeHistoryCursor(cursor *task.HistoryCursor, taskID id.TaskID) string {
+	if cursor == nil {
+		return ""
+	}
+	data, _ := json.Marshal(map[string]interface{}{
+		"created_at": cursor.CreatedAt,
+		"id":         cursor.ID.String(),
+		"task_id":    taskID.String(),
+	})
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func DecodeHistoryCursor(token string, taskID id.TaskID) (*task.HistoryCursor, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+
+	if tokenTaskID, _ := m["task_id"].(string); tokenTaskID != taskID.String() {
+		return nil, apperr.NewErrInvalidInput("page_token", "does not match the current task")
+	}
+
+	createdAt, ok := cursorTime(m, "created_at")
+	if !ok {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+
+	idStr, _ := m["id"].(string)
+	historyID, err := id.ParseHistoryID(idStr)
+	if err != nil {
+		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	}
+
+	return &task.HistoryCursor{CreatedAt: createdAt, ID: historyID}, nil
+}
@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+const historyColumns = "id, company_id, task_id, actor_id, action, version, changes, created_at"
+
+type TaskHistoryRepo struct {
+	client *Client
+}
+
+func NewTaskHistoryRepo(client *Client) *TaskHistoryRepo {
+	return &TaskHistoryRepo{client: client}
+}
+
+func (r *TaskHistoryRepo) ListByTask(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, opts task.HistoryListOptions) (*task.HistoryListResult, error) {
+	pageSize := opts.PageSize
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	if pageSize > 100 {
+		pageSize = 100
+	}
+
+	q := &listQuery{}
+	q.where("company_id = " + q.arg(companyID.UUID()))
+	q.where("task_id = " + q.arg(taskID.UUID()))
+	if opts.Cursor != nil {
+		q.where("(created_at, id) < (" + q.arg(opts.Cursor.CreatedAt) + ", " + q.arg(opts.Cursor.ID.UUID()) + ")")
+	}
+
+	query := `
+		SELECT ` + historyColumns + `
+		FROM task_history
+		WHERE ` + strings.Join(q.conds, " AND ") + `
+		ORDER BY created_at DESC, id DESC
+		LIMIT ` + q.arg(pageSize+1)
+
+	rows, err := r.client.pool.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*task.HistoryEntry
+
+	for rows.Next() {
+		var hr historyRow
+		if err := rows.Scan(hr.dest()...); err != nil {
+			return nil, err
+		}
+
+		e, err := hr.toEntry()
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	result := &task.HistoryListResult{}
+
+	if len(entries) > pageSize {
+		entries = entries[:pageSize]
+		last := entries[len(entries)-1]
+		result.NextCursor = &task.HistoryCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	result.Entries = entries
+	return result, nil
+}
+
+// fieldChangeJSON is how a task.FieldChange is stored in task_history.changes.
+type fieldChangeJSON struct {
+	Field  string  `json:"field"`
+	Before *string `json:"before"`
+	After  *string `json:"after"`
+}
+
+// insertHistory appends e inside tx, so the entry commits or rolls back with
+// the change it describes.
+func marshalChanges(changes []task.FieldChange) ([]byte, error) {
+	out := make([]fieldChangeJSON, len(changes))
+	for i, c := range changes {
+		out[i] = fieldChangeJSON{Field: c.Field.String(), Before: c.Before, After: c.After}
+	}
+	return json.Marshal(out)
+}
+
+func insertHistory(ctx context.Context, tx pgx.Tx, e *task.HistoryEntry) error {
+	query := `
+		INSERT INTO task_history (` + historyColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	data, err := marshalChanges(e.Changes)
+	if err != nil {
+		return err
+	}
+
+	var actorID interface{}
+	if e.ActorID != nil {
+		actorID = e.ActorID.UUID()
+	}
+
+	_, err = tx.Exec(ctx, query,
+		e.ID.UUID(),
+		e.CompanyID.UUID(),
+		e.TaskID.UUID(),
+		actorID,
+		e.Action.String(),
+		e.Version,
+		data,
+		e.CreatedAt,
+	)
+	return err
+}
+
+type historyRow struct {
+	id        string
+	companyID string
+	taskID    string
+	actorID   *string
+	action    string
+	version   int
+	changes   []byte
+	createdAt time.Time
+}
+
+func (hr *historyRow) dest() []interface{} {
+	return []interface{}{&hr.id, &hr.companyID, &hr.taskID, &hr.actorID, &hr.action, &hr.version, &hr.changes, &hr.createdAt}
+}
+
+func (hr *historyRow) toEntry() (*task.HistoryEntry, error) {
+	var stored []fieldChangeJSON
+	if err := json.Unmarshal(hr.changes, &stored); err != nil {
+		return nil, err
+	}
+
+	changes := make([]task.FieldChange, len(stored))
+	for i, c := range stored {
+		changes[i] = task.FieldChange{Field: task.Field(c.Field), Before: c.Before, After: c.After}
+	}
+
+	parsedID, _ := id.ParseHistoryID(hr.id)
+	parsedCompanyID, _ := id.ParseCompanyID(hr.companyID)
+	parsedTaskID, _ := id.ParseTaskID(hr.taskID)
+	action, _ := task.ParseHistoryAction(hr.action)
+
+	var parsedActorID *id.UserID
+	if hr.actorID != nil {
+		aid, _ := id.ParseUserID(*hr.actorID)
+		parsedActorID = &aid
+	}
+
+	return &task.HistoryEntry{
+		ID:        parsedID,
+		CompanyID: parsedCompanyID,
+		TaskID:    parsedTaskID,
+		ActorID:   parsedActorID,
+		Action:    action,
+		Version:   hr.version,
+		Changes:   changes,
+		CreatedAt: hr.createdAt,
+	}, nil
+}
+
+var _ task.HistoryRepo = (*TaskHistoryRepo)(nil)
@@ -2,11 +2,12 @@ package postgres
 
 import (
 	"context"
-	"encoding/base64"
-	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 
 	"github.com/pyshx/todoapp/pkg/apperr"
@@ -14,6 +15,26 @@ import (
 	"github.com/pyshx/todoapp/pkg/task"
 )
 
+const taskColumns = "id, company_id, creator_id, parent_id, title, description, due_date, visibility, team_id, status, priority, series_id, series_occurrence, version, created_at, updated_at, completed_at, archived_at, deleted_at"
+
+// taskDerivedColumns aggregates a task's assignees, labels, shares and the
+// progress of its live subtasks and looks up its status category; select it
+// after taskColumns from tasks and scan both with taskRow.
+var taskDerivedColumns = "ARRAY(SELECT user_id::text FROM task_assignees ta WHERE ta.task_id = tasks.id ORDER BY position) AS assignee_ids, " +
+	"ARRAY(SELECT label_id::text FROM task_labels tl WHERE tl.task_id = tasks.id ORDER BY label_id) AS label_ids, " +
+	"ARRAY(SELECT sh.user_id::text FROM task_shares sh WHERE sh.task_id = tasks.id ORDER BY sh.created_at, sh.user_id) AS share_user_ids, " +
+	"ARRAY(SELECT sh.role FROM task_shares sh WHERE sh.task_id = tasks.id ORDER BY sh.created_at, sh.user_id) AS share_roles, " +
+	"(SELECT COUNT(*) FILTER (WHERE " + statusCategory("st.") + " = 'done') FROM tasks st WHERE st.parent_id = tasks.id AND st.deleted_at IS NULL) AS subtask_done, " +
+	"(SELECT COUNT(*) FROM tasks st WHERE st.parent_id = tasks.id AND st.deleted_at IS NULL) AS subtask_total, " +
+	statusCategory("tasks.") + " AS status_category"
+
+// statusCategory is the workflow category of a task's status. prefix
+// qualifies the task columns and must not be empty, since the lookup has
+// columns of the same names.
+func statusCategory(prefix string) string {
+	return "(SELECT ws.category FROM workflow_statuses ws WHERE ws.company_id = " + prefix + "company_id AND ws.key = " + prefix + "status)"
+}
+
 type TaskRepo struct {
 	client *Client
 }
@@ -23,92 +44,240 @@ func NewTaskRepo(client *Client) *TaskRepo {
 }
 
 func (r *TaskRepo) Create(ctx context.Context, t *task.Task) error {
-	query := `
-		INSERT INTO tasks (id, company_id, creator_id, assignee_id, title, description, due_date, visibility, status, version, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
-	`
-
-	var assigneeID interface{}
-	if t.AssigneeID() != nil {
-		assigneeID = t.AssigneeID().UUID()
-	}
-
-	_, err := r.client.pool.Exec(ctx, query,
-		t.ID().UUID(),
-		t.CompanyID().UUID(),
-		t.CreatorID().UUID(),
-		assigneeID,
-		t.Title(),
-		t.Description(),
-		t.DueDate(),
-		t.Visibility().String(),
-		t.Status().String(),
-		t.Version(),
-		t.CreatedAt(),
-		t.UpdatedAt(),
-	)
-	return err
+	return pgx.BeginFunc(ctx, r.client.pool, func(tx pgx.Tx) error {
+		creatorID := t.CreatorID()
+		return insertTask(ctx, tx, t, &creatorID)
+	})
 }
 
 func (r *TaskRepo) FindByID(ctx context.Context, taskID id.TaskID) (*task.Task, error) {
 	query := `
-		SELECT id, company_id, creator_id, assignee_id, title, description, due_date, visibility, status, version, created_at, updated_at
+		SELECT ` + taskColumns + `, ` + taskDerivedColumns + `
 		FROM tasks
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 	`
 	return r.scanTask(ctx, r.client.pool.QueryRow(ctx, query, taskID.UUID()), taskID.String())
 }
 
 func (r *TaskRepo) FindByIDForCompany(ctx context.Context, taskID id.TaskID, companyID id.CompanyID) (*task.Task, error) {
 	query := `
-		SELECT id, company_id, creator_id, assignee_id, title, description, due_date, visibility, status, version, created_at, updated_at
+		SELECT ` + taskColumns + `, ` + taskDerivedColumns + `
 		FROM tasks
-		WHERE id = $1 AND company_id = $2
+		WHERE id = $1 AND company_id = $2 AND deleted_at IS NULL
 	`
 	return r.scanTask(ctx, r.client.pool.QueryRow(ctx, query, taskID.UUID(), companyID.UUID()), taskID.String())
 }
 
 func (r *TaskRepo) ListByCompany(ctx context.Context, companyID id.CompanyID, opts task.ListOptions) (*task.ListResult, error) {
+	q := &listQuery{}
+	q.where("company_id = " + q.arg(companyID.UUID()))
+	q.where("deleted_at IS NULL")
+	return r.list(ctx, q, opts)
+}
+
+// ListVisibleByCompany applies the same rule as task.CanBeViewedBy inside the
+// keyset query, so every page is full whenever more visible rows exist.
+func (r *TaskRepo) ListVisibleByCompany(ctx context.Context, companyID id.CompanyID, viewerID id.UserID, opts task.ListOptions) (*task.ListResult, error) {
+	q := &listQuery{}
+	q.where("company_id = " + q.arg(companyID.UUID()))
+	q.where(visibleTo("", q.arg(viewerID.UUID())))
+	q.where("deleted_at IS NULL")
+	return r.list(ctx, q, opts)
+}
+
+func (r *TaskRepo) ListByAssignee(ctx context.Context, companyID id.CompanyID, assigneeID id.UserID, opts task.ListOptions) (*task.ListResult, error) {
+	q := &listQuery{}
+	q.where("company_id = " + q.arg(companyID.UUID()))
+	q.where(assignedTo("", q.arg(assigneeID.UUID())))
+	q.where("deleted_at IS NULL")
+	return r.list(ctx, q, opts)
+}
+
+// Search ranks full-text matches on title and description, falling back to
+// trigram word similarity so that misspelled queries still find tasks. The
+// visibility predicate is the same one ListVisibleByCompany uses.
+func (r *TaskRepo) Search(ctx context.Context, companyID id.CompanyID, viewerID id.UserID, opts task.SearchOptions) (*task.SearchResult, error) {
 	pageSize := opts.PageSize
 	if pageSize <= 0 {
-		pageSize = 50
+		pageSize = 20
 	}
 	if pageSize > 100 {
 		pageSize = 100
 	}
 
-	var rows pgx.Rows
-	var err error
+	q := &listQuery{}
+	company := q.arg(companyID.UUID())
+	viewer := q.arg(viewerID.UUID())
+	text := q.arg(opts.Query)
 
+	after := "TRUE"
 	if opts.Cursor != nil {
-		query := `
-			SELECT id, company_id, creator_id, assignee_id, title, description, due_date, visibility, status, version, created_at, updated_at
-			FROM tasks
-			WHERE company_id = $1 AND (created_at, id) < ($2, $3)
-			ORDER BY created_at DESC, id DESC
-			LIMIT $4
-		`
-		rows, err = r.client.pool.Query(ctx, query, companyID.UUID(), opts.Cursor.CreatedAt, opts.Cursor.ID.UUID(), pageSize+1)
-	} else {
-		query := `
-			SELECT id, company_id, creator_id, assignee_id, title, description, due_date, visibility, status, version, created_at, updated_at
+		after = "(score, id) < (" + q.arg(opts.Cursor.Score) + "::float8, " + q.arg(opts.Cursor.ID.UUID()) + ")"
+	}
+
+	markers := `StartSel="` + task.HighlightStart + `", StopSel="` + task.HighlightStop + `"`
+	titleHeadline := `'` + markers + `, HighlightAll=true'`
+	descriptionHeadline := `'` + markers + `, MaxFragments=2, MaxWords=20, MinWords=5'`
+
+	query := `
+		WITH matches AS (
+			SELECT ` + taskColumns + `, ` + taskDerivedColumns + `,
+				GREATEST(
+					ts_rank(search_vector, websearch_to_tsquery('english', ` + text + `)),
+					word_similarity(` + text + `, title),
+					word_similarity(` + text + `, COALESCE(description, '')) * 0.5
+				)::float8 AS score
 			FROM tasks
-			WHERE company_id = $1
-			ORDER BY created_at DESC, id DESC
-			LIMIT $2
-		`
-		rows, err = r.client.pool.Query(ctx, query, companyID.UUID(), pageSize+1)
+			WHERE company_id = ` + company + `
+			  AND deleted_at IS NULL
+			  AND ` + visibleTo("", viewer) + `
+			  AND (
+				search_vector @@ websearch_to_tsquery('english', ` + text + `)
+				OR ` + text + ` <% title
+				OR ` + text + ` <% description
+			  )
+		)
+		SELECT ` + taskColumns + `, assignee_ids, label_ids, share_user_ids, share_roles, subtask_done, subtask_total, status_category, score,
+			ts_headline('english', title, websearch_to_tsquery('english', ` + text + `), ` + titleHeadline + `),
+			CASE WHEN description IS NULL THEN NULL
+				ELSE ts_headline('english', description, websearch_to_tsquery('english', ` + text + `), ` + descriptionHeadline + `)
+			END
+		FROM matches
+		WHERE ` + after + `
+		ORDER BY score DESC, id DESC
+		LIMIT ` + q.arg(pageSize+1)
+
+	rows, err := r.client.pool.Query(ctx, query, q.args...)
+	if err != nil {
+		return nil, err
 	}
+	defer rows.Close()
+
+	var hits []*task.SearchHit
 
+	for rows.Next() {
+		var row taskRow
+		var score float64
+		var titleHighlight string
+		var descriptionHighlight *string
+
+		if err := rows.Scan(append(row.dest(), &score, &titleHighlight, &descriptionHighlight)...); err != nil {
+			return nil, err
+		}
+
+		t, err := row.toTask()
+		if err != nil {
+			return nil, err
+		}
+		hits = append(hits, &task.SearchHit{
+			Task:                 t,
+			Score:                score,
+			TitleHighlight:       titleHighlight,
+			DescriptionHighlight: descriptionHighlight,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	result := &task.SearchResult{}
+
+	if len(hits) > pageSize {
+		hits = hits[:pageSize]
+		last := hits[len(hits)-1]
+		result.NextCursor = &task.SearchCursor{
+			Score: last.Score,
+			ID:    last.Task.ID(),
+		}
+	}
+
+	result.Hits = hits
+	return result, nil
+}
+
+// ListSubtree walks down from rootID through subtasks the viewer can see. A
+// hidden subtask prunes its whole branch, matching what the viewer could
+// reach by listing subtasks level by level.
+func (r *TaskRepo) ListSubtree(ctx context.Context, companyID id.CompanyID, viewerID id.UserID, rootID id.TaskID) (*task.Subtree, error) {
+	q := &listQuery{}
+	company := q.arg(companyID.UUID())
+	viewer := q.arg(viewerID.UUID())
+	root := q.arg(rootID.UUID())
+
+	query := `
+		WITH RECURSIVE subtree(task_id, depth) AS (
+			SELECT t.id, 1
+			FROM tasks t
+			WHERE t.parent_id = ` + root + ` AND t.company_id = ` + company + ` AND t.deleted_at IS NULL AND ` + visibleTo("t.", viewer) + `
+			UNION ALL
+			SELECT t.id, s.depth + 1
+			FROM tasks t
+			JOIN subtree s ON t.parent_id = s.task_id
+			WHERE t.company_id = ` + company + ` AND t.deleted_at IS NULL AND ` + visibleTo("t.", viewer) + `
+		) CYCLE task_id SET is_cycle USING path
+		SELECT ` + taskColumns + `, ` + taskDerivedColumns + `
+		FROM tasks
+		JOIN subtree ON subtree.task_id = tasks.id
+		WHERE NOT subtree.is_cycle
+		ORDER BY subtree.depth, created_at, id
+		LIMIT ` + q.arg(task.MaxSubtreeSize+1)
+
+	rows, err := r.client.pool.Query(ctx, query, q.args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	return r.scanTaskList(rows, pageSize)
+	tasks, err := scanTasks(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &task.Subtree{Tasks: tasks}
+
+	if len(result.Tasks) > task.MaxSubtreeSize {
+		result.Tasks = result.Tasks[:task.MaxSubtreeSize]
+		result.Truncated = true
+	}
+
+	return result, nil
 }
 
-func (r *TaskRepo) ListByAssignee(ctx context.Context, companyID id.CompanyID, assigneeID id.UserID, opts task.ListOptions) (*task.ListResult, error) {
+func (r *TaskRepo) ListAncestorIDs(ctx context.Context, companyID id.CompanyID, taskID id.TaskID) ([]id.TaskID, error) {
+	query := `
+		WITH RECURSIVE ancestors(task_id, depth) AS (
+			SELECT parent_id, 1 FROM tasks WHERE id = $1 AND company_id = $2 AND parent_id IS NOT NULL
+			UNION ALL
+			SELECT t.parent_id, a.depth + 1
+			FROM tasks t
+			JOIN ancestors a ON t.id = a.task_id
+			WHERE t.company_id = $2 AND t.parent_id IS NOT NULL
+		) CYCLE task_id SET is_cycle USING path
+		SELECT task_id::text FROM ancestors WHERE NOT is_cycle ORDER BY depth
+	`
+
+	rows, err := r.client.pool.Query(ctx, query, taskID.UUID(), companyID.UUID())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ancestorIDs []id.TaskID
+
+	for rows.Next() {
+		var s string
+		if err := rows.Scan(&s); err != nil {
+			return nil, err
+		}
+		ancestorID, _ := id.ParseTaskID(s)
+		ancestorIDs = append(ancestorIDs, ancestorID)
+	}
+
+	return ancestorIDs, rows.Err()
+}
+
+func (r *TaskRepo) list(ctx context.Context, q *listQuery, opts task.ListOptions) (*task.ListResult, error) {
 	pageSize := opts.PageSize
 	if pageSize <= 0 {
 		pageSize = 50
@@ -117,42 +286,60 @@ func (r *TaskRepo) ListByAssignee(ctx context.Context, companyID id.CompanyID, a
 		pageSize = 100
 	}
 
-	var rows pgx.Rows
-	var err error
+	sort := opts.Sort.OrDefault()
+
+	q.applyFilter(opts.Filter)
 
 	if opts.Cursor != nil {
-		query := `
-			SELECT id, company_id, creator_id, assignee_id, title, description, due_date, visibility, status, version, created_at, updated_at
-			FROM tasks
-			WHERE company_id = $1 AND assignee_id = $2 AND (created_at, id) < ($3, $4)
-			ORDER BY created_at DESC, id DESC
-			LIMIT $5
-		`
-		rows, err = r.client.pool.Query(ctx, query, companyID.UUID(), assigneeID.UUID(), opts.Cursor.CreatedAt, opts.Cursor.ID.UUID(), pageSize+1)
-	} else {
-		query := `
-			SELECT id, company_id, creator_id, assignee_id, title, description, due_date, visibility, status, version, created_at, updated_at
-			FROM tasks
-			WHERE company_id = $1 AND assignee_id = $2
-			ORDER BY created_at DESC, id DESC
-			LIMIT $3
-		`
-		rows, err = r.client.pool.Query(ctx, query, companyID.UUID(), assigneeID.UUID(), pageSize+1)
+		q.applyCursor(opts.Cursor, sort)
 	}
 
+	query := `
+		SELECT ` + taskColumns + `, ` + taskDerivedColumns + `
+		FROM tasks
+		WHERE ` + strings.Join(q.conds, " AND ") + `
+		ORDER BY ` + orderBy(sort) + `
+		LIMIT ` + q.arg(pageSize+1)
+
+	rows, err := r.client.pool.Query(ctx, query, q.args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	return r.scanTaskList(rows, pageSize)
+	return r.scanTaskList(rows, pageSize, sort)
+}
+
+func (r *TaskRepo) Update(ctx context.Context, t *task.Task, expectedVersion int, actorID id.UserID) error {
+	var updated bool
+	err := pgx.BeginFunc(ctx, r.client.pool, func(tx pgx.Tx) error {
+		var err error
+		updated, err = updateTask(ctx, tx, t, expectedVersion, actorID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if !updated {
+		existing, err := r.FindByIDForCompany(ctx, t.ID(), t.CompanyID())
+		if err != nil {
+			return err
+		}
+		return apperr.NewErrVersionMismatch(expectedVersion, existing.Version())
+	}
+
+	return nil
 }
 
-func (r *TaskRepo) Update(ctx context.Context, t *task.Task, expectedVersion int) error {
+// updateTask saves t inside tx together with its assignees, labels and
+// history entry. It reports false, without error, when no live task matches
+// expectedVersion; callers look up the current version outside tx.
+func updateTask(ctx context.Context, tx pgx.Tx, t *task.Task, expectedVersion int, actorID id.UserID) (bool, error) {
 	query := `
 		UPDATE tasks
-		SET title = $1, description = $2, assignee_id = $3, due_date = $4, visibility = $5, status = $6, version = $7, updated_at = $8
-		WHERE id = $9 AND company_id = $10 AND version = $11
+		SET title = $1, description = $2, assignee_id = $3, parent_id = $4, due_date = $5, visibility = $6, team_id = $7, status = $8, priority = $9, version = $10, updated_at = $11, completed_at = $12, archived_at = $13
+		WHERE id = $14 AND company_id = $15 AND version = $16 AND deleted_at IS NULL
 	`
 
 	var assigneeID interface{}
@@ -160,63 +347,461 @@ func (r *TaskRepo) Update(ctx context.Context, t *task.Task, expectedVersion int
 		assigneeID = t.AssigneeID().UUID()
 	}
 
-	result, err := r.client.pool.Exec(ctx, query,
+	var parentID interface{}
+	if t.ParentID() != nil {
+		parentID = t.ParentID().UUID()
+	}
+
+	if t.ParentID() != nil && hasChange(t, task.FieldParent) {
+		if err := checkParentCycle(ctx, tx, t); err != nil {
+			return false, err
+		}
+	}
+
+	result, err := tx.Exec(ctx, query,
 		t.Title(),
 		t.Description(),
 		assigneeID,
+		parentID,
 		t.DueDate(),
 		t.Visibility().String(),
+		teamID(t.TeamID()),
 		t.Status().String(),
+		t.Priority().String(),
 		t.Version(),
 		t.UpdatedAt(),
+		t.CompletedAt(),
+		t.ArchivedAt(),
 		t.ID().UUID(),
 		t.CompanyID().UUID(),
 		expectedVersion,
 	)
 	if err != nil {
-		return err
+		if violatesConstraint(err, "tasks_parent_fkey") {
+			return false, apperr.NewErrInvalidInput("parent_id", "task not found")
+		}
+		if violatesConstraint(err, "tasks_team_fkey") {
+			return false, apperr.NewErrInvalidInput("team_id", "team not found")
+		}
+		return false, err
 	}
 
 	if result.RowsAffected() == 0 {
-		existing, err := r.FindByIDForCompany(ctx, t.ID(), t.CompanyID())
+		return false, nil
+	}
+
+	if err := replaceAssignees(ctx, tx, t); err != nil {
+		return false, err
+	}
+
+	if err := replaceLabels(ctx, tx, t); err != nil {
+		return false, err
+	}
+
+	return true, insertHistory(ctx, tx, &task.HistoryEntry{
+		ID:        id.NewHistoryID(),
+		CompanyID: t.CompanyID(),
+		TaskID:    t.ID(),
+		ActorID:   &actorID,
+		Action:    task.HistoryUpdated,
+		Version:   t.Version(),
+		Changes:   t.Changes(),
+		CreatedAt: t.UpdatedAt(),
+	})
+}
+
+// Delete moves a task to the trash. Returning an error after the UPDATE rolls
+// it back, so a task with live subtasks is left untouched.
+func (r *TaskRepo) Delete(ctx context.Context, taskID id.TaskID, companyID id.CompanyID, actorID id.UserID) error {
+	query := `
+		UPDATE tasks SET deleted_at = $3
+		WHERE id = $1 AND company_id = $2 AND deleted_at IS NULL
+		RETURNING version, EXISTS (SELECT 1 FROM tasks st WHERE st.parent_id = tasks.id AND st.deleted_at IS NULL)
+	`
+
+	now := time.Now()
+
+	return pgx.BeginFunc(ctx, r.client.pool, func(tx pgx.Tx) error {
+		var version int
+		var hasSubtasks bool
+		if err := tx.QueryRow(ctx, query, taskID.UUID(), companyID.UUID(), now).Scan(&version, &hasSubtasks); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperr.NewErrNotFound("task", taskID.String())
+			}
+			return err
+		}
+		if hasSubtasks {
+			return apperr.NewErrFailedPrecondition("delete", "task", "task has subtasks; move or delete them first")
+		}
+
+		return insertHistory(ctx, tx, &task.HistoryEntry{
+			ID:        id.NewHistoryID(),
+			CompanyID: companyID,
+			TaskID:    taskID,
+			ActorID:   &actorID,
+			Action:    task.HistoryDeleted,
+			Version:   version,
+			CreatedAt: now,
+		})
+	})
+}
+
+// ListDeleted applies the same visibility rule as ListVisibleByCompany. The
+// trash holds archived tasks too, so they are always listed.
+func (r *TaskRepo) ListDeleted(ctx context.Context, companyID id.CompanyID, viewerID id.UserID, opts task.ListOptions) (*task.ListResult, error) {
+	opts.Filter.IncludeArchived = true
+	q := &listQuery{}
+	q.where("company_id = " + q.arg(companyID.UUID()))
+	q.where(visibleTo("", q.arg(viewerID.UUID())))
+	q.where("deleted_at IS NOT NULL")
+	return r.list(ctx, q, opts)
+}
+
+func (r *TaskRepo) FindDeletedByIDForCompany(ctx context.Context, taskID id.TaskID, companyID id.CompanyID) (*task.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `, ` + taskDerivedColumns + `
+		FROM tasks
+		WHERE id = $1 AND company_id = $2 AND deleted_at IS NOT NULL
+	`
+	return r.scanTask(ctx, r.client.pool.QueryRow(ctx, query, taskID.UUID(), companyID.UUID()), taskID.String())
+}
+
+// Restore takes a task out of the trash. A subtask cannot come back while its
+// parent is still in the trash.
+func (r *TaskRepo) Restore(ctx context.Context, taskID id.TaskID, companyID id.CompanyID, actorID id.UserID) error {
+	query := `
+		UPDATE tasks SET deleted_at = NULL
+		WHERE id = $1 AND company_id = $2 AND deleted_at IS NOT NULL
+		RETURNING version, parent_id IS NOT NULL AND NOT EXISTS (SELECT 1 FROM tasks p WHERE p.id = tasks.parent_id AND p.deleted_at IS NULL)
+	`
+
+	return pgx.BeginFunc(ctx, r.client.pool, func(tx pgx.Tx) error {
+		var version int
+		var parentDeleted bool
+		if err := tx.QueryRow(ctx, query, taskID.UUID(), companyID.UUID()).Scan(&version, &parentDeleted); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperr.NewErrNotFound("task", taskID.String())
+			}
+			return err
+		}
+		if parentDeleted {
+			return apperr.NewErrFailedPrecondition("restore", "task", "parent task is in the trash; restore it first")
+		}
+
+		return insertHistory(ctx, tx, &task.HistoryEntry{
+			ID:        id.NewHistoryID(),
+			CompanyID: companyID,
+			TaskID:    taskID,
+			ActorID:   &actorID,
+			Action:    task.HistoryRestored,
+			Version:   version,
+			CreatedAt: time.Now(),
+		})
+	})
+}
+
+func (r *TaskRepo) Purge(ctx context.Context, taskID id.TaskID, companyID id.CompanyID, actorID id.UserID) error {
+	query := `DELETE FROM tasks WHERE id = $1 AND company_id = $2 AND deleted_at IS NOT NULL RETURNING version`
+
+	return pgx.BeginFunc(ctx, r.client.pool, func(tx pgx.Tx) error {
+		var version int
+		if err := tx.QueryRow(ctx, query, taskID.UUID(), companyID.UUID()).Scan(&version); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return apperr.NewErrNotFound("task", taskID.String())
+			}
+			if violatesConstraint(err, "tasks_parent_fkey") {
+				return apperr.NewErrFailedPrecondition("purge", "task", "task has subtasks in the trash; purge them first")
+			}
+			return err
+		}
+
+		return insertHistory(ctx, tx, &task.HistoryEntry{
+			ID:        id.NewHistoryID(),
+			CompanyID: companyID,
+			TaskID:    taskID,
+			ActorID:   &actorID,
+			Action:    task.HistoryPurged,
+			Version:   version,
+			CreatedAt: time.Now(),
+		})
+	})
+}
+
+// PurgeDeletedBefore removes expired tasks leaf first: tasks_parent_fkey is
+// RESTRICT, so each pass only takes tasks without subtasks and the next pass
+// picks up the parents it freed. Every statement records its own history.
+func (r *TaskRepo) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	query := `
+		WITH purged AS (
+			DELETE FROM tasks
+			WHERE deleted_at < $1
+			  AND NOT EXISTS (SELECT 1 FROM tasks st WHERE st.parent_id = tasks.id)
+			RETURNING id, company_id, version
+		)
+		INSERT INTO task_history (id, company_id, task_id, action, version, created_at)
+		SELECT uuid_generate_v4(), company_id, id, 'purged', version, $2
+		FROM purged
+	`
+
+	var total int
+	for {
+		result, err := r.client.pool.Exec(ctx, query, cutoff, time.Now())
 		if err != nil {
-			if apperr.IsNotFound(err) {
+			return total, err
+		}
+		if result.RowsAffected() == 0 {
+			return total, nil
+		}
+		total += int(result.RowsAffected())
+	}
+}
+
+// ArchiveCompleted archives in one statement, recording the same history
+// entry an Archive request would but without an actor.
+func (r *TaskRepo) ArchiveCompleted(ctx context.Context, now time.Time) (int, error) {
+	query := `
+		WITH archived AS (
+			UPDATE tasks t
+			SET archived_at = $1, version = t.version + 1, updated_at = $1
+			FROM companies c
+			WHERE c.id = t.company_id
+			  AND c.auto_archive_after_days IS NOT NULL
+			  AND t.completed_at <= $1 - make_interval(days => c.auto_archive_after_days)
+			  AND t.archived_at IS NULL
+			  AND t.deleted_at IS NULL
+			RETURNING t.id, t.company_id, t.version
+		)
+		INSERT INTO task_history (id, company_id, task_id, action, version, changes, created_at)
+		SELECT uuid_generate_v4(), company_id, id, 'updated', version, $2, $1
+		FROM archived
+	`
+
+	// Rendered as task.FieldChanges renders timestamps
+	archivedAt := now.UTC().Format(time.RFC3339Nano)
+	changes, err := marshalChanges([]task.FieldChange{{Field: task.FieldArchived, After: &archivedAt}})
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := r.client.pool.Exec(ctx, query, now, changes)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(result.RowsAffected()), nil
+}
+
+// HandOver locks every task h.FromID created or is assigned to, applies the
+// handover to each through the domain and saves the ones that changed with
+// their history. Series are plain templates without history and are
+// updated in place; a series has a single assignee, kept in assignee_id.
+func (r *TaskRepo) HandOver(ctx context.Context, h task.Handover, actorID id.UserID, now time.Time) ([]task.Change, error) {
+	query := `
+		SELECT ` + taskColumns + `, ` + taskDerivedColumns + `
+		FROM tasks
+		WHERE company_id = $1 AND (creator_id = $2 OR ` + assignedTo("", "$2") + `)
+		ORDER BY created_at, id
+		FOR UPDATE
+	`
+
+	var changes []task.Change
+	err := pgx.BeginFunc(ctx, r.client.pool, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, query, h.CompanyID.UUID(), h.FromID.UUID())
+		if err != nil {
+			return err
+		}
+		tasks, err := scanTasks(rows)
+		rows.Close()
+		if err != nil {
+			return err
+		}
+
+		for _, before := range tasks {
+			after := before.HandOver(h, now)
+			if len(after.Changes()) == 0 {
+				continue
+			}
+
+			var assigneeID interface{}
+			if after.AssigneeID() != nil {
+				assigneeID = after.AssigneeID().UUID()
+			}
+			if _, err := tx.Exec(ctx, `
+				UPDATE tasks
+				SET creator_id = $1, assignee_id = $2, visibility = $3, version = $4, updated_at = $5
+				WHERE id = $6
+			`, after.CreatorID().UUID(), assigneeID, after.Visibility().String(), after.Version(), after.UpdatedAt(), after.ID().UUID()); err != nil {
 				return err
 			}
+
+			if err := replaceAssignees(ctx, tx, after); err != nil {
+				return err
+			}
+
+			if err := insertHistory(ctx, tx, &task.HistoryEntry{
+				ID:        id.NewHistoryID(),
+				CompanyID: after.CompanyID(),
+				TaskID:    after.ID(),
+				ActorID:   &actorID,
+				Action:    task.HistoryUpdated,
+				Version:   after.Version(),
+				Changes:   after.Changes(),
+				CreatedAt: now,
+			}); err != nil {
+				return err
+			}
+
+			changes = append(changes, task.NewChange(before, after))
+		}
+
+		var seriesAssigneeID interface{}
+		if h.AssigneeID != nil {
+			seriesAssigneeID = h.AssigneeID.UUID()
+		}
+		if _, err := tx.Exec(ctx, `
+			UPDATE task_series
+			SET visibility = CASE WHEN $4 AND creator_id = $2 AND visibility = 'only_me' THEN 'company_wide' ELSE visibility END,
+			    creator_id = CASE WHEN creator_id = $2 THEN $3 ELSE creator_id END,
+			    assignee_id = CASE WHEN assignee_id = $2 THEN $5 ELSE assignee_id END,
+			    version = version + 1,
+			    updated_at = $6
+			WHERE company_id = $1 AND (creator_id = $2 OR assignee_id = $2)
+		`, h.CompanyID.UUID(), h.FromID.UUID(), h.CreatorID.UUID(), h.OnlyMe == task.OnlyMeShare, seriesAssigneeID, now); err != nil {
 			return err
 		}
-		return apperr.NewErrVersionMismatch(expectedVersion, existing.Version())
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return changes, nil
 }
 
-func (r *TaskRepo) Delete(ctx context.Context, taskID id.TaskID, companyID id.CompanyID) error {
-	query := `DELETE FROM tasks WHERE id = $1 AND company_id = $2`
+// insertTask writes a new task, its assignees, labels and creation history entry
+// inside tx. actorID is nil for tasks the server creates on its own.
+func insertTask(ctx context.Context, tx pgx.Tx, t *task.Task, actorID *id.UserID) error {
+	query := `
+		INSERT INTO tasks (id, company_id, creator_id, assignee_id, parent_id, title, description, due_date, visibility, team_id, status, priority, series_id, series_occurrence, version, created_at, updated_at, completed_at, archived_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+	`
+
+	var assigneeID interface{}
+	if t.AssigneeID() != nil {
+		assigneeID = t.AssigneeID().UUID()
+	}
+
+	var parentID interface{}
+	if t.ParentID() != nil {
+		parentID = t.ParentID().UUID()
+	}
+
+	var seriesID, occurrence interface{}
+	if t.SeriesID() != nil {
+		seriesID = t.SeriesID().UUID()
+		occurrence = t.Occurrence()
+	}
 
-	result, err := r.client.pool.Exec(ctx, query, taskID.UUID(), companyID.UUID())
+	_, err := tx.Exec(ctx, query,
+		t.ID().UUID(),
+		t.CompanyID().UUID(),
+		t.CreatorID().UUID(),
+		assigneeID,
+		parentID,
+		t.Title(),
+		t.Description(),
+		t.DueDate(),
+		t.Visibility().String(),
+		teamID(t.TeamID()),
+		t.Status().String(),
+		t.Priority().String(),
+		seriesID,
+		occurrence,
+		t.Version(),
+		t.CreatedAt(),
+		t.UpdatedAt(),
+		t.CompletedAt(),
+		t.ArchivedAt(),
+	)
 	if err != nil {
+		if violatesConstraint(err, "tasks_parent_fkey") {
+			return apperr.NewErrInvalidInput("parent_id", "task not found")
+		}
+		if violatesConstraint(err, "tasks_team_fkey") {
+			return apperr.NewErrInvalidInput("team_id", "team not found")
+		}
 		return err
 	}
 
-	if result.RowsAffected() == 0 {
-		return apperr.NewErrNotFound("task", taskID.String())
+	if err := replaceAssignees(ctx, tx, t); err != nil {
+		return err
+	}
+
+	if err := replaceLabels(ctx, tx, t); err != nil {
+		return err
+	}
+
+	return insertHistory(ctx, tx, &task.HistoryEntry{
+		ID:        id.NewHistoryID(),
+		CompanyID: t.CompanyID(),
+		TaskID:    t.ID(),
+		ActorID:   actorID,
+		Action:    task.HistoryCreated,
+		Version:   t.Version(),
+		Changes:   task.FieldChanges(nil, t),
+		CreatedAt: t.CreatedAt(),
+	})
+}
+
+// replaceAssignees makes task_assignees match the task's assignees, keeping
+// their order. tasks.assignee_id is written separately as the primary one.
+func replaceAssignees(ctx context.Context, tx pgx.Tx, t *task.Task) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM task_assignees WHERE task_id = $1`, t.ID().UUID()); err != nil {
+		return err
+	}
+
+	for i, assigneeID := range t.AssigneeIDs() {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO task_assignees (task_id, company_id, user_id, position) VALUES ($1, $2, $3, $4)`,
+			t.ID().UUID(), t.CompanyID().UUID(), assigneeID.UUID(), i,
+		)
+		if err != nil {
+			if isForeignKeyViolation(err) {
+				return apperr.NewErrInvalidInput("assignee_ids", "user not found")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replaceLabels makes task_labels match the task's label set.
+func replaceLabels(ctx context.Context, tx pgx.Tx, t *task.Task) error {
+	if _, err := tx.Exec(ctx, `DELETE FROM task_labels WHERE task_id = $1`, t.ID().UUID()); err != nil {
+		return err
+	}
+
+	for _, labelID := range t.LabelIDs() {
+		_, err := tx.Exec(ctx,
+			`INSERT INTO task_labels (task_id, label_id, company_id) VALUES ($1, $2, $3)`,
+			t.ID().UUID(), labelID.UUID(), t.CompanyID().UUID(),
+		)
+		if err != nil {
+			if isForeignKeyViolation(err) {
+				return apperr.NewErrInvalidInput("label_ids", "label not found")
+			}
+			return err
+		}
 	}
 
 	return nil
 }
 
 func (r *TaskRepo) scanTask(ctx context.Context, row pgx.Row, taskIDStr string) (*task.Task, error) {
-	var dbID, dbCompanyID, dbCreatorID string
-	var dbAssigneeID *string
-	var title string
-	var description *string
-	var dueDate *time.Time
-	var visibility, status string
-	var version int
-	var createdAt, updatedAt time.Time
-
-	err := row.Scan(&dbID, &dbCompanyID, &dbCreatorID, &dbAssigneeID, &title, &description, &dueDate, &visibility, &status, &version, &createdAt, &updatedAt)
+	var tr taskRow
+	err := row.Scan(tr.dest()...)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, apperr.NewErrNotFound("task", taskIDStr)
@@ -224,131 +809,392 @@ func (r *TaskRepo) scanTask(ctx context.Context, row pgx.Row, taskIDStr string)
 		return nil, err
 	}
 
-	return r.buildTask(dbID, dbCompanyID, dbCreatorID, dbAssigneeID, title, description, dueDate, visibility, status, version, createdAt, updatedAt)
+	return tr.toTask()
+}
+
+func (r *TaskRepo) scanTaskList(rows pgx.Rows, pageSize int, sort task.Sort) (*task.ListResult, error) {
+	tasks, err := scanTasks(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &task.ListResult{}
+
+	if len(tasks) > pageSize {
+		tasks = tasks[:pageSize]
+		result.NextCursor = task.NewPageCursor(tasks[len(tasks)-1], sort)
+	}
+
+	result.Tasks = tasks
+	return result, nil
 }
 
-func (r *TaskRepo) scanTaskList(rows pgx.Rows, pageSize int) (*task.ListResult, error) {
+// scanTasks reads rows selected with taskColumns and taskDerivedColumns.
+func scanTasks(rows pgx.Rows) ([]*task.Task, error) {
 	var tasks []*task.Task
 
 	for rows.Next() {
-		var dbID, dbCompanyID, dbCreatorID string
-		var dbAssigneeID *string
-		var title string
-		var description *string
-		var dueDate *time.Time
-		var visibility, status string
-		var version int
-		var createdAt, updatedAt time.Time
-
-		err := rows.Scan(&dbID, &dbCompanyID, &dbCreatorID, &dbAssigneeID, &title, &description, &dueDate, &visibility, &status, &version, &createdAt, &updatedAt)
-		if err != nil {
+		var tr taskRow
+		if err := rows.Scan(tr.dest()...); err != nil {
 			return nil, err
 		}
 
-		t, err := r.buildTask(dbID, dbCompanyID, dbCreatorID, dbAssigneeID, title, description, dueDate, visibility, status, version, createdAt, updatedAt)
+		t, err := tr.toTask()
 		if err != nil {
 			return nil, err
 		}
 		tasks = append(tasks, t)
 	}
 
-	if err := rows.Err(); err != nil {
-		return nil, err
+	return tasks, rows.Err()
+}
+
+// taskRow holds the columns selected by taskColumns and taskDerivedColumns.
+type taskRow struct {
+	id           string
+	companyID    string
+	creatorID    string
+	parentID     *string
+	title        string
+	description  *string
+	dueDate      *time.Time
+	visibility   string
+	teamID       *string
+	status       string
+	priority     string
+	seriesID     *string
+	occurrence   *int
+	version      int
+	createdAt    time.Time
+	updatedAt    time.Time
+	completedAt  *time.Time
+	archivedAt   *time.Time
+	deletedAt    *time.Time
+	assigneeIDs  []string
+	labelIDs     []string
+	shareUserIDs []string
+	shareRoles   []string
+	subtaskDone  int
+	subtaskTotal int
+	category     string
+}
+
+func (tr *taskRow) dest() []interface{} {
+	return []interface{}{
+		&tr.id, &tr.companyID, &tr.creatorID, &tr.parentID, &tr.title, &tr.description, &tr.dueDate,
+		&tr.visibility, &tr.teamID, &tr.status, &tr.priority, &tr.seriesID, &tr.occurrence, &tr.version, &tr.createdAt, &tr.updatedAt,
+		&tr.completedAt, &tr.archivedAt, &tr.deletedAt, &tr.assigneeIDs, &tr.labelIDs, &tr.shareUserIDs, &tr.shareRoles,
+		&tr.subtaskDone, &tr.subtaskTotal, &tr.category,
 	}
+}
 
-	result := &task.ListResult{}
+func (tr *taskRow) toTask() (*task.Task, error) {
+	parsedID, _ := id.ParseTaskID(tr.id)
+	parsedCompanyID, _ := id.ParseCompanyID(tr.companyID)
+	parsedCreatorID, _ := id.ParseUserID(tr.creatorID)
 
-	if len(tasks) > pageSize {
-		tasks = tasks[:pageSize]
-		lastTask := tasks[len(tasks)-1]
-		result.NextCursor = &task.PageCursor{
-			CreatedAt: lastTask.CreatedAt(),
-			ID:        lastTask.ID(),
-		}
+	var assigneeIDs []id.UserID
+	for _, a := range tr.assigneeIDs {
+		aid, _ := id.ParseUserID(a)
+		assigneeIDs = append(assigneeIDs, aid)
 	}
 
-	result.Tasks = tasks
-	return result, nil
-}
+	var parsedParentID *id.TaskID
+	if tr.parentID != nil {
+		pid, _ := id.ParseTaskID(*tr.parentID)
+		parsedParentID = &pid
+	}
+
+	var parsedSeriesID *id.SeriesID
+	var occurrence int
+	if tr.seriesID != nil {
+		sid, _ := id.ParseSeriesID(*tr.seriesID)
+		parsedSeriesID = &sid
+		occurrence = *tr.occurrence
+	}
 
-func (r *TaskRepo) buildTask(dbID, dbCompanyID, dbCreatorID string, dbAssigneeID *string, title string, description *string, dueDate *time.Time, visibility, status string, version int, createdAt, updatedAt time.Time) (*task.Task, error) {
-	parsedID, _ := id.ParseTaskID(dbID)
-	parsedCompanyID, _ := id.ParseCompanyID(dbCompanyID)
-	parsedCreatorID, _ := id.ParseUserID(dbCreatorID)
+	var parsedTeamID *id.TeamID
+	if tr.teamID != nil {
+		tid, _ := id.ParseTeamID(*tr.teamID)
+		parsedTeamID = &tid
+	}
+
+	var labelIDs []id.LabelID
+	for _, l := range tr.labelIDs {
+		lid, _ := id.ParseLabelID(l)
+		labelIDs = append(labelIDs, lid)
+	}
 
-	var parsedAssigneeID *id.UserID
-	if dbAssigneeID != nil {
-		aid, _ := id.ParseUserID(*dbAssigneeID)
-		parsedAssigneeID = &aid
+	var shares []task.Share
+	for i, u := range tr.shareUserIDs {
+		uid, _ := id.ParseUserID(u)
+		role, _ := task.ParseShareRole(tr.shareRoles[i])
+		shares = append(shares, task.Share{UserID: uid, Role: role})
 	}
 
-	parsedVisibility, _ := task.ParseVisibility(visibility)
-	parsedStatus, _ := task.ParseStatus(status)
+	parsedVisibility, _ := task.ParseVisibility(tr.visibility)
+	parsedStatus, _ := task.ParseStatus(tr.status)
+	parsedCategory, _ := task.ParseCategory(tr.category)
+	parsedPriority, _ := task.ParsePriority(tr.priority)
 
 	return task.NewBuilder().
 		ID(parsedID).
 		CompanyID(parsedCompanyID).
 		CreatorID(parsedCreatorID).
-		AssigneeID(parsedAssigneeID).
-		Title(title).
-		Description(description).
-		DueDate(dueDate).
+		AssigneeIDs(assigneeIDs).
+		ParentID(parsedParentID).
+		Title(tr.title).
+		Description(tr.description).
+		DueDate(tr.dueDate).
 		Visibility(parsedVisibility).
+		TeamID(parsedTeamID).
 		Status(parsedStatus).
-		Version(version).
-		CreatedAt(createdAt).
-		UpdatedAt(updatedAt).
+		Category(parsedCategory).
+		Priority(parsedPriority).
+		LabelIDs(labelIDs).
+		Shares(shares).
+		SubtaskProgress(task.Progress{Done: tr.subtaskDone, Total: tr.subtaskTotal}).
+		SeriesID(parsedSeriesID).
+		Occurrence(occurrence).
+		Version(tr.version).
+		CreatedAt(tr.createdAt).
+		UpdatedAt(tr.updatedAt).
+		CompletedAt(tr.completedAt).
+		ArchivedAt(tr.archivedAt).
+		DeletedAt(tr.deletedAt).
 		Build()
 }
 
-func EncodeCursor(cursor *task.PageCursor) string {
-	if cursor == nil {
-		return ""
+// visibleTo is the SQL form of task.CanBeViewedBy, minus the company check
+// that callers apply separately. prefix qualifies the task columns.
+func visibleTo(prefix, viewer string) string {
+	return "(" + prefix + "visibility = 'company_wide' OR " + inTeamOf(prefix, viewer) + " OR " + prefix + "creator_id = " + viewer + " OR " + assignedTo(prefix, viewer) + " OR " + sharedWith(prefix, viewer) + ")"
+}
+
+// inTeamOf matches team-visible tasks whose team has user as a member.
+// prefix qualifies the task columns.
+func inTeamOf(prefix, user string) string {
+	return "(" + prefix + "visibility = 'team' AND " + prefix + "team_id IN (SELECT team_id FROM team_members WHERE user_id = " + user + "))"
+}
+
+// checkParentCycle rejects moving t under one of its own descendants. Parent
+// changes of a company are serialized on an advisory lock, so two concurrent
+// moves cannot each miss the cycle the other closes.
+func checkParentCycle(ctx context.Context, tx pgx.Tx, t *task.Task) error {
+	if err := lockCompany(ctx, tx, "task_parents", t.CompanyID()); err != nil {
+		return err
 	}
-	data, _ := json.Marshal(map[string]interface{}{
-		"created_at": cursor.CreatedAt,
-		"id":         cursor.ID.String(),
-	})
-	return base64.StdEncoding.EncodeToString(data)
+
+	// The walk goes up from the new parent; reaching t means t is one of
+	// its ancestors.
+	var cycle bool
+	if err := tx.QueryRow(ctx, `
+		WITH RECURSIVE ancestors(task_id) AS (
+			SELECT $1::uuid
+			UNION
+			SELECT t.parent_id
+			FROM tasks t
+			JOIN ancestors a ON t.id = a.task_id
+			WHERE t.company_id = $2 AND t.parent_id IS NOT NULL
+		)
+		SELECT EXISTS (SELECT 1 FROM ancestors WHERE task_id = $3)
+	`, t.ParentID().UUID(), t.CompanyID().UUID(), t.ID().UUID()).Scan(&cycle); err != nil {
+		return err
+	}
+	if cycle {
+		return apperr.NewErrInvalidInput("parent_id", "would create a cycle")
+	}
+	return nil
 }
 
-func DecodeCursor(token string) (*task.PageCursor, error) {
-	if token == "" {
-		return nil, nil
+// lockCompany takes a transaction-scoped advisory lock on one kind of change
+// within a company.
+func lockCompany(ctx context.Context, tx pgx.Tx, scope string, companyID id.CompanyID) error {
+	_, err := tx.Exec(ctx,
+		`SELECT pg_advisory_xact_lock(hashtextextended($1 || ':' || $2::text, 0))`,
+		scope, companyID.UUID(),
+	)
+	return err
+}
+
+// hasChange reports whether f is among the changes t records.
+func hasChange(t *task.Task, f task.Field) bool {
+	for _, c := range t.Changes() {
+		if c.Field == f {
+			return true
+		}
 	}
+	return false
+}
 
-	data, err := base64.StdEncoding.DecodeString(token)
-	if err != nil {
-		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+// teamID maps a missing team to NULL.
+func teamID(t *id.TeamID) interface{} {
+	if t == nil {
+		return nil
 	}
+	return t.UUID()
+}
+
+// assignedTo matches tasks that have user among their assignees. prefix
+// qualifies the task columns.
+func assignedTo(prefix, user string) string {
+	return prefix + "id IN (SELECT task_id FROM task_assignees WHERE user_id = " + user + ")"
+}
+
+// sharedWith matches tasks shared with user in any role. prefix qualifies
+// the task columns.
+func sharedWith(prefix, user string) string {
+	return prefix + "id IN (SELECT task_id FROM task_shares WHERE user_id = " + user + ")"
+}
 
-	var m map[string]interface{}
-	if err := json.Unmarshal(data, &m); err != nil {
-		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+// listQuery accumulates WHERE conditions and their positional arguments.
+type listQuery struct {
+	conds []string
+	args  []interface{}
+}
+
+func (q *listQuery) arg(v interface{}) string {
+	q.args = append(q.args, v)
+	return fmt.Sprintf("$%d", len(q.args))
+}
+
+func (q *listQuery) where(cond string) {
+	q.conds = append(q.conds, cond)
+}
+
+func (q *listQuery) applyFilter(f task.Filter) {
+	if f.ParentID != nil {
+		q.where("parent_id = " + q.arg(f.ParentID.UUID()))
+	}
+	if len(f.Statuses) > 0 {
+		statuses := make([]string, len(f.Statuses))
+		for i, s := range f.Statuses {
+			statuses[i] = s.String()
+		}
+		q.where("status = ANY(" + q.arg(statuses) + ")")
+	}
+	if len(f.Priorities) > 0 {
+		priorities := make([]string, len(f.Priorities))
+		for i, p := range f.Priorities {
+			priorities[i] = p.String()
+		}
+		q.where("priority = ANY(" + q.arg(priorities) + ")")
+	}
+	if f.Unassigned {
+		q.where("NOT EXISTS (SELECT 1 FROM task_assignees ta WHERE ta.task_id = tasks.id)")
+	} else if f.AssigneeID != nil {
+		q.where(assignedTo("", q.arg(f.AssigneeID.UUID())))
+	}
+	if f.CreatorID != nil {
+		q.where("creator_id = " + q.arg(f.CreatorID.UUID()))
 	}
+	if f.Visibility != nil {
+		q.where("visibility = " + q.arg(f.Visibility.String()))
+	}
+	if f.DueAfter != nil {
+		q.where("due_date >= " + q.arg(*f.DueAfter))
+	}
+	if f.DueBefore != nil {
+		q.where("due_date < " + q.arg(*f.DueBefore))
+	}
+	if f.OverdueOnly {
+		q.where("due_date < NOW() AND " + statusCategory("tasks.") + " <> 'done'")
+	}
+	if f.CreatedAfter != nil {
+		q.where("created_at >= " + q.arg(*f.CreatedAfter))
+	}
+	if f.CreatedBefore != nil {
+		q.where("created_at < " + q.arg(*f.CreatedBefore))
+	}
+	if f.UpdatedAfter != nil {
+		q.where("updated_at >= " + q.arg(*f.UpdatedAfter))
+	}
+	if f.UpdatedBefore != nil {
+		q.where("updated_at < " + q.arg(*f.UpdatedBefore))
+	}
+	if !f.IncludeArchived {
+		q.where("archived_at IS NULL")
+	}
+	if len(f.LabelIDs) > 0 {
+		labelIDs := make([]uuid.UUID, len(f.LabelIDs))
+		for i, l := range f.LabelIDs {
+			labelIDs[i] = l.UUID()
+		}
+		q.where("id IN (SELECT task_id FROM task_labels WHERE label_id = ANY(" + q.arg(labelIDs) + ") GROUP BY task_id HAVING COUNT(*) = " + q.arg(len(labelIDs)) + ")")
+	}
+}
 
-	createdAtStr, ok := m["created_at"].(string)
-	if !ok {
-		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+// applyCursor restricts the query to rows strictly after the cursor in sort
+// order. Tasks without a due date sort last, so a due-date cursor on a NULL
+// row only continues through the remaining NULL rows.
+func (q *listQuery) applyCursor(c *task.PageCursor, sort task.Sort) {
+	op := "<"
+	if sort.Direction == task.SortAsc {
+		op = ">"
 	}
-	createdAt, err := time.Parse(time.RFC3339Nano, createdAtStr)
-	if err != nil {
-		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	cursorID := q.arg(c.ID.UUID())
+
+	var key string
+	switch sort.Field {
+	case task.SortByDueDate:
+		if c.DueDate == nil {
+			q.where("(due_date IS NULL AND id " + op + " " + cursorID + ")")
+		} else {
+			q.where("(due_date IS NULL OR (due_date, id) " + op + " (" + q.arg(*c.DueDate) + ", " + cursorID + "))")
+		}
+		return
+	case task.SortByUpdatedAt:
+		key = q.arg(c.UpdatedAt)
+	case task.SortByTitle:
+		key = q.arg(c.Title)
+	case task.SortByStatus:
+		key = statusPosition(q.arg(c.Status.String()) + "::text")
+	case task.SortByPriority:
+		key = priorityRank(q.arg(c.Priority.String()) + "::text")
+	default:
+		key = q.arg(c.CreatedAt)
 	}
+	q.where("(" + sortExpr(sort.Field) + ", id) " + op + " (" + key + ", " + cursorID + ")")
+}
 
-	idStr, ok := m["id"].(string)
-	if !ok {
-		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+func orderBy(sort task.Sort) string {
+	dir := "DESC"
+	if sort.Direction == task.SortAsc {
+		dir = "ASC"
 	}
-	taskID, err := id.ParseTaskID(idStr)
-	if err != nil {
-		return nil, apperr.NewErrInvalidInput("page_token", "invalid cursor format")
+	if sort.Field == task.SortByDueDate {
+		return "due_date IS NULL, due_date " + dir + ", id " + dir
 	}
+	return sortExpr(sort.Field) + " " + dir + ", id " + dir
+}
+
+func sortExpr(field task.SortField) string {
+	switch field {
+	case task.SortByDueDate:
+		return "due_date"
+	case task.SortByUpdatedAt:
+		return "updated_at"
+	case task.SortByTitle:
+		return "title"
+	case task.SortByStatus:
+		return statusPosition("tasks.status")
+	case task.SortByPriority:
+		return priorityRank("priority")
+	default:
+		return "created_at"
+	}
+}
+
+// statusPosition orders statuses by their position in the company's
+// workflow rather than alphabetically.
+func statusPosition(expr string) string {
+	return "(SELECT ws.position FROM workflow_statuses ws WHERE ws.company_id = tasks.company_id AND ws.key = " + expr + ")"
+}
 
-	return &task.PageCursor{
-		CreatedAt: createdAt,
-		ID:        taskID,
-	}, nil
+// priorityRank orders priorities by severity. It must match the expression
+// index in 007_task_priority.sql.
+func priorityRank(expr string) string {
+	return "(CASE " + expr + " WHEN 'none' THEN 0 WHEN 'low' THEN 1 WHEN 'medium' THEN 2 WHEN 'high' THEN 3 WHEN 'urgent' THEN 4 END)"
 }
 
 var _ task.Repo = (*TaskRepo)(nil)
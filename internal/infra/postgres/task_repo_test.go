@@ -7,8 +7,14 @@ import (
 	"time"
 
 	"github.com/pyshx/todoapp/internal/infra/postgres"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/company"
 	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/notification"
+	"github.com/pyshx/todoapp/pkg/recurrence"
 	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/team"
+	"github.com/pyshx/todoapp/pkg/user"
 )
 
 func setupTestDB(t *testing.T) *postgres.Client {
@@ -70,7 +76,7 @@ func TestTaskRepo_CreateAndFindByID(t *testing.T) {
 	}
 
 	// Cleanup
-	repo.Delete(ctx, taskID, companyID)
+	repo.Delete(ctx, taskID, companyID, creatorID)
 }
 
 func TestTaskRepo_FindByIDForCompany(t *testing.T) {
@@ -119,7 +125,7 @@ func TestTaskRepo_FindByIDForCompany(t *testing.T) {
 	}
 
 	// Cleanup
-	repo.Delete(ctx, taskID, companyID)
+	repo.Delete(ctx, taskID, companyID, creatorID)
 }
 
 func TestTaskRepo_Update(t *testing.T) {
@@ -158,7 +164,7 @@ func TestTaskRepo_Update(t *testing.T) {
 	}
 	updatedTask := originalTask.ApplyUpdate(update, time.Now())
 
-	if err := repo.Update(ctx, updatedTask, 1); err != nil {
+	if err := repo.Update(ctx, updatedTask, 1, creatorID); err != nil {
 		t.Fatalf("failed to update task: %v", err)
 	}
 
@@ -176,7 +182,7 @@ func TestTaskRepo_Update(t *testing.T) {
 	}
 
 	// Cleanup
-	repo.Delete(ctx, taskID, companyID)
+	repo.Delete(ctx, taskID, companyID, creatorID)
 }
 
 func TestTaskRepo_OptimisticLocking(t *testing.T) {
@@ -213,7 +219,7 @@ func TestTaskRepo_OptimisticLocking(t *testing.T) {
 	update := task.Update{Title: &newTitle}
 	updatedTask := originalTask.ApplyUpdate(update, time.Now())
 
-	if err := repo.Update(ctx, updatedTask, 1); err != nil {
+	if err := repo.Update(ctx, updatedTask, 1, creatorID); err != nil {
 		t.Fatalf("first update should succeed: %v", err)
 	}
 
@@ -222,13 +228,13 @@ func TestTaskRepo_OptimisticLocking(t *testing.T) {
 	staleUpdate := task.Update{Title: &staleTitle}
 	staleTask := originalTask.ApplyUpdate(staleUpdate, time.Now())
 
-	err := repo.Update(ctx, staleTask, 1) // Using stale version 1
+	err := repo.Update(ctx, staleTask, 1, creatorID) // Using stale version 1
 	if err == nil {
 		t.Error("expected version mismatch error for stale update")
 	}
 
 	// Cleanup
-	repo.Delete(ctx, taskID, companyID)
+	repo.Delete(ctx, taskID, companyID, creatorID)
 }
 
 func TestTaskRepo_ListByCompany(t *testing.T) {
@@ -277,7 +283,7 @@ func TestTaskRepo_ListByCompany(t *testing.T) {
 
 	// Cleanup
 	for _, taskID := range taskIDs {
-		repo.Delete(ctx, taskID, companyID)
+		repo.Delete(ctx, taskID, companyID, creatorID)
 	}
 }
 
@@ -311,7 +317,7 @@ func TestTaskRepo_Delete(t *testing.T) {
 	}
 
 	// Delete the task
-	if err := repo.Delete(ctx, taskID, companyID); err != nil {
+	if err := repo.Delete(ctx, taskID, companyID, creatorID); err != nil {
 		t.Fatalf("failed to delete task: %v", err)
 	}
 
@@ -321,3 +327,1479 @@ func TestTaskRepo_Delete(t *testing.T) {
 		t.Error("expected error when finding deleted task")
 	}
 }
+
+func TestTaskRepo_ListVisibleByCompany(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	repo := postgres.NewTaskRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	creatorID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	viewerID, _ := id.ParseUserID("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb")
+
+	// Interleave private and company-wide tasks so a post-filter would return short pages
+	var taskIDs []id.TaskID
+	privateIDs := make(map[string]bool)
+	for i := 0; i < 6; i++ {
+		taskID := id.NewTaskID()
+		taskIDs = append(taskIDs, taskID)
+		now := time.Now().Truncate(time.Microsecond)
+
+		visibility := task.VisibilityCompanyWide
+		if i%2 == 0 {
+			visibility = task.VisibilityOnlyMe
+			privateIDs[taskID.String()] = true
+		}
+
+		newTask, _ := task.NewBuilder().
+			ID(taskID).
+			CompanyID(companyID).
+			CreatorID(creatorID).
+			Title("Visibility Test Task").
+			Visibility(visibility).
+			Status(task.StatusTodo).
+			Version(1).
+			CreatedAt(now).
+			UpdatedAt(now).
+			Build()
+
+		if err := repo.Create(ctx, newTask); err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+	}
+
+	result, err := repo.ListVisibleByCompany(ctx, companyID, viewerID, task.ListOptions{PageSize: 2})
+	if err != nil {
+		t.Fatalf("failed to list tasks: %v", err)
+	}
+
+	if len(result.Tasks) != 2 {
+		t.Errorf("expected a full page of 2 tasks, got %d", len(result.Tasks))
+	}
+	for _, found := range result.Tasks {
+		if privateIDs[found.ID().String()] {
+			t.Errorf("only_me task %s leaked to another user", found.ID())
+		}
+	}
+
+	// Cleanup
+	for _, taskID := range taskIDs {
+		repo.Delete(ctx, taskID, companyID, creatorID)
+	}
+}
+
+func TestTaskRepo_ListByCompanyWithFilter(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	repo := postgres.NewTaskRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	creatorID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+
+	var taskIDs []id.TaskID
+	for _, status := range []task.Status{task.StatusTodo, task.StatusDone} {
+		taskID := id.NewTaskID()
+		taskIDs = append(taskIDs, taskID)
+		now := time.Now().Truncate(time.Microsecond)
+
+		newTask, _ := task.NewBuilder().
+			ID(taskID).
+			CompanyID(companyID).
+			CreatorID(creatorID).
+			Title("Filter Test Task").
+			Visibility(task.VisibilityCompanyWide).
+			Status(status).
+			Version(1).
+			CreatedAt(now).
+			UpdatedAt(now).
+			Build()
+
+		if err := repo.Create(ctx, newTask); err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+	}
+
+	result, err := repo.ListByCompany(ctx, companyID, task.ListOptions{
+		PageSize: 100,
+		Filter: task.Filter{
+			Statuses:   []task.Status{task.StatusDone},
+			Unassigned: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to list tasks: %v", err)
+	}
+
+	for _, found := range result.Tasks {
+		if found.Status() != task.StatusDone {
+			t.Errorf("expected only done tasks, got %s", found.Status())
+		}
+		if found.AssigneeID() != nil {
+			t.Errorf("expected only unassigned tasks, got assignee %s", found.AssigneeID())
+		}
+	}
+
+	// Cleanup
+	for _, taskID := range taskIDs {
+		repo.Delete(ctx, taskID, companyID, creatorID)
+	}
+}
+
+func TestTaskRepo_ListByCompanyPriority(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	repo := postgres.NewTaskRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	creatorID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+
+	var taskIDs []id.TaskID
+	for _, priority := range []task.Priority{task.PriorityLow, task.PriorityUrgent, task.PriorityHigh} {
+		taskID := id.NewTaskID()
+		taskIDs = append(taskIDs, taskID)
+		now := time.Now().Truncate(time.Microsecond)
+
+		newTask, _ := task.NewBuilder().
+			ID(taskID).
+			CompanyID(companyID).
+			CreatorID(creatorID).
+			Title("Priority Test Task").
+			Visibility(task.VisibilityCompanyWide).
+			Priority(priority).
+			Version(1).
+			CreatedAt(now).
+			UpdatedAt(now).
+			Build()
+
+		if err := repo.Create(ctx, newTask); err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+	}
+
+	opts := task.ListOptions{
+		PageSize: 1,
+		Filter:   task.Filter{Priorities: []task.Priority{task.PriorityHigh, task.PriorityUrgent}},
+		Sort:     task.Sort{Field: task.SortByPriority, Direction: task.SortDesc},
+	}
+
+	var got []task.Priority
+	for {
+		result, err := repo.ListByCompany(ctx, companyID, opts)
+		if err != nil {
+			t.Fatalf("failed to list tasks: %v", err)
+		}
+		for _, found := range result.Tasks {
+			got = append(got, found.Priority())
+		}
+		if result.NextCursor == nil {
+			break
+		}
+		opts.Cursor = result.NextCursor
+	}
+
+	for _, p := range got {
+		if p != task.PriorityHigh && p != task.PriorityUrgent {
+			t.Errorf("expected only high or urgent tasks, got %s", p)
+		}
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i-1].Rank() < got[i].Rank() {
+			t.Errorf("priorities out of order: %s before %s", got[i-1], got[i])
+		}
+	}
+
+	// Cleanup
+	for _, taskID := range taskIDs {
+		repo.Delete(ctx, taskID, companyID, creatorID)
+	}
+}
+
+func TestTaskRepo_Subtasks(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	repo := postgres.NewTaskRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	creatorID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+
+	create := func(parentID *id.TaskID, status task.Status) *task.Task {
+		now := time.Now().Truncate(time.Microsecond)
+		newTask := task.NewBuilder().
+			ID(id.NewTaskID()).
+			CompanyID(companyID).
+			CreatorID(creatorID).
+			ParentID(parentID).
+			Title("Subtask Test Task").
+			Visibility(task.VisibilityCompanyWide).
+			Status(status).
+			Version(1).
+			CreatedAt(now).
+			UpdatedAt(now).
+			MustBuild()
+		if err := repo.Create(ctx, newTask); err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+		return newTask
+	}
+
+	root := create(nil, task.StatusTodo)
+	rootID := root.ID()
+	child := create(&rootID, task.StatusDone)
+	childID := child.ID()
+	create(&rootID, task.StatusTodo)
+	grandchild := create(&childID, task.StatusTodo)
+
+	found, err := repo.FindByID(ctx, rootID)
+	if err != nil {
+		t.Fatalf("failed to find task: %v", err)
+	}
+	if got := found.SubtaskProgress(); got.Done != 1 || got.Total != 2 {
+		t.Errorf("SubtaskProgress = %+v, want {Done:1 Total:2}", got)
+	}
+
+	subtree, err := repo.ListSubtree(ctx, companyID, creatorID, rootID)
+	if err != nil {
+		t.Fatalf("failed to list subtree: %v", err)
+	}
+	if len(subtree.Tasks) != 3 {
+		t.Errorf("expected 3 descendants, got %d", len(subtree.Tasks))
+	}
+
+	ancestors, err := repo.ListAncestorIDs(ctx, companyID, grandchild.ID())
+	if err != nil {
+		t.Fatalf("failed to list ancestors: %v", err)
+	}
+	if len(ancestors) != 2 || !ancestors[0].Equal(childID) || !ancestors[1].Equal(rootID) {
+		t.Errorf("ancestors = %v, want [%s %s]", ancestors, childID, rootID)
+	}
+
+	if err := repo.Delete(ctx, rootID, companyID, creatorID); !apperr.IsFailedPrecondition(err) {
+		t.Errorf("expected failed precondition deleting a parent, got %v", err)
+	}
+
+	// Cleanup, deepest first
+	for i := len(subtree.Tasks) - 1; i >= 0; i-- {
+		repo.Delete(ctx, subtree.Tasks[i].ID(), companyID, creatorID)
+	}
+	repo.Delete(ctx, rootID, companyID, creatorID)
+}
+
+func TestTaskRepo_Search(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	repo := postgres.NewTaskRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	creatorID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	viewerID, _ := id.ParseUserID("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb")
+
+	taskID := id.NewTaskID()
+	now := time.Now().Truncate(time.Microsecond)
+	description := "Rotate the staging database credentials"
+
+	newTask, _ := task.NewBuilder().
+		ID(taskID).
+		CompanyID(companyID).
+		CreatorID(creatorID).
+		Title("Quarterly credential rotation").
+		Description(&description).
+		Visibility(task.VisibilityCompanyWide).
+		Status(task.StatusTodo).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now).
+		Build()
+
+	if err := repo.Create(ctx, newTask); err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+	defer repo.Delete(ctx, taskID, companyID, creatorID)
+
+	for _, query := range []string{"credentials", "credental rotaton"} {
+		result, err := repo.Search(ctx, companyID, viewerID, task.SearchOptions{Query: query, PageSize: 10})
+		if err != nil {
+			t.Fatalf("failed to search %q: %v", query, err)
+		}
+
+		found := false
+		for _, hit := range result.Hits {
+			if hit.Task.ID().Equal(taskID) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected search %q to find the task", query)
+		}
+	}
+}
+
+func TestTaskRepo_History(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	repo := postgres.NewTaskRepo(client)
+	historyRepo := postgres.NewTaskHistoryRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	creatorID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+
+	taskID := id.NewTaskID()
+	now := time.Now().Truncate(time.Microsecond)
+
+	newTask, _ := task.NewBuilder().
+		ID(taskID).
+		CompanyID(companyID).
+		CreatorID(creatorID).
+		Title("History Test Task").
+		Visibility(task.VisibilityCompanyWide).
+		Status(task.StatusTodo).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now).
+		Build()
+
+	if err := repo.Create(ctx, newTask); err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	done := task.WorkflowStatus{Key: task.StatusDone, Category: task.CategoryDone}
+	updated := newTask.ApplyUpdate(task.Update{Status: &done}, now.Add(time.Second))
+	if err := repo.Update(ctx, updated, 1, creatorID); err != nil {
+		t.Fatalf("failed to update task: %v", err)
+	}
+	if err := repo.Delete(ctx, taskID, companyID, creatorID); err != nil {
+		t.Fatalf("failed to delete task: %v", err)
+	}
+
+	result, err := historyRepo.ListByTask(ctx, companyID, taskID, task.HistoryListOptions{})
+	if err != nil {
+		t.Fatalf("failed to list history: %v", err)
+	}
+
+	wantActions := []task.HistoryAction{task.HistoryDeleted, task.HistoryUpdated, task.HistoryCreated}
+	if len(result.Entries) != len(wantActions) {
+		t.Fatalf("expected %d entries, got %d", len(wantActions), len(result.Entries))
+	}
+	for i, e := range result.Entries {
+		if e.Action != wantActions[i] {
+			t.Errorf("entry %d: expected %s, got %s", i, wantActions[i], e.Action)
+		}
+	}
+
+	changes := result.Entries[1].Changes
+	if len(changes) != 1 || changes[0].Field != task.FieldStatus || *changes[0].Before != "todo" || *changes[0].After != "done" {
+		t.Errorf("expected status todo -> done, got %+v", changes)
+	}
+}
+
+func TestTaskRepo_SoftDelete(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	repo := postgres.NewTaskRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	creatorID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+
+	now := time.Now().Truncate(time.Microsecond)
+	newTask := func(parentID *id.TaskID) *task.Task {
+		return task.NewBuilder().
+			ID(id.NewTaskID()).
+			CompanyID(companyID).
+			CreatorID(creatorID).
+			ParentID(parentID).
+			Title("Soft Delete Test Task").
+			Visibility(task.VisibilityCompanyWide).
+			Status(task.StatusTodo).
+			Version(1).
+			CreatedAt(now).
+			UpdatedAt(now).
+			MustBuild()
+	}
+
+	parent := newTask(nil)
+	parentID := parent.ID()
+	child := newTask(&parentID)
+	for _, tk := range []*task.Task{parent, child} {
+		if err := repo.Create(ctx, tk); err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+	}
+
+	if err := repo.Delete(ctx, parentID, companyID, creatorID); !apperr.IsFailedPrecondition(err) {
+		t.Fatalf("expected failed precondition while the subtask is live, got %v", err)
+	}
+
+	if err := repo.Delete(ctx, child.ID(), companyID, creatorID); err != nil {
+		t.Fatalf("failed to delete subtask: %v", err)
+	}
+	if err := repo.Delete(ctx, parentID, companyID, creatorID); err != nil {
+		t.Fatalf("failed to delete parent: %v", err)
+	}
+
+	if _, err := repo.FindByIDForCompany(ctx, parentID, companyID); !apperr.IsNotFound(err) {
+		t.Errorf("expected deleted task to be hidden, got %v", err)
+	}
+	deleted, err := repo.FindDeletedByIDForCompany(ctx, parentID, companyID)
+	if err != nil {
+		t.Fatalf("failed to find deleted task: %v", err)
+	}
+	if deleted.DeletedAt() == nil {
+		t.Error("expected deleted_at to be set")
+	}
+
+	if err := repo.Restore(ctx, child.ID(), companyID, creatorID); !apperr.IsFailedPrecondition(err) {
+		t.Errorf("expected failed precondition while the parent is deleted, got %v", err)
+	}
+	if err := repo.Purge(ctx, parentID, companyID, creatorID); !apperr.IsFailedPrecondition(err) {
+		t.Errorf("expected failed precondition while the subtask is in the trash, got %v", err)
+	}
+
+	if err := repo.Restore(ctx, parentID, companyID, creatorID); err != nil {
+		t.Fatalf("failed to restore parent: %v", err)
+	}
+	if _, err := repo.FindByIDForCompany(ctx, parentID, companyID); err != nil {
+		t.Errorf("expected restored task to be found, got %v", err)
+	}
+
+	if err := repo.Purge(ctx, child.ID(), companyID, creatorID); err != nil {
+		t.Fatalf("failed to purge subtask: %v", err)
+	}
+	if _, err := repo.FindDeletedByIDForCompany(ctx, child.ID(), companyID); !apperr.IsNotFound(err) {
+		t.Errorf("expected purged task to be gone, got %v", err)
+	}
+}
+
+func TestTaskRepo_ListExcludesArchived(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	repo := postgres.NewTaskRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	creatorID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+
+	now := time.Now().Truncate(time.Microsecond)
+	parent, _ := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(companyID).
+		CreatorID(creatorID).
+		Title("Archive Parent").
+		Visibility(task.VisibilityCompanyWide).
+		Status(task.StatusTodo).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now).
+		Build()
+	if err := repo.Create(ctx, parent); err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	parentID := parent.ID()
+	child, _ := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(companyID).
+		CreatorID(creatorID).
+		ParentID(&parentID).
+		Title("Archived Child").
+		Visibility(task.VisibilityCompanyWide).
+		Status(task.StatusTodo).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now).
+		Build()
+	if err := repo.Create(ctx, child); err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	archived := true
+	if err := repo.Update(ctx, child.ApplyUpdate(task.Update{Archived: &archived}, now), 1, creatorID); err != nil {
+		t.Fatalf("failed to archive task: %v", err)
+	}
+
+	result, err := repo.ListByCompany(ctx, companyID, task.ListOptions{Filter: task.Filter{ParentID: &parentID}})
+	if err != nil {
+		t.Fatalf("failed to list tasks: %v", err)
+	}
+	if len(result.Tasks) != 0 {
+		t.Errorf("expected archived subtask to be hidden, got %d tasks", len(result.Tasks))
+	}
+
+	result, err = repo.ListByCompany(ctx, companyID, task.ListOptions{Filter: task.Filter{ParentID: &parentID, IncludeArchived: true}})
+	if err != nil {
+		t.Fatalf("failed to list tasks: %v", err)
+	}
+	if len(result.Tasks) != 1 || result.Tasks[0].ArchivedAt() == nil {
+		t.Errorf("expected the archived subtask with include_archived, got %d tasks", len(result.Tasks))
+	}
+}
+
+func TestWorkflowRepo_Save(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	repo := postgres.NewTaskRepo(client)
+	workflowRepo := postgres.NewWorkflowRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	creatorID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+
+	defaults := task.DefaultWorkflow(companyID)
+	defer workflowRepo.Save(ctx, defaults)
+
+	review := task.WorkflowStatus{Key: "in_review", Name: "In review", Category: task.CategoryDone}
+	withReview, err := task.NewWorkflow(companyID, append(defaults.Statuses(), review), append(defaults.Transitions(), task.Transition{From: task.StatusInProgress, To: review.Key}))
+	if err != nil {
+		t.Fatalf("NewWorkflow() error = %v", err)
+	}
+	if err := workflowRepo.Save(ctx, withReview); err != nil {
+		t.Fatalf("failed to save workflow: %v", err)
+	}
+
+	found, err := workflowRepo.FindByCompany(ctx, companyID)
+	if err != nil {
+		t.Fatalf("failed to find workflow: %v", err)
+	}
+	if len(found.Statuses()) != 4 || !found.CanTransition(task.StatusInProgress, review.Key) || found.CanTransition(review.Key, task.StatusTodo) {
+		t.Errorf("workflow did not round-trip: %v %v", found.Statuses(), found.Transitions())
+	}
+
+	now := time.Now().Truncate(time.Microsecond)
+	reviewed, _ := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(companyID).
+		CreatorID(creatorID).
+		Title("In Review").
+		Visibility(task.VisibilityCompanyWide).
+		Status(review.Key).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now).
+		Build()
+	if err := repo.Create(ctx, reviewed); err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	stored, err := repo.FindByID(ctx, reviewed.ID())
+	if err != nil {
+		t.Fatalf("failed to find task: %v", err)
+	}
+	if !stored.IsDone() {
+		t.Errorf("Category() = %s, want done", stored.Category())
+	}
+
+	if err := workflowRepo.Save(ctx, defaults); !apperr.IsFailedPrecondition(err) {
+		t.Errorf("expected failed precondition while a task is in_review, got %v", err)
+	}
+
+	if err := repo.Delete(ctx, reviewed.ID(), companyID, creatorID); err != nil {
+		t.Fatalf("failed to delete task: %v", err)
+	}
+	if err := repo.Purge(ctx, reviewed.ID(), companyID, creatorID); err != nil {
+		t.Fatalf("failed to purge task: %v", err)
+	}
+}
+
+func TestTaskRepo_MultipleAssignees(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	repo := postgres.NewTaskRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	aliceID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	bobID, _ := id.ParseUserID("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb")
+
+	now := time.Now().Truncate(time.Microsecond)
+	newTask, _ := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(companyID).
+		CreatorID(aliceID).
+		AssigneeIDs([]id.UserID{aliceID, bobID}).
+		Title("Pair Task").
+		Visibility(task.VisibilityOnlyMe).
+		Status(task.StatusTodo).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now).
+		Build()
+	if err := repo.Create(ctx, newTask); err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	found, err := repo.FindByID(ctx, newTask.ID())
+	if err != nil {
+		t.Fatalf("failed to find task: %v", err)
+	}
+	if ids := found.AssigneeIDs(); len(ids) != 2 || !ids[0].Equal(aliceID) || !ids[1].Equal(bobID) {
+		t.Errorf("AssigneeIDs() = %v, want [%s %s]", ids, aliceID, bobID)
+	}
+
+	contains := func(result *task.ListResult) bool {
+		for _, tk := range result.Tasks {
+			if tk.ID().Equal(newTask.ID()) {
+				return true
+			}
+		}
+		return false
+	}
+
+	byAssignee, err := repo.ListByAssignee(ctx, companyID, bobID, task.ListOptions{PageSize: 100})
+	if err != nil {
+		t.Fatalf("failed to list tasks: %v", err)
+	}
+	if !contains(byAssignee) {
+		t.Error("expected the task among the second assignee's tasks")
+	}
+
+	visible, err := repo.ListVisibleByCompany(ctx, companyID, bobID, task.ListOptions{PageSize: 100})
+	if err != nil {
+		t.Fatalf("failed to list tasks: %v", err)
+	}
+	if !contains(visible) {
+		t.Error("expected the only_me task to be visible to the second assignee")
+	}
+
+	updated := found.ApplyUpdate(task.Update{RemoveAssigneeIDs: []id.UserID{bobID}}, now.Add(time.Second))
+	if err := repo.Update(ctx, updated, 1, aliceID); err != nil {
+		t.Fatalf("failed to update task: %v", err)
+	}
+
+	visible, err = repo.ListVisibleByCompany(ctx, companyID, bobID, task.ListOptions{PageSize: 100})
+	if err != nil {
+		t.Fatalf("failed to list tasks: %v", err)
+	}
+	if contains(visible) {
+		t.Error("expected the task to be hidden from a removed assignee")
+	}
+}
+
+func TestInviteRepo_Accept(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	inviteRepo := postgres.NewInviteRepo(client)
+	userRepo := postgres.NewUserRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	inviterID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	now := time.Now().Truncate(time.Microsecond)
+
+	token, hash, err := user.NewInviteToken()
+	if err != nil {
+		t.Fatalf("NewInviteToken() error = %v", err)
+	}
+	email := "invitee-" + id.NewInviteID().String() + "@acme.com"
+	invite := user.NewInviteBuilder().
+		ID(id.NewInviteID()).
+		CompanyID(companyID).
+		Email(email).
+		Role(user.RoleEditor).
+		InvitedBy(inviterID).
+		TokenHash(hash).
+		ExpiresAt(now.Add(user.InviteTTL)).
+		CreatedAt(now).
+		MustBuild()
+	if err := inviteRepo.Create(ctx, invite); err != nil {
+		t.Fatalf("failed to create invite: %v", err)
+	}
+
+	found, err := inviteRepo.FindByTokenHash(ctx, user.HashInviteToken(token))
+	if err != nil {
+		t.Fatalf("failed to find invite: %v", err)
+	}
+	if found.Email() != email || found.Role() != user.RoleEditor || found.IsAccepted() {
+		t.Errorf("invite did not round-trip: %s %s accepted=%v", found.Email(), found.Role(), found.IsAccepted())
+	}
+
+	newUser := func() *user.User {
+		return user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email(email).Role(user.RoleEditor).CreatedAt(now).MustBuild()
+	}
+	accepted := newUser()
+	if err := inviteRepo.Accept(ctx, found, accepted, now); err != nil {
+		t.Fatalf("failed to accept invite: %v", err)
+	}
+	if err := inviteRepo.Accept(ctx, found, newUser(), now); !apperr.IsFailedPrecondition(err) {
+		t.Errorf("expected failed precondition on second accept, got %v", err)
+	}
+
+	deactivated := accepted.ApplyUpdate(user.Update{Deactivated: true}, now)
+	if err := userRepo.Update(ctx, deactivated); err != nil {
+		t.Fatalf("failed to deactivate user: %v", err)
+	}
+	stored, err := userRepo.FindByID(ctx, accepted.ID())
+	if err != nil {
+		t.Fatalf("failed to find user: %v", err)
+	}
+	if stored.IsActive() {
+		t.Error("expected user to be deactivated")
+	}
+
+	active, err := userRepo.ListByCompany(ctx, companyID, false)
+	if err != nil {
+		t.Fatalf("failed to list users: %v", err)
+	}
+	for _, u := range active {
+		if u.ID().Equal(accepted.ID()) {
+			t.Error("deactivated user listed without include_deactivated")
+		}
+	}
+}
+
+func TestTaskRepo_HandOver(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	repo := postgres.NewTaskRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	aliceID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	bobID, _ := id.ParseUserID("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb")
+	now := time.Now().Truncate(time.Microsecond)
+
+	leaver := createLeaver(t, client, companyID, aliceID, now)
+
+	newTask := func(creatorID id.UserID, visibility task.Visibility, assigneeIDs ...id.UserID) *task.Task {
+		tk := task.NewBuilder().
+			ID(id.NewTaskID()).
+			CompanyID(companyID).
+			CreatorID(creatorID).
+			AssigneeIDs(assigneeIDs).
+			Title("Handover Task").
+			Visibility(visibility).
+			Status(task.StatusTodo).
+			Version(1).
+			CreatedAt(now).
+			UpdatedAt(now).
+			MustBuild()
+		if err := repo.Create(ctx, tk); err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+		return tk
+	}
+	private := newTask(leaver.ID(), task.VisibilityOnlyMe, leaver.ID())
+	assigned := newTask(aliceID, task.VisibilityCompanyWide, leaver.ID(), aliceID)
+
+	changes, err := repo.HandOver(ctx, task.Handover{
+		CompanyID:  companyID,
+		FromID:     leaver.ID(),
+		CreatorID:  aliceID,
+		AssigneeID: &bobID,
+		OnlyMe:     task.OnlyMeShare,
+	}, aliceID, now.Add(time.Second))
+	if err != nil {
+		t.Fatalf("failed to hand over tasks: %v", err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changed tasks, got %d", len(changes))
+	}
+
+	found, err := repo.FindByID(ctx, private.ID())
+	if err != nil {
+		t.Fatalf("failed to find task: %v", err)
+	}
+	if !found.CreatorID().Equal(aliceID) || found.Visibility() != task.VisibilityCompanyWide || found.Version() != 2 {
+		t.Errorf("private task: creator %s, visibility %s, version %d", found.CreatorID(), found.Visibility(), found.Version())
+	}
+	if ids := found.AssigneeIDs(); len(ids) != 1 || !ids[0].Equal(bobID) {
+		t.Errorf("private task: AssigneeIDs() = %v, want [%s]", ids, bobID)
+	}
+
+	found, err = repo.FindByID(ctx, assigned.ID())
+	if err != nil {
+		t.Fatalf("failed to find task: %v", err)
+	}
+	if ids := found.AssigneeIDs(); len(ids) != 2 || !ids[0].Equal(aliceID) || !ids[1].Equal(bobID) {
+		t.Errorf("assigned task: AssigneeIDs() = %v, want [%s %s]", ids, aliceID, bobID)
+	}
+}
+
+func TestTaskRepo_HandOverTeamSeries(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	repo := postgres.NewTaskRepo(client)
+	seriesRepo := postgres.NewTaskSeriesRepo(client)
+	teamRepo := postgres.NewTeamRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	aliceID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	now := time.Now().Truncate(time.Microsecond)
+
+	leaver := createLeaver(t, client, companyID, aliceID, now)
+
+	tm := team.NewBuilder().ID(id.NewTeamID()).CompanyID(companyID).Name("Series Team " + now.String()).CreatedAt(now).MustBuild()
+	if err := teamRepo.Create(ctx, tm); err != nil {
+		t.Fatalf("failed to create team: %v", err)
+	}
+	teamID := tm.ID()
+
+	rule, err := recurrence.Parse("FREQ=WEEKLY")
+	if err != nil {
+		t.Fatalf("failed to parse rule: %v", err)
+	}
+	seriesID := id.NewSeriesID()
+	series := task.NewSeriesBuilder().
+		ID(seriesID).
+		CompanyID(companyID).
+		CreatorID(leaver.ID()).
+		Rule(rule).
+		TimeZone(time.UTC).
+		StartsAt(now).
+		LastOccurrenceAt(now).
+		Occurrences(1).
+		Title("Team Series").
+		Visibility(task.VisibilityTeam).
+		TeamID(&teamID).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now).
+		MustBuild()
+	first := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(companyID).
+		CreatorID(leaver.ID()).
+		Title("Team Series").
+		DueDate(&now).
+		Visibility(task.VisibilityTeam).
+		TeamID(&teamID).
+		Status(task.StatusTodo).
+		SeriesID(&seriesID).
+		Occurrence(1).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now).
+		MustBuild()
+	if err := seriesRepo.Create(ctx, series, first); err != nil {
+		t.Fatalf("failed to create series: %v", err)
+	}
+
+	if _, err := repo.HandOver(ctx, task.Handover{
+		CompanyID: companyID,
+		FromID:    leaver.ID(),
+		CreatorID: aliceID,
+		OnlyMe:    task.OnlyMeShare,
+	}, aliceID, now.Add(time.Second)); err != nil {
+		t.Fatalf("failed to hand over tasks: %v", err)
+	}
+
+	found, err := seriesRepo.FindByIDForCompany(ctx, seriesID, companyID)
+	if err != nil {
+		t.Fatalf("failed to find series: %v", err)
+	}
+	if !found.CreatorID().Equal(aliceID) || found.Visibility() != task.VisibilityTeam {
+		t.Errorf("team series: creator %s, visibility %s", found.CreatorID(), found.Visibility())
+	}
+
+	foundTask, err := repo.FindByID(ctx, first.ID())
+	if err != nil {
+		t.Fatalf("failed to find task: %v", err)
+	}
+	if foundTask.Visibility() != task.VisibilityTeam {
+		t.Errorf("team series instance: visibility %s, want team", foundTask.Visibility())
+	}
+}
+
+// createLeaver adds an editor through an invite, so handing over their tasks
+// leaves the seed users' tasks alone.
+func createLeaver(t *testing.T, client *postgres.Client, companyID id.CompanyID, invitedBy id.UserID, now time.Time) *user.User {
+	t.Helper()
+
+	ctx := context.Background()
+	inviteRepo := postgres.NewInviteRepo(client)
+
+	_, hash, err := user.NewInviteToken()
+	if err != nil {
+		t.Fatalf("NewInviteToken() error = %v", err)
+	}
+	invite := user.NewInviteBuilder().
+		ID(id.NewInviteID()).
+		CompanyID(companyID).
+		Email("leaver-" + id.NewInviteID().String() + "@acme.com").
+		Role(user.RoleEditor).
+		InvitedBy(invitedBy).
+		TokenHash(hash).
+		ExpiresAt(now.Add(user.InviteTTL)).
+		CreatedAt(now).
+		MustBuild()
+	if err := inviteRepo.Create(ctx, invite); err != nil {
+		t.Fatalf("failed to create invite: %v", err)
+	}
+	leaver := user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email(invite.Email()).Role(user.RoleEditor).CreatedAt(now).MustBuild()
+	if err := inviteRepo.Accept(ctx, invite, leaver, now); err != nil {
+		t.Fatalf("failed to accept invite: %v", err)
+	}
+	return leaver
+}
+
+func TestCompanyRepo_Create(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	companyRepo := postgres.NewCompanyRepo(client)
+	workflowRepo := postgres.NewWorkflowRepo(client)
+	userRepo := postgres.NewUserRepo(client)
+	now := time.Now().Truncate(time.Microsecond)
+
+	c := company.NewBuilder().ID(id.NewCompanyID()).Name("Provisioned").CreatedAt(now).MustBuild()
+	owner := user.NewBuilder().
+		ID(id.NewUserID()).
+		CompanyID(c.ID()).
+		Email("owner-" + c.ID().String() + "@example.com").
+		Role(user.RoleOwner).
+		CreatedAt(now).
+		MustBuild()
+	if err := companyRepo.Create(ctx, c, owner, task.DefaultWorkflow(c.ID())); err != nil {
+		t.Fatalf("failed to create company: %v", err)
+	}
+
+	if _, err := workflowRepo.FindByCompany(ctx, c.ID()); err != nil {
+		t.Errorf("expected the default workflow, got %v", err)
+	}
+	if found, err := userRepo.FindByID(ctx, owner.ID()); err != nil || found.Role() != user.RoleOwner {
+		t.Errorf("expected the owner to be stored, got %v", err)
+	}
+
+	// A taken owner email rolls the whole company back.
+	other := company.NewBuilder().ID(id.NewCompanyID()).Name("Duplicate").CreatedAt(now).MustBuild()
+	duplicate := user.NewBuilder().ID(id.NewUserID()).CompanyID(other.ID()).Email(owner.Email()).Role(user.RoleOwner).CreatedAt(now).MustBuild()
+	if err := companyRepo.Create(ctx, other, duplicate, task.DefaultWorkflow(other.ID())); !apperr.IsAlreadyExists(err) {
+		t.Errorf("expected already exists, got %v", err)
+	}
+	if _, err := companyRepo.FindByID(ctx, other.ID()); !apperr.IsNotFound(err) {
+		t.Errorf("expected the company to be rolled back, got %v", err)
+	}
+
+	suspended := true
+	updated := c.ApplyUpdate(company.Update{Suspended: &suspended}, now)
+	if err := companyRepo.Update(ctx, updated); err != nil {
+		t.Fatalf("failed to suspend company: %v", err)
+	}
+	found, err := companyRepo.FindByID(ctx, c.ID())
+	if err != nil {
+		t.Fatalf("failed to find company: %v", err)
+	}
+	if !found.IsSuspended() {
+		t.Error("expected the company to be suspended")
+	}
+}
+
+func TestTeamRepo_Visibility(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	teamRepo := postgres.NewTeamRepo(client)
+	taskRepo := postgres.NewTaskRepo(client)
+	userRepo := postgres.NewUserRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	otherCompanyID, _ := id.ParseCompanyID("22222222-2222-2222-2222-222222222222")
+	creatorID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	memberID, _ := id.ParseUserID("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb")
+	outsiderID, _ := id.ParseUserID("cccccccc-cccc-cccc-cccc-cccccccccccc")
+	now := time.Now().Truncate(time.Microsecond)
+
+	tm := team.NewBuilder().ID(id.NewTeamID()).CompanyID(companyID).Name("Team " + now.String()).CreatedAt(now).MustBuild()
+	if err := teamRepo.Create(ctx, tm); err != nil {
+		t.Fatalf("failed to create team: %v", err)
+	}
+	teamID := tm.ID()
+
+	if err := teamRepo.AddMember(ctx, companyID, teamID, memberID); err != nil {
+		t.Fatalf("failed to add member: %v", err)
+	}
+	if err := teamRepo.AddMember(ctx, companyID, teamID, memberID); err != nil {
+		t.Errorf("expected adding a member twice to succeed, got %v", err)
+	}
+	if err := teamRepo.AddMember(ctx, companyID, teamID, outsiderID); !apperr.IsNotFound(err) {
+		t.Errorf("expected not found for a user of another company, got %v", err)
+	}
+	if member, err := userRepo.FindByID(ctx, memberID); err != nil || !member.IsTeamMember(teamID) {
+		t.Errorf("expected the user to carry the team, got %v", err)
+	}
+
+	teamTask := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(companyID).
+		CreatorID(creatorID).
+		Title("Team Task").
+		Visibility(task.VisibilityTeam).
+		TeamID(&teamID).
+		Status(task.StatusTodo).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now).
+		MustBuild()
+	if err := taskRepo.Create(ctx, teamTask); err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	visible := func() bool {
+		teamVisibility := task.VisibilityTeam
+		opts := task.ListOptions{PageSize: 100, Filter: task.Filter{Visibility: &teamVisibility}}
+		result, err := taskRepo.ListVisibleByCompany(ctx, companyID, memberID, opts)
+		if err != nil {
+			t.Fatalf("failed to list tasks: %v", err)
+		}
+		for _, found := range result.Tasks {
+			if found.ID().Equal(teamTask.ID()) {
+				return true
+			}
+		}
+		return false
+	}
+	if !visible() {
+		t.Error("expected the team task to be visible to a member")
+	}
+
+	watcherRepo := postgres.NewTaskWatcherRepo(client)
+	if err := watcherRepo.Add(ctx, companyID, teamTask.ID(), []id.UserID{memberID}); err != nil {
+		t.Fatalf("failed to watch task: %v", err)
+	}
+	watched, err := watcherRepo.ListTeamTasks(ctx, companyID, teamID, memberID)
+	if err != nil {
+		t.Fatalf("failed to list watched team tasks: %v", err)
+	}
+	if len(watched) != 1 || !watched[0].ID().Equal(teamTask.ID()) {
+		t.Errorf("expected the watched team task, got %d tasks", len(watched))
+	}
+
+	if err := teamRepo.RemoveMember(ctx, companyID, teamID, memberID); err != nil {
+		t.Fatalf("failed to remove member: %v", err)
+	}
+	if visible() {
+		t.Error("expected the team task to be hidden after leaving the team")
+	}
+
+	// Tasks cannot point at another company's team.
+	foreign := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(otherCompanyID).
+		CreatorID(outsiderID).
+		Title("Foreign Team Task").
+		Visibility(task.VisibilityTeam).
+		TeamID(&teamID).
+		Status(task.StatusTodo).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now).
+		MustBuild()
+	if err := taskRepo.Create(ctx, foreign); !apperr.IsInvalidInput(err) {
+		t.Errorf("expected invalid input for another company's team, got %v", err)
+	}
+
+	if err := teamRepo.Delete(ctx, teamID, companyID); !apperr.IsFailedPrecondition(err) {
+		t.Errorf("expected failed precondition while a task uses the team, got %v", err)
+	}
+
+	// Cleanup
+	taskRepo.Delete(ctx, teamTask.ID(), companyID, creatorID)
+	taskRepo.Purge(ctx, teamTask.ID(), companyID, creatorID)
+	if err := teamRepo.Delete(ctx, teamID, companyID); err != nil {
+		t.Errorf("failed to delete team: %v", err)
+	}
+}
+
+func TestTaskShareRepo_SaveAndDelete(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	shareRepo := postgres.NewTaskShareRepo(client)
+	taskRepo := postgres.NewTaskRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	creatorID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	sharedID, _ := id.ParseUserID("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb")
+	now := time.Now().Truncate(time.Microsecond)
+
+	private := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(companyID).
+		CreatorID(creatorID).
+		Title("Private Task").
+		Visibility(task.VisibilityOnlyMe).
+		Status(task.StatusTodo).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now).
+		MustBuild()
+	if err := taskRepo.Create(ctx, private); err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	visible := func() bool {
+		result, err := taskRepo.ListVisibleByCompany(ctx, companyID, sharedID, task.ListOptions{PageSize: 100})
+		if err != nil {
+			t.Fatalf("failed to list tasks: %v", err)
+		}
+		for _, found := range result.Tasks {
+			if found.ID().Equal(private.ID()) {
+				return true
+			}
+		}
+		return false
+	}
+	if visible() {
+		t.Fatal("expected the private task to be hidden before sharing")
+	}
+
+	if err := shareRepo.Save(ctx, companyID, private.ID(), task.Share{UserID: sharedID, Role: task.ShareRoleViewer}); err != nil {
+		t.Fatalf("failed to share task: %v", err)
+	}
+	if !visible() {
+		t.Error("expected the shared task to be visible")
+	}
+
+	if err := shareRepo.Save(ctx, companyID, private.ID(), task.Share{UserID: sharedID, Role: task.ShareRoleEditor}); err != nil {
+		t.Fatalf("failed to change share role: %v", err)
+	}
+	found, err := taskRepo.FindByIDForCompany(ctx, private.ID(), companyID)
+	if err != nil {
+		t.Fatalf("failed to find task: %v", err)
+	}
+	if len(found.Shares()) != 1 || !found.IsSharedEditor(sharedID) {
+		t.Errorf("expected one editor share, got %+v", found.Shares())
+	}
+
+	if err := shareRepo.Save(ctx, companyID, id.NewTaskID(), task.Share{UserID: sharedID, Role: task.ShareRoleViewer}); !apperr.IsNotFound(err) {
+		t.Errorf("expected not found for a missing task, got %v", err)
+	}
+
+	if err := shareRepo.Delete(ctx, companyID, private.ID(), sharedID); err != nil {
+		t.Fatalf("failed to unshare task: %v", err)
+	}
+	if visible() {
+		t.Error("expected the task to be hidden after unsharing")
+	}
+	if err := shareRepo.Delete(ctx, companyID, private.ID(), sharedID); !apperr.IsNotFound(err) {
+		t.Errorf("expected not found for a missing share, got %v", err)
+	}
+}
+
+func TestTaskDependencyRepo_ConcurrentCycle(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	taskRepo := postgres.NewTaskRepo(client)
+	dependencyRepo := postgres.NewTaskDependencyRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	creatorID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	now := time.Now().Truncate(time.Microsecond)
+
+	var ids []id.TaskID
+	for i := 0; i < 2; i++ {
+		tk := task.NewBuilder().
+			ID(id.NewTaskID()).
+			CompanyID(companyID).
+			CreatorID(creatorID).
+			Title("Dependency Task").
+			Visibility(task.VisibilityCompanyWide).
+			Status(task.StatusTodo).
+			Version(1).
+			CreatedAt(now).
+			UpdatedAt(now).
+			MustBuild()
+		if err := taskRepo.Create(ctx, tk); err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+		ids = append(ids, tk.ID())
+	}
+	a, b := ids[0], ids[1]
+
+	// Each edge alone is fine; together they form a cycle, so exactly one
+	// of the concurrent adds must win.
+	errs := make(chan error, 2)
+	go func() { errs <- dependencyRepo.Add(ctx, companyID, a, b) }()
+	go func() { errs <- dependencyRepo.Add(ctx, companyID, b, a) }()
+
+	var added, rejected int
+	for i := 0; i < 2; i++ {
+		err := <-errs
+		switch {
+		case err == nil:
+			added++
+		case apperr.IsInvalidInput(err):
+			rejected++
+		default:
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if added != 1 || rejected != 1 {
+		t.Errorf("expected one edge added and one rejected, got %d added and %d rejected", added, rejected)
+	}
+}
+
+func TestNotificationRepo_CreateDueSoon(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	taskRepo := postgres.NewTaskRepo(client)
+	userRepo := postgres.NewUserRepo(client)
+	notificationRepo := postgres.NewNotificationRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	aliceID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	bobID, _ := id.ParseUserID("bbbbbbbb-bbbb-bbbb-bbbb-bbbbbbbbbbbb")
+	now := time.Now().Truncate(time.Microsecond)
+	due := now.Add(time.Hour)
+
+	leaver := createLeaver(t, client, companyID, aliceID, now)
+
+	newTask := func(assigneeID id.UserID, archivedAt *time.Time) *task.Task {
+		tk := task.NewBuilder().
+			ID(id.NewTaskID()).
+			CompanyID(companyID).
+			CreatorID(aliceID).
+			AssigneeIDs([]id.UserID{assigneeID}).
+			Title("Due Task").
+			DueDate(&due).
+			Visibility(task.VisibilityCompanyWide).
+			Status(task.StatusTodo).
+			ArchivedAt(archivedAt).
+			Version(1).
+			CreatedAt(now).
+			UpdatedAt(now).
+			MustBuild()
+		if err := taskRepo.Create(ctx, tk); err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+		return tk
+	}
+	live := newTask(bobID, nil)
+	archived := newTask(bobID, &now)
+	leaversTask := newTask(leaver.ID(), nil)
+
+	if err := userRepo.Update(ctx, leaver.ApplyUpdate(user.Update{Deactivated: true}, now)); err != nil {
+		t.Fatalf("failed to deactivate user: %v", err)
+	}
+
+	if _, err := notificationRepo.CreateDueSoon(ctx, now, 2*time.Hour); err != nil {
+		t.Fatalf("failed to create reminders: %v", err)
+	}
+
+	reminded := func(recipientID id.UserID, taskID id.TaskID) bool {
+		result, err := notificationRepo.ListForRecipient(ctx, recipientID, notification.ListOptions{PageSize: 100})
+		if err != nil {
+			t.Fatalf("failed to list notifications: %v", err)
+		}
+		for _, n := range result.Notifications {
+			if n.Kind() == notification.KindDueSoon && n.TaskID().Equal(taskID) {
+				return true
+			}
+		}
+		return false
+	}
+	if !reminded(bobID, live.ID()) {
+		t.Error("expected a reminder for the live task")
+	}
+	if reminded(bobID, archived.ID()) {
+		t.Error("expected no reminder for the archived task")
+	}
+	if reminded(leaver.ID(), leaversTask.ID()) {
+		t.Error("expected no reminder for a deactivated assignee")
+	}
+}
+
+func TestTaskRepo_ConcurrentParentCycle(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	repo := postgres.NewTaskRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	creatorID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	now := time.Now().Truncate(time.Microsecond)
+
+	var tasks []*task.Task
+	for i := 0; i < 2; i++ {
+		tk := task.NewBuilder().
+			ID(id.NewTaskID()).
+			CompanyID(companyID).
+			CreatorID(creatorID).
+			Title("Parent Task").
+			Visibility(task.VisibilityCompanyWide).
+			Status(task.StatusTodo).
+			Version(1).
+			CreatedAt(now).
+			UpdatedAt(now).
+			MustBuild()
+		if err := repo.Create(ctx, tk); err != nil {
+			t.Fatalf("failed to create task: %v", err)
+		}
+		tasks = append(tasks, tk)
+	}
+	a, b := tasks[0], tasks[1]
+
+	// Each move alone is fine; together they form a cycle, so exactly one
+	// of the concurrent updates must win.
+	move := func(child, parent *task.Task) error {
+		parentID := parent.ID()
+		parentPtr := &parentID
+		return repo.Update(ctx, child.ApplyUpdate(task.Update{ParentID: &parentPtr}, now.Add(time.Second)), 1, creatorID)
+	}
+	errs := make(chan error, 2)
+	go func() { errs <- move(a, b) }()
+	go func() { errs <- move(b, a) }()
+
+	var moved, rejected int
+	for i := 0; i < 2; i++ {
+		err := <-errs
+		switch {
+		case err == nil:
+			moved++
+		case apperr.IsInvalidInput(err):
+			rejected++
+		default:
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if moved != 1 || rejected != 1 {
+		t.Errorf("expected one move saved and one rejected, got %d saved and %d rejected", moved, rejected)
+	}
+}
+
+func TestTaskSeriesRepo_UpdateInstanceConflict(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	repo := postgres.NewTaskRepo(client)
+	seriesRepo := postgres.NewTaskSeriesRepo(client)
+
+	companyID, _ := id.ParseCompanyID("11111111-1111-1111-1111-111111111111")
+	aliceID, _ := id.ParseUserID("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa")
+	now := time.Now().Truncate(time.Microsecond)
+
+	rule, err := recurrence.Parse("FREQ=WEEKLY")
+	if err != nil {
+		t.Fatalf("failed to parse rule: %v", err)
+	}
+	seriesID := id.NewSeriesID()
+	series := task.NewSeriesBuilder().
+		ID(seriesID).
+		CompanyID(companyID).
+		CreatorID(aliceID).
+		Rule(rule).
+		TimeZone(time.UTC).
+		StartsAt(now).
+		LastOccurrenceAt(now).
+		Occurrences(1).
+		Title("Weekly Sync").
+		Visibility(task.VisibilityCompanyWide).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now).
+		MustBuild()
+	first := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(companyID).
+		CreatorID(aliceID).
+		Title("Weekly Sync").
+		DueDate(&now).
+		Visibility(task.VisibilityCompanyWide).
+		Status(task.StatusTodo).
+		SeriesID(&seriesID).
+		Occurrence(1).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now).
+		MustBuild()
+	if err := seriesRepo.Create(ctx, series, first); err != nil {
+		t.Fatalf("failed to create series: %v", err)
+	}
+
+	// Someone edits the instance after the series edit listed it.
+	edited := "Weekly Sync (moved)"
+	if err := repo.Update(ctx, first.ApplyUpdate(task.Update{Title: &edited}, now), first.Version(), aliceID); err != nil {
+		t.Fatalf("failed to update instance: %v", err)
+	}
+
+	title := "Weekly Review"
+	updatedSeries := series.ApplyUpdate(task.SeriesUpdate{Title: &title}, now)
+	change := task.NewChange(first, first.ApplyUpdate(task.Update{Title: &title}, now))
+	err = seriesRepo.Update(ctx, updatedSeries, series.Version(), []task.Change{change}, aliceID)
+	if !apperr.IsVersionMismatch(err) {
+		t.Fatalf("expected version mismatch, got %v", err)
+	}
+
+	found, err := seriesRepo.FindByIDForCompany(ctx, seriesID, companyID)
+	if err != nil {
+		t.Fatalf("failed to find series: %v", err)
+	}
+	if found.Title() != "Weekly Sync" || found.Version() != series.Version() {
+		t.Errorf("series was saved despite the conflict: title %q, version %d", found.Title(), found.Version())
+	}
+}
+
+func TestCompanyDelete_CascadesThroughCreatedTasks(t *testing.T) {
+	client := setupTestDB(t)
+	defer client.Close()
+
+	ctx := context.Background()
+	companyRepo := postgres.NewCompanyRepo(client)
+	repo := postgres.NewTaskRepo(client)
+	now := time.Now().Truncate(time.Microsecond)
+
+	c := company.NewBuilder().ID(id.NewCompanyID()).Name("Closing").CreatedAt(now).MustBuild()
+	owner := user.NewBuilder().
+		ID(id.NewUserID()).
+		CompanyID(c.ID()).
+		Email("owner-" + c.ID().String() + "@example.com").
+		Role(user.RoleOwner).
+		CreatedAt(now).
+		MustBuild()
+	if err := companyRepo.Create(ctx, c, owner, task.DefaultWorkflow(c.ID())); err != nil {
+		t.Fatalf("failed to create company: %v", err)
+	}
+
+	tk := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(c.ID()).
+		CreatorID(owner.ID()).
+		Title("Created by the owner").
+		Visibility(task.VisibilityCompanyWide).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now).
+		MustBuild()
+	if err := repo.Create(ctx, tk); err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	// The creator alone cannot be deleted while their task exists.
+	if _, err := client.Pool().Exec(ctx, "DELETE FROM users WHERE id = $1", owner.ID().UUID()); err == nil {
+		t.Fatal("expected deleting the task creator to fail")
+	}
+
+	if _, err := client.Pool().Exec(ctx, "DELETE FROM companies WHERE id = $1", c.ID().UUID()); err != nil {
+		t.Fatalf("failed to delete company: %v", err)
+	}
+	if _, err := repo.FindByID(ctx, tk.ID()); !apperr.IsNotFound(err) {
+		t.Errorf("expected the task to be deleted with its company, got %v", err)
+	}
+}
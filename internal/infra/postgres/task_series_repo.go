@@ -0,0 +1,359 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/recurrence"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+const seriesColumns = "id, company_id, creator_id, assignee_id, rule, time_zone, starts_at, last_occurrence_at, occurrences, ended, title, description, visibility, team_id, priority, version, created_at, updated_at"
+
+type TaskSeriesRepo struct {
+	client *Client
+}
+
+func NewTaskSeriesRepo(client *Client) *TaskSeriesRepo {
+	return &TaskSeriesRepo{client: client}
+}
+
+func (r *TaskSeriesRepo) Create(ctx context.Context, s *task.Series, first *task.Task) error {
+	query := `
+		INSERT INTO task_series (` + seriesColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+	`
+
+	var assigneeID interface{}
+	if s.AssigneeID() != nil {
+		assigneeID = s.AssigneeID().UUID()
+	}
+
+	err := pgx.BeginFunc(ctx, r.client.pool, func(tx pgx.Tx) error {
+		_, err := tx.Exec(ctx, query,
+			s.ID().UUID(),
+			s.CompanyID().UUID(),
+			s.CreatorID().UUID(),
+			assigneeID,
+			s.Rule().String(),
+			s.TimeZone().String(),
+			s.StartsAt(),
+			s.LastOccurrenceAt(),
+			s.Occurrences(),
+			s.Ended(),
+			s.Title(),
+			s.Description(),
+			s.Visibility().String(),
+			teamID(s.TeamID()),
+			s.Priority().String(),
+			s.Version(),
+			s.CreatedAt(),
+			s.UpdatedAt(),
+		)
+		if err != nil {
+			return err
+		}
+
+		creatorID := first.CreatorID()
+		return insertTask(ctx, tx, first, &creatorID)
+	})
+	if violatesConstraint(err, "task_series_team_fkey") {
+		return apperr.NewErrInvalidInput("team_id", "team not found")
+	}
+	return err
+}
+
+func (r *TaskSeriesRepo) FindByIDForCompany(ctx context.Context, seriesID id.SeriesID, companyID id.CompanyID) (*task.Series, error) {
+	query := `
+		SELECT ` + seriesColumns + `
+		FROM task_series
+		WHERE id = $1 AND company_id = $2
+	`
+
+	var sr seriesRow
+	if err := r.client.pool.QueryRow(ctx, query, seriesID.UUID(), companyID.UUID()).Scan(sr.dest()...); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NewErrNotFound("task series", seriesID.String())
+		}
+		return nil, err
+	}
+
+	return sr.toSeries()
+}
+
+// Update saves s and every instance change in one transaction, so a version
+// conflict on any of them leaves the series and all instances untouched.
+func (r *TaskSeriesRepo) Update(ctx context.Context, s *task.Series, expectedVersion int, instances []task.Change, actorID id.UserID) error {
+	var seriesUpdated bool
+	var conflict *task.Change
+	err := pgx.BeginFunc(ctx, r.client.pool, func(tx pgx.Tx) error {
+		var err error
+		seriesUpdated, err = updateSeries(ctx, tx, s, expectedVersion)
+		if err != nil || !seriesUpdated {
+			return err
+		}
+
+		for i := range instances {
+			c := &instances[i]
+			updated, err := updateTask(ctx, tx, c.After, c.Before.Version(), actorID)
+			if err != nil {
+				return err
+			}
+			if !updated {
+				conflict = c
+				return errInstanceConflict
+			}
+		}
+		return nil
+	})
+	if conflict != nil {
+		existing, err := NewTaskRepo(r.client).FindByIDForCompany(ctx, conflict.Before.ID(), conflict.Before.CompanyID())
+		if err != nil {
+			return err
+		}
+		return apperr.NewErrVersionMismatch(conflict.Before.Version(), existing.Version())
+	}
+	if err != nil {
+		return err
+	}
+
+	if !seriesUpdated {
+		return r.versionMismatch(ctx, s, expectedVersion)
+	}
+
+	return nil
+}
+
+func (r *TaskSeriesRepo) Advance(ctx context.Context, s *task.Series, expectedVersion int, next *task.Task) error {
+	var updated bool
+	err := pgx.BeginFunc(ctx, r.client.pool, func(tx pgx.Tx) error {
+		var err error
+		updated, err = updateSeries(ctx, tx, s, expectedVersion)
+		if err != nil || !updated || next == nil {
+			return err
+		}
+
+		return insertTask(ctx, tx, next, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !updated {
+		return r.versionMismatch(ctx, s, expectedVersion)
+	}
+
+	return nil
+}
+
+// errInstanceConflict rolls back a series update when one of its instances
+// no longer has the expected version.
+var errInstanceConflict = errors.New("series instance version conflict")
+
+// updateSeries saves s inside tx and reports false, without error, when the
+// stored series no longer has expectedVersion.
+func updateSeries(ctx context.Context, tx pgx.Tx, s *task.Series, expectedVersion int) (bool, error) {
+	query := `
+		UPDATE task_series
+		SET assignee_id = $1, rule = $2, time_zone = $3, starts_at = $4, last_occurrence_at = $5, occurrences = $6,
+			ended = $7, title = $8, description = $9, visibility = $10, team_id = $11, priority = $12, version = $13,
+			updated_at = $14
+		WHERE id = $15 AND company_id = $16 AND version = $17
+	`
+
+	var assigneeID interface{}
+	if s.AssigneeID() != nil {
+		assigneeID = s.AssigneeID().UUID()
+	}
+
+	result, err := tx.Exec(ctx, query,
+		assigneeID,
+		s.Rule().String(),
+		s.TimeZone().String(),
+		s.StartsAt(),
+		s.LastOccurrenceAt(),
+		s.Occurrences(),
+		s.Ended(),
+		s.Title(),
+		s.Description(),
+		s.Visibility().String(),
+		teamID(s.TeamID()),
+		s.Priority().String(),
+		s.Version(),
+		s.UpdatedAt(),
+		s.ID().UUID(),
+		s.CompanyID().UUID(),
+		expectedVersion,
+	)
+	if violatesConstraint(err, "task_series_team_fkey") {
+		return false, apperr.NewErrInvalidInput("team_id", "team not found")
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+func (r *TaskSeriesRepo) versionMismatch(ctx context.Context, s *task.Series, expectedVersion int) error {
+	existing, err := r.FindByIDForCompany(ctx, s.ID(), s.CompanyID())
+	if err != nil {
+		return err
+	}
+	return apperr.NewErrVersionMismatch(expectedVersion, existing.Version())
+}
+
+func (r *TaskSeriesRepo) ListDue(ctx context.Context, now time.Time, limit int) ([]*task.Series, error) {
+	query := `
+		SELECT ` + seriesColumns + `
+		FROM task_series
+		WHERE NOT ended AND last_occurrence_at <= $1
+		ORDER BY last_occurrence_at, id
+		LIMIT $2
+	`
+
+	rows, err := r.client.pool.Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var series []*task.Series
+
+	for rows.Next() {
+		var sr seriesRow
+		if err := rows.Scan(sr.dest()...); err != nil {
+			return nil, err
+		}
+
+		s, err := sr.toSeries()
+		if err != nil {
+			return nil, err
+		}
+		series = append(series, s)
+	}
+
+	return series, rows.Err()
+}
+
+func (r *TaskSeriesRepo) FindInstance(ctx context.Context, companyID id.CompanyID, seriesID id.SeriesID, occurrence int) (*task.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `, ` + taskDerivedColumns + `
+		FROM tasks
+		WHERE series_id = $1 AND series_occurrence = $2 AND company_id = $3
+	`
+
+	var tr taskRow
+	err := r.client.pool.QueryRow(ctx, query, seriesID.UUID(), occurrence, companyID.UUID()).Scan(tr.dest()...)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NewErrNotFound("task", seriesID.String())
+		}
+		return nil, err
+	}
+
+	return tr.toTask()
+}
+
+func (r *TaskSeriesRepo) ListOpenInstances(ctx context.Context, companyID id.CompanyID, seriesID id.SeriesID) ([]*task.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `, ` + taskDerivedColumns + `
+		FROM tasks
+		WHERE series_id = $1 AND company_id = $2 AND ` + statusCategory("tasks.") + ` <> 'done' AND deleted_at IS NULL
+		ORDER BY series_occurrence
+	`
+
+	rows, err := r.client.pool.Query(ctx, query, seriesID.UUID(), companyID.UUID())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanTasks(rows)
+}
+
+type seriesRow struct {
+	id               string
+	companyID        string
+	creatorID        string
+	assigneeID       *string
+	rule             string
+	timeZone         string
+	startsAt         time.Time
+	lastOccurrenceAt time.Time
+	occurrences      int
+	ended            bool
+	title            string
+	description      *string
+	visibility       string
+	teamID           *string
+	priority         string
+	version          int
+	createdAt        time.Time
+	updatedAt        time.Time
+}
+
+func (sr *seriesRow) dest() []interface{} {
+	return []interface{}{
+		&sr.id, &sr.companyID, &sr.creatorID, &sr.assigneeID, &sr.rule, &sr.timeZone, &sr.startsAt, &sr.lastOccurrenceAt,
+		&sr.occurrences, &sr.ended, &sr.title, &sr.description, &sr.visibility, &sr.teamID, &sr.priority, &sr.version, &sr.createdAt, &sr.updatedAt,
+	}
+}
+
+func (sr *seriesRow) toSeries() (*task.Series, error) {
+	rule, err := recurrence.Parse(sr.rule)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(sr.timeZone)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedID, _ := id.ParseSeriesID(sr.id)
+	parsedCompanyID, _ := id.ParseCompanyID(sr.companyID)
+	parsedCreatorID, _ := id.ParseUserID(sr.creatorID)
+
+	var parsedAssigneeID *id.UserID
+	if sr.assigneeID != nil {
+		aid, _ := id.ParseUserID(*sr.assigneeID)
+		parsedAssigneeID = &aid
+	}
+
+	var parsedTeamID *id.TeamID
+	if sr.teamID != nil {
+		tid, _ := id.ParseTeamID(*sr.teamID)
+		parsedTeamID = &tid
+	}
+
+	parsedVisibility, _ := task.ParseVisibility(sr.visibility)
+	parsedPriority, _ := task.ParsePriority(sr.priority)
+
+	return task.NewSeriesBuilder().
+		ID(parsedID).
+		CompanyID(parsedCompanyID).
+		CreatorID(parsedCreatorID).
+		AssigneeID(parsedAssigneeID).
+		Rule(rule).
+		TimeZone(loc).
+		StartsAt(sr.startsAt).
+		LastOccurrenceAt(sr.lastOccurrenceAt).
+		Occurrences(sr.occurrences).
+		Ended(sr.ended).
+		Title(sr.title).
+		Description(sr.description).
+		Visibility(parsedVisibility).
+		TeamID(parsedTeamID).
+		Priority(parsedPriority).
+		Version(sr.version).
+		CreatedAt(sr.createdAt).
+		UpdatedAt(sr.updatedAt).
+		Build()
+}
+
+var _ task.SeriesRepo = (*TaskSeriesRepo)(nil)
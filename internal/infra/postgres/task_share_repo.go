@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+type TaskShareRepo struct {
+	client *Client
+}
+
+func NewTaskShareRepo(client *Client) *TaskShareRepo {
+	return &TaskShareRepo{client: client}
+}
+
+func (r *TaskShareRepo) Save(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, s task.Share) error {
+	query := `
+		INSERT INTO task_shares (task_id, company_id, user_id, role)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (task_id, user_id) DO UPDATE SET role = EXCLUDED.role
+	`
+
+	_, err := r.client.pool.Exec(ctx, query, taskID.UUID(), companyID.UUID(), s.UserID.UUID(), s.Role.String())
+	if violatesConstraint(err, "task_shares_user_id_fkey") {
+		return apperr.NewErrNotFound("user", s.UserID.String())
+	}
+	if isForeignKeyViolation(err) {
+		return apperr.NewErrNotFound("task", taskID.String())
+	}
+	return err
+}
+
+func (r *TaskShareRepo) Delete(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, userID id.UserID) error {
+	query := `DELETE FROM task_shares WHERE task_id = $1 AND company_id = $2 AND user_id = $3`
+
+	result, err := r.client.pool.Exec(ctx, query, taskID.UUID(), companyID.UUID(), userID.UUID())
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return apperr.NewErrNotFound("task share", userID.String())
+	}
+
+	return nil
+}
+
+var _ task.ShareRepo = (*TaskShareRepo)(nil)
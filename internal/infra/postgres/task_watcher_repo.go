@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type TaskWatcherRepo struct {
+	client *Client
+}
+
+func NewTaskWatcherRepo(client *Client) *TaskWatcherRepo {
+	return &TaskWatcherRepo{client: client}
+}
+
+func (r *TaskWatcherRepo) Add(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, userIDs []id.UserID) error {
+	query := `
+		INSERT INTO task_watchers (task_id, company_id, user_id)
+		SELECT $1::uuid, $2::uuid, unnest($3::uuid[])
+		ON CONFLICT DO NOTHING
+	`
+
+	_, err := r.client.pool.Exec(ctx, query, taskID.UUID(), companyID.UUID(), userUUIDs(userIDs))
+	if isForeignKeyViolation(err) {
+		return apperr.NewErrNotFound("task", taskID.String())
+	}
+	return err
+}
+
+func (r *TaskWatcherRepo) Remove(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, userIDs []id.UserID) error {
+	query := `
+		DELETE FROM task_watchers
+		WHERE task_id = $1 AND company_id = $2 AND user_id = ANY($3)
+	`
+
+	_, err := r.client.pool.Exec(ctx, query, taskID.UUID(), companyID.UUID(), userUUIDs(userIDs))
+	return err
+}
+
+func (r *TaskWatcherRepo) ListByTask(ctx context.Context, companyID id.CompanyID, taskID id.TaskID) ([]*user.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM task_watchers w
+		JOIN users u ON u.id = w.user_id
+		WHERE w.task_id = $1 AND w.company_id = $2
+		ORDER BY w.created_at, u.id
+	`
+
+	rows, err := r.client.pool.Query(ctx, query, taskID.UUID(), companyID.UUID())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var watchers []*user.User
+
+	for rows.Next() {
+		u, err := scanUser(rows, "")
+		if err != nil {
+			return nil, err
+		}
+		watchers = append(watchers, u)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return watchers, nil
+}
+
+func (r *TaskWatcherRepo) ListTeamTasks(ctx context.Context, companyID id.CompanyID, teamID id.TeamID, userID id.UserID) ([]*task.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `, ` + taskDerivedColumns + `
+		FROM tasks
+		WHERE company_id = $1 AND visibility = 'team' AND team_id = $2
+			AND id IN (SELECT task_id FROM task_watchers WHERE user_id = $3)
+		ORDER BY created_at, id
+	`
+
+	rows, err := r.client.pool.Query(ctx, query, companyID.UUID(), teamID.UUID(), userID.UUID())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanTasks(rows)
+}
+
+func userUUIDs(userIDs []id.UserID) []uuid.UUID {
+	uuids := make([]uuid.UUID, len(userIDs))
+	for i, userID := range userIDs {
+		uuids[i] = userID.UUID()
+	}
+	return uuids
+}
+
+var _ task.WatcherRepo = (*TaskWatcherRepo)(nil)
@@ -0,0 +1,219 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/team"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type TeamRepo struct {
+	client *Client
+}
+
+func NewTeamRepo(client *Client) *TeamRepo {
+	return &TeamRepo{client: client}
+}
+
+func (r *TeamRepo) Create(ctx context.Context, t *team.Team) error {
+	query := `
+		INSERT INTO teams (id, company_id, name, created_at)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	_, err := r.client.pool.Exec(ctx, query, t.ID().UUID(), t.CompanyID().UUID(), t.Name(), t.CreatedAt())
+	if isUniqueViolation(err) {
+		return apperr.NewErrAlreadyExists("team", "name is already used in this company")
+	}
+	return err
+}
+
+func (r *TeamRepo) FindByIDForCompany(ctx context.Context, teamID id.TeamID, companyID id.CompanyID) (*team.Team, error) {
+	query := `
+		SELECT id, company_id, name, created_at
+		FROM teams
+		WHERE id = $1 AND company_id = $2
+	`
+
+	t, err := r.scanTeam(r.client.pool.QueryRow(ctx, query, teamID.UUID(), companyID.UUID()))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperr.NewErrNotFound("team", teamID.String())
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *TeamRepo) ListByCompany(ctx context.Context, companyID id.CompanyID) ([]*team.Team, error) {
+	query := `
+		SELECT id, company_id, name, created_at
+		FROM teams
+		WHERE company_id = $1
+		ORDER BY lower(name)
+	`
+
+	rows, err := r.client.pool.Query(ctx, query, companyID.UUID())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teams []*team.Team
+	for rows.Next() {
+		t, err := r.scanTeam(rows)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, t)
+	}
+	return teams, rows.Err()
+}
+
+func (r *TeamRepo) Update(ctx context.Context, t *team.Team) error {
+	query := `
+		UPDATE teams
+		SET name = $1
+		WHERE id = $2 AND company_id = $3
+	`
+
+	result, err := r.client.pool.Exec(ctx, query, t.Name(), t.ID().UUID(), t.CompanyID().UUID())
+	if err != nil {
+		if isUniqueViolation(err) {
+			return apperr.NewErrAlreadyExists("team", "name is already used in this company")
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return apperr.NewErrNotFound("team", t.ID().String())
+	}
+
+	return nil
+}
+
+func (r *TeamRepo) Delete(ctx context.Context, teamID id.TeamID, companyID id.CompanyID) error {
+	query := `DELETE FROM teams WHERE id = $1 AND company_id = $2`
+
+	result, err := r.client.pool.Exec(ctx, query, teamID.UUID(), companyID.UUID())
+	if err != nil {
+		if violatesConstraint(err, "tasks_team_fkey") || violatesConstraint(err, "task_series_team_fkey") {
+			return apperr.NewErrFailedPrecondition("delete", "team", "team is still used by tasks")
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return apperr.NewErrNotFound("team", teamID.String())
+	}
+
+	return nil
+}
+
+// AddMember only adds users of the team's company.
+func (r *TeamRepo) AddMember(ctx context.Context, companyID id.CompanyID, teamID id.TeamID, userID id.UserID) error {
+	query := `
+		INSERT INTO team_members (team_id, company_id, user_id)
+		SELECT $1::uuid, company_id, id FROM users WHERE id = $2 AND company_id = $3
+		ON CONFLICT (team_id, user_id) DO NOTHING
+	`
+
+	result, err := r.client.pool.Exec(ctx, query, teamID.UUID(), userID.UUID(), companyID.UUID())
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return apperr.NewErrNotFound("team", teamID.String())
+		}
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return r.requireMember(ctx, teamID, userID)
+	}
+
+	return nil
+}
+
+// requireMember tells an existing membership apart from a user outside the
+// company after an insert that changed nothing.
+func (r *TeamRepo) requireMember(ctx context.Context, teamID id.TeamID, userID id.UserID) error {
+	var exists bool
+	err := r.client.pool.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM team_members WHERE team_id = $1 AND user_id = $2)`,
+		teamID.UUID(), userID.UUID(),
+	).Scan(&exists)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return apperr.NewErrNotFound("user", userID.String())
+	}
+	return nil
+}
+
+func (r *TeamRepo) RemoveMember(ctx context.Context, companyID id.CompanyID, teamID id.TeamID, userID id.UserID) error {
+	query := `DELETE FROM team_members WHERE team_id = $1 AND user_id = $2 AND company_id = $3`
+
+	result, err := r.client.pool.Exec(ctx, query, teamID.UUID(), userID.UUID(), companyID.UUID())
+	if err != nil {
+		return err
+	}
+
+	if result.RowsAffected() == 0 {
+		return apperr.NewErrNotFound("team member", userID.String())
+	}
+
+	return nil
+}
+
+func (r *TeamRepo) ListMembers(ctx context.Context, companyID id.CompanyID, teamID id.TeamID) ([]*user.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM team_members m
+		JOIN users u ON u.id = m.user_id
+		WHERE m.team_id = $1 AND m.company_id = $2
+		ORDER BY lower(u.email), u.id
+	`
+
+	rows, err := r.client.pool.Query(ctx, query, teamID.UUID(), companyID.UUID())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*user.User
+	for rows.Next() {
+		u, err := scanUser(rows, "")
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, rows.Err()
+}
+
+func (r *TeamRepo) scanTeam(row pgx.Row) (*team.Team, error) {
+	var dbID, dbCompanyID, name string
+	var createdAt time.Time
+
+	if err := row.Scan(&dbID, &dbCompanyID, &name, &createdAt); err != nil {
+		return nil, err
+	}
+
+	parsedID, _ := id.ParseTeamID(dbID)
+	parsedCompanyID, _ := id.ParseCompanyID(dbCompanyID)
+
+	return team.NewBuilder().
+		ID(parsedID).
+		CompanyID(parsedCompanyID).
+		Name(name).
+		CreatedAt(createdAt).
+		Build()
+}
+
+var _ team.Repo = (*TeamRepo)(nil)
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 
@@ -11,6 +12,10 @@ import (
 	"github.com/pyshx/todoapp/pkg/user"
 )
 
+// userColumns expects the users table to be aliased as u.
+const userColumns = `u.id, u.company_id, u.email, u.role, u.created_at, u.deactivated_at,
+	ARRAY(SELECT m.team_id::text FROM team_members m WHERE m.user_id = u.id ORDER BY m.team_id) AS team_ids`
+
 type UserRepo struct {
 	client *Client
 }
@@ -21,21 +26,75 @@ func NewUserRepo(client *Client) *UserRepo {
 
 func (r *UserRepo) FindByID(ctx context.Context, userID id.UserID) (*user.User, error) {
 	query := `
-		SELECT id, company_id, email, role, created_at
-		FROM users
-		WHERE id = $1
+		SELECT ` + userColumns + `
+		FROM users u
+		WHERE u.id = $1
 	`
 
-	row := r.client.pool.QueryRow(ctx, query, userID.UUID())
+	return scanUser(r.client.pool.QueryRow(ctx, query, userID.UUID()), userID.String())
+}
+
+func (r *UserRepo) FindByEmailForCompany(ctx context.Context, email string, companyID id.CompanyID) (*user.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM users u
+		WHERE lower(u.email) = lower($1) AND u.company_id = $2
+	`
+
+	return scanUser(r.client.pool.QueryRow(ctx, query, email, companyID.UUID()), email)
+}
 
+func (r *UserRepo) ListByCompany(ctx context.Context, companyID id.CompanyID, includeDeactivated bool) ([]*user.User, error) {
+	query := `
+		SELECT ` + userColumns + `
+		FROM users u
+		WHERE u.company_id = $1 AND ($2 OR u.deactivated_at IS NULL)
+		ORDER BY lower(u.email), u.id
+	`
+
+	rows, err := r.client.pool.Query(ctx, query, companyID.UUID(), includeDeactivated)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []*user.User
+	for rows.Next() {
+		u, err := scanUser(rows, "")
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, rows.Err()
+}
+
+func (r *UserRepo) Update(ctx context.Context, u *user.User) error {
+	tag, err := r.client.pool.Exec(ctx, `
+		UPDATE users
+		SET role = $1, deactivated_at = $2
+		WHERE id = $3 AND company_id = $4
+	`, u.Role().String(), u.DeactivatedAt(), u.ID().UUID(), u.CompanyID().UUID())
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return apperr.NewErrNotFound("user", u.ID().String())
+	}
+	return nil
+}
+
+func scanUser(row pgx.Row, key string) (*user.User, error) {
 	var dbID, dbCompanyID string
 	var email, role string
-	var createdAt interface{}
+	var createdAt time.Time
+	var deactivatedAt *time.Time
+	var dbTeamIDs []string
 
-	err := row.Scan(&dbID, &dbCompanyID, &email, &role, &createdAt)
+	err := row.Scan(&dbID, &dbCompanyID, &email, &role, &createdAt, &deactivatedAt, &dbTeamIDs)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, apperr.NewErrNotFound("user", userID.String())
+			return nil, apperr.NewErrNotFound("user", key)
 		}
 		return nil, err
 	}
@@ -48,11 +107,20 @@ func (r *UserRepo) FindByID(ctx context.Context, userID id.UserID) (*user.User,
 	companyID, _ := id.ParseCompanyID(dbCompanyID)
 	parsedID, _ := id.ParseUserID(dbID)
 
+	teamIDs := make([]id.TeamID, 0, len(dbTeamIDs))
+	for _, t := range dbTeamIDs {
+		tid, _ := id.ParseTeamID(t)
+		teamIDs = append(teamIDs, tid)
+	}
+
 	u, err := user.NewBuilder().
 		ID(parsedID).
 		CompanyID(companyID).
 		Email(email).
 		Role(parsedRole).
+		CreatedAt(createdAt).
+		DeactivatedAt(deactivatedAt).
+		TeamIDs(teamIDs).
 		Build()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,132 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+type WorkflowRepo struct {
+	client *Client
+}
+
+func NewWorkflowRepo(client *Client) *WorkflowRepo {
+	return &WorkflowRepo{client: client}
+}
+
+func (r *WorkflowRepo) FindByCompany(ctx context.Context, companyID id.CompanyID) (*task.Workflow, error) {
+	rows, err := r.client.pool.Query(ctx, `
+		SELECT key, name, category
+		FROM workflow_statuses
+		WHERE company_id = $1
+		ORDER BY position
+	`, companyID.UUID())
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []task.WorkflowStatus
+	for rows.Next() {
+		var key, name, category string
+		if err := rows.Scan(&key, &name, &category); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		parsedCategory, _ := task.ParseCategory(category)
+		statuses = append(statuses, task.WorkflowStatus{Key: task.Status(key), Name: name, Category: parsedCategory})
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(statuses) == 0 {
+		return nil, apperr.NewErrNotFound("workflow", companyID.String())
+	}
+
+	rows, err = r.client.pool.Query(ctx, `
+		SELECT t.from_status, t.to_status
+		FROM workflow_transitions t
+		JOIN workflow_statuses f ON f.company_id = t.company_id AND f.key = t.from_status
+		JOIN workflow_statuses s ON s.company_id = t.company_id AND s.key = t.to_status
+		WHERE t.company_id = $1
+		ORDER BY f.position, s.position
+	`, companyID.UUID())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var transitions []task.Transition
+	for rows.Next() {
+		var from, to string
+		if err := rows.Scan(&from, &to); err != nil {
+			return nil, err
+		}
+		transitions = append(transitions, task.Transition{From: task.Status(from), To: task.Status(to)})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return task.NewWorkflow(companyID, statuses, transitions)
+}
+
+// Save upserts the statuses before deleting the ones that were dropped, so
+// tasks keep pointing at statuses that survive. Dropping a status that
+// tasks still use rolls the whole save back.
+func (r *WorkflowRepo) Save(ctx context.Context, w *task.Workflow) error {
+	err := pgx.BeginFunc(ctx, r.client.pool, func(tx pgx.Tx) error {
+		return saveWorkflow(ctx, tx, w)
+	})
+	if violatesConstraint(err, "tasks_status_fkey") {
+		return apperr.NewErrFailedPrecondition("save", "workflow", "a removed status is still used by tasks")
+	}
+	return err
+}
+
+// saveWorkflow writes w inside tx.
+func saveWorkflow(ctx context.Context, tx pgx.Tx, w *task.Workflow) error {
+	companyID := w.CompanyID().UUID()
+
+	keys := make([]string, len(w.Statuses()))
+	for i, s := range w.Statuses() {
+		keys[i] = s.Key.String()
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO workflow_statuses (company_id, key, name, category, position)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (company_id, key) DO UPDATE
+			SET name = EXCLUDED.name, category = EXCLUDED.category, position = EXCLUDED.position
+		`, companyID, s.Key.String(), s.Name, s.Category.String(), i); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM workflow_transitions WHERE company_id = $1`, companyID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM workflow_statuses
+		WHERE company_id = $1 AND NOT (key = ANY($2))
+	`, companyID, keys); err != nil {
+		return err
+	}
+
+	for _, t := range w.Transitions() {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO workflow_transitions (company_id, from_status, to_status)
+			VALUES ($1, $2, $3)
+		`, companyID, t.From.String(), t.To.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ task.WorkflowRepo = (*WorkflowRepo)(nil)
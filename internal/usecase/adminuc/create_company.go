@@ -0,0 +1,78 @@
+package adminuc
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/company"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type CreateCompanyInput struct {
+	Name       string
+	OwnerEmail string
+}
+
+type CreateCompany struct {
+	CompanyRepo company.Repo
+}
+
+func NewCreateCompany(companyRepo company.Repo) *CreateCompany {
+	return &CreateCompany{CompanyRepo: companyRepo}
+}
+
+// Execute provisions a tenant: the company, its first owner and the default
+// workflow are stored together.
+func (uc *CreateCompany) Execute(ctx context.Context, input CreateCompanyInput) (*company.Company, *user.User, error) {
+	name := strings.TrimSpace(input.Name)
+	if err := validateName(name); err != nil {
+		return nil, nil, err
+	}
+
+	email := strings.TrimSpace(input.OwnerEmail)
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return nil, nil, apperr.NewErrInvalidInput("owner_email", "must be an email address")
+	}
+
+	now := time.Now()
+	c, err := company.NewBuilder().
+		ID(id.NewCompanyID()).
+		Name(name).
+		CreatedAt(now).
+		Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	owner, err := user.NewBuilder().
+		ID(id.NewUserID()).
+		CompanyID(c.ID()).
+		Email(email).
+		Role(user.RoleOwner).
+		CreatedAt(now).
+		Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := uc.CompanyRepo.Create(ctx, c, owner, task.DefaultWorkflow(c.ID())); err != nil {
+		return nil, nil, err
+	}
+
+	return c, owner, nil
+}
+
+func validateName(name string) error {
+	if name == "" {
+		return apperr.NewErrInvalidInput("name", "cannot be empty")
+	}
+	if len(name) > company.MaxNameLength {
+		return apperr.NewErrInvalidInput("name", "must be at most 200 characters")
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package adminuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/company"
+)
+
+type ListCompanies struct {
+	CompanyRepo company.Repo
+}
+
+func NewListCompanies(companyRepo company.Repo) *ListCompanies {
+	return &ListCompanies{CompanyRepo: companyRepo}
+}
+
+// Execute returns every company, suspended ones included.
+func (uc *ListCompanies) Execute(ctx context.Context) ([]*company.Company, error) {
+	return uc.CompanyRepo.List(ctx)
+}
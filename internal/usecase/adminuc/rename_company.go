@@ -0,0 +1,42 @@
+package adminuc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/company"
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+type RenameCompanyInput struct {
+	CompanyID id.CompanyID
+	Name      string
+}
+
+type RenameCompany struct {
+	CompanyRepo company.Repo
+}
+
+func NewRenameCompany(companyRepo company.Repo) *RenameCompany {
+	return &RenameCompany{CompanyRepo: companyRepo}
+}
+
+func (uc *RenameCompany) Execute(ctx context.Context, input RenameCompanyInput) (*company.Company, error) {
+	name := strings.TrimSpace(input.Name)
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.CompanyRepo.FindByID(ctx, input.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := existing.ApplyUpdate(company.Update{Name: &name}, time.Now())
+	if err := uc.CompanyRepo.Update(ctx, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,34 @@
+package adminuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/company"
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+type SuspendCompany struct {
+	CompanyRepo company.Repo
+}
+
+func NewSuspendCompany(companyRepo company.Repo) *SuspendCompany {
+	return &SuspendCompany{CompanyRepo: companyRepo}
+}
+
+// Execute blocks every user of the company from signing in. Suspending a
+// suspended company keeps the original suspension time.
+func (uc *SuspendCompany) Execute(ctx context.Context, companyID id.CompanyID) (*company.Company, error) {
+	existing, err := uc.CompanyRepo.FindByID(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	suspended := true
+	updated := existing.ApplyUpdate(company.Update{Suspended: &suspended}, time.Now())
+	if err := uc.CompanyRepo.Update(ctx, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
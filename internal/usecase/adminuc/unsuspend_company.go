@@ -0,0 +1,33 @@
+package adminuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/company"
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+type UnsuspendCompany struct {
+	CompanyRepo company.Repo
+}
+
+func NewUnsuspendCompany(companyRepo company.Repo) *UnsuspendCompany {
+	return &UnsuspendCompany{CompanyRepo: companyRepo}
+}
+
+// Execute lets the company's users sign in again.
+func (uc *UnsuspendCompany) Execute(ctx context.Context, companyID id.CompanyID) (*company.Company, error) {
+	existing, err := uc.CompanyRepo.FindByID(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	suspended := false
+	updated := existing.ApplyUpdate(company.Update{Suspended: &suspended}, time.Now())
+	if err := uc.CompanyRepo.Update(ctx, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
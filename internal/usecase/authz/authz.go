@@ -0,0 +1,16 @@
+// Package authz holds the permission checks shared by the use cases.
+package authz
+
+import (
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+// Require fails with permission denied unless the actor's role grants p.
+// action and resource describe what was refused.
+func Require(actor *user.User, p user.Permission, action, resource string) error {
+	if actor.Can(p) {
+		return nil
+	}
+	return apperr.NewErrPermissionDenied(action, resource, actor.Role().String()+" role cannot "+p.String())
+}
@@ -0,0 +1,117 @@
+package commentuc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/usecase/mentionuc"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/comment"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/mention"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type CreateCommentInput struct {
+	TaskID   id.TaskID
+	ParentID *id.CommentID
+	Body     string
+}
+
+type CreateComment struct {
+	CommentRepo    comment.Repo
+	TaskRepo       task.Repo
+	RecordMentions *mentionuc.RecordMentions
+}
+
+func NewCreateComment(commentRepo comment.Repo, taskRepo task.Repo, recordMentions *mentionuc.RecordMentions) *CreateComment {
+	return &CreateComment{
+		CommentRepo:    commentRepo,
+		TaskRepo:       taskRepo,
+		RecordMentions: recordMentions,
+	}
+}
+
+// Execute adds a comment. Any company member who can see the task may
+// comment, viewers included.
+func (uc *CreateComment) Execute(ctx context.Context, actor *user.User, input CreateCommentInput) (*comment.Comment, error) {
+	t, err := visibleTask(ctx, uc.TaskRepo, actor, input.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	body := strings.TrimSpace(input.Body)
+	if err := validateBody(body); err != nil {
+		return nil, err
+	}
+
+	mentioned, err := uc.RecordMentions.Resolve(ctx, actor, t, "body", body)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ParentID != nil {
+		parent, err := uc.CommentRepo.FindByIDForCompany(ctx, *input.ParentID, actor.CompanyID())
+		if err != nil && !apperr.IsNotFound(err) {
+			return nil, err
+		}
+		if parent == nil || !parent.TaskID().Equal(input.TaskID) {
+			return nil, apperr.NewErrInvalidInput("parent_id", "comment not found on this task")
+		}
+	}
+
+	c, err := comment.NewBuilder().
+		ID(id.NewCommentID()).
+		CompanyID(actor.CompanyID()).
+		TaskID(input.TaskID).
+		AuthorID(actor.ID()).
+		ParentID(input.ParentID).
+		Body(body).
+		CreatedAt(time.Now()).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.CommentRepo.Create(ctx, c); err != nil {
+		return nil, err
+	}
+
+	if err := uc.RecordMentions.Execute(ctx, actor, commentSource(c), mentioned); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func validateBody(body string) error {
+	if body == "" {
+		return apperr.NewErrInvalidInput("body", "cannot be empty")
+	}
+	if len(body) > comment.MaxBodyLength {
+		return apperr.NewErrInvalidInput("body", "must be at most 10000 characters")
+	}
+	return nil
+}
+
+func commentSource(c *comment.Comment) mention.Source {
+	commentID := c.ID()
+	return mention.Source{CompanyID: c.CompanyID(), TaskID: c.TaskID(), CommentID: &commentID}
+}
+
+// visibleTask loads the task a comment belongs to; comments are readable and
+// writable only by users who can see that task.
+func visibleTask(ctx context.Context, taskRepo task.Repo, actor *user.User, taskID id.TaskID) (*task.Task, error) {
+	t, err := taskRepo.FindByIDForCompany(ctx, taskID, actor.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+
+	if !t.CanBeViewedBy(actor) {
+		return nil, apperr.NewErrPermissionDenied("view", "task", "task is not visible to you")
+	}
+
+	return t, nil
+}
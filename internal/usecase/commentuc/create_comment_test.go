@@ -0,0 +1,157 @@
+package commentuc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pyshx/todoapp/internal/usecase/commentuc"
+	"github.com/pyshx/todoapp/internal/usecase/mentionuc"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/comment"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/mention"
+	"github.com/pyshx/todoapp/pkg/notification"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+// mockTaskRepo only serves task lookups; other task.Repo methods are not
+// used by comment use cases.
+type mockTaskRepo struct {
+	task.Repo
+	tasks map[id.TaskID]*task.Task
+}
+
+func (m *mockTaskRepo) FindByIDForCompany(ctx context.Context, taskID id.TaskID, companyID id.CompanyID) (*task.Task, error) {
+	if t, ok := m.tasks[taskID]; ok && t.CompanyID().Equal(companyID) {
+		return t, nil
+	}
+	return nil, apperr.NewErrNotFound("task", taskID.String())
+}
+
+// mockCommentRepo is a simple mock for comment.Repo
+type mockCommentRepo struct {
+	comments map[id.CommentID]*comment.Comment
+}
+
+func (m *mockCommentRepo) Create(ctx context.Context, c *comment.Comment) error {
+	m.comments[c.ID()] = c
+	return nil
+}
+
+func (m *mockCommentRepo) FindByIDForCompany(ctx context.Context, commentID id.CommentID, companyID id.CompanyID) (*comment.Comment, error) {
+	if c, ok := m.comments[commentID]; ok && c.CompanyID().Equal(companyID) {
+		return c, nil
+	}
+	return nil, apperr.NewErrNotFound("comment", commentID.String())
+}
+
+func (m *mockCommentRepo) ListByTask(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, opts comment.ListOptions) (*comment.ListResult, error) {
+	return &comment.ListResult{}, nil
+}
+
+func (m *mockCommentRepo) Update(ctx context.Context, c *comment.Comment) error {
+	m.comments[c.ID()] = c
+	return nil
+}
+
+func (m *mockCommentRepo) Delete(ctx context.Context, commentID id.CommentID, companyID id.CompanyID) error {
+	delete(m.comments, commentID)
+	return nil
+}
+
+// mockMentionRepo reports every mention as new
+type mockMentionRepo struct{}
+
+func (m *mockMentionRepo) Replace(ctx context.Context, source mention.Source, userIDs []id.UserID) ([]id.UserID, error) {
+	return userIDs, nil
+}
+
+// mockNotificationRepo discards notifications
+type mockNotificationRepo struct {
+	notification.Repo
+}
+
+func (m *mockNotificationRepo) Create(ctx context.Context, notifications []*notification.Notification) error {
+	return nil
+}
+
+func TestCommentPermissions(t *testing.T) {
+	ctx := context.Background()
+	companyID := id.NewCompanyID()
+
+	newUser := func(role user.Role) *user.User {
+		return user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email("u@test.com").Role(role).MustBuild()
+	}
+	editor := newUser(user.RoleEditor)
+	viewer := newUser(user.RoleViewer)
+	otherViewer := newUser(user.RoleViewer)
+
+	newTask := func(visibility task.Visibility) *task.Task {
+		return task.NewBuilder().
+			ID(id.NewTaskID()).
+			CompanyID(companyID).
+			CreatorID(editor.ID()).
+			Title("Task").
+			Visibility(visibility).
+			MustBuild()
+	}
+	shared := newTask(task.VisibilityCompanyWide)
+	private := newTask(task.VisibilityOnlyMe)
+	other := newTask(task.VisibilityCompanyWide)
+
+	taskRepo := &mockTaskRepo{tasks: map[id.TaskID]*task.Task{
+		shared.ID():  shared,
+		private.ID(): private,
+		other.ID():   other,
+	}}
+	commentRepo := &mockCommentRepo{comments: make(map[id.CommentID]*comment.Comment)}
+
+	// The comments below mention nobody, so users are never looked up.
+	recordMentions := mentionuc.NewRecordMentions(nil, &mockMentionRepo{}, &mockNotificationRepo{})
+
+	create := commentuc.NewCreateComment(commentRepo, taskRepo, recordMentions)
+	edit := commentuc.NewEditComment(commentRepo, taskRepo, recordMentions)
+	del := commentuc.NewDeleteComment(commentRepo, taskRepo)
+
+	c, err := create.Execute(ctx, viewer, commentuc.CreateCommentInput{TaskID: shared.ID(), Body: "  Question  "})
+	if err != nil {
+		t.Fatalf("viewer should be able to comment on a visible task: %v", err)
+	}
+	if c.Body() != "Question" {
+		t.Errorf("Body() = %q, want trimmed body", c.Body())
+	}
+
+	if _, err := create.Execute(ctx, viewer, commentuc.CreateCommentInput{TaskID: private.ID(), Body: "Hi"}); !apperr.IsPermissionDenied(err) {
+		t.Errorf("expected permission denied on a hidden task, got %v", err)
+	}
+
+	if _, err := create.Execute(ctx, editor, commentuc.CreateCommentInput{TaskID: other.ID(), ParentID: ptr(c.ID()), Body: "Answer"}); !apperr.IsInvalidInput(err) {
+		t.Errorf("expected invalid input for a reply across tasks, got %v", err)
+	}
+
+	if _, err := create.Execute(ctx, editor, commentuc.CreateCommentInput{TaskID: shared.ID(), ParentID: ptr(c.ID()), Body: "Answer"}); err != nil {
+		t.Errorf("unexpected error replying: %v", err)
+	}
+
+	if _, err := edit.Execute(ctx, otherViewer, commentuc.EditCommentInput{CommentID: c.ID(), Body: "Changed"}); !apperr.IsPermissionDenied(err) {
+		t.Errorf("expected permission denied for another viewer, got %v", err)
+	}
+
+	edited, err := edit.Execute(ctx, viewer, commentuc.EditCommentInput{CommentID: c.ID(), Body: "Changed"})
+	if err != nil {
+		t.Fatalf("author should be able to edit: %v", err)
+	}
+	if edited.EditedAt() == nil {
+		t.Error("EditedAt() should be set after an edit")
+	}
+
+	if err := del.Execute(ctx, otherViewer, c.ID()); !apperr.IsPermissionDenied(err) {
+		t.Errorf("expected permission denied deleting as another viewer, got %v", err)
+	}
+	if err := del.Execute(ctx, editor, c.ID()); err != nil {
+		t.Errorf("editor should be able to delete: %v", err)
+	}
+}
+
+func ptr[T any](v T) *T { return &v }
@@ -0,0 +1,42 @@
+package commentuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/comment"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type DeleteComment struct {
+	CommentRepo comment.Repo
+	TaskRepo    task.Repo
+}
+
+func NewDeleteComment(commentRepo comment.Repo, taskRepo task.Repo) *DeleteComment {
+	return &DeleteComment{
+		CommentRepo: commentRepo,
+		TaskRepo:    taskRepo,
+	}
+}
+
+// Execute removes a comment. A comment with replies cannot be deleted until
+// its replies are.
+func (uc *DeleteComment) Execute(ctx context.Context, actor *user.User, commentID id.CommentID) error {
+	existing, err := uc.CommentRepo.FindByIDForCompany(ctx, commentID, actor.CompanyID())
+	if err != nil {
+		return err
+	}
+
+	if _, err := visibleTask(ctx, uc.TaskRepo, actor, existing.TaskID()); err != nil {
+		return err
+	}
+
+	if !existing.CanBeEditedBy(actor) {
+		return apperr.NewErrPermissionDenied("delete", "comment", "only the author or an editor can delete this comment")
+	}
+
+	return uc.CommentRepo.Delete(ctx, commentID, actor.CompanyID())
+}
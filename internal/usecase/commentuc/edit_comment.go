@@ -0,0 +1,71 @@
+package commentuc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/usecase/mentionuc"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/comment"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type EditCommentInput struct {
+	CommentID id.CommentID
+	Body      string
+}
+
+type EditComment struct {
+	CommentRepo    comment.Repo
+	TaskRepo       task.Repo
+	RecordMentions *mentionuc.RecordMentions
+}
+
+func NewEditComment(commentRepo comment.Repo, taskRepo task.Repo, recordMentions *mentionuc.RecordMentions) *EditComment {
+	return &EditComment{
+		CommentRepo:    commentRepo,
+		TaskRepo:       taskRepo,
+		RecordMentions: recordMentions,
+	}
+}
+
+func (uc *EditComment) Execute(ctx context.Context, actor *user.User, input EditCommentInput) (*comment.Comment, error) {
+	existing, err := uc.CommentRepo.FindByIDForCompany(ctx, input.CommentID, actor.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := visibleTask(ctx, uc.TaskRepo, actor, existing.TaskID())
+	if err != nil {
+		return nil, err
+	}
+
+	if !existing.CanBeEditedBy(actor) {
+		return nil, apperr.NewErrPermissionDenied("edit", "comment", "only the author or an editor can edit this comment")
+	}
+
+	body := strings.TrimSpace(input.Body)
+	if err := validateBody(body); err != nil {
+		return nil, err
+	}
+
+	mentioned, err := uc.RecordMentions.Resolve(ctx, actor, t, "body", body)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := existing.ApplyUpdate(comment.Update{Body: &body}, time.Now())
+
+	if err := uc.CommentRepo.Update(ctx, updated); err != nil {
+		return nil, err
+	}
+
+	if err := uc.RecordMentions.Execute(ctx, actor, commentSource(updated), mentioned); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
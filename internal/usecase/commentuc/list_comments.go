@@ -0,0 +1,41 @@
+package commentuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/comment"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type ListCommentsInput struct {
+	TaskID   id.TaskID
+	PageSize int
+	Cursor   *comment.PageCursor
+}
+
+type ListComments struct {
+	CommentRepo comment.Repo
+	TaskRepo    task.Repo
+}
+
+func NewListComments(commentRepo comment.Repo, taskRepo task.Repo) *ListComments {
+	return &ListComments{
+		CommentRepo: commentRepo,
+		TaskRepo:    taskRepo,
+	}
+}
+
+// Execute lists a task's comments oldest first; replies carry their parent
+// ID so that clients can assemble threads.
+func (uc *ListComments) Execute(ctx context.Context, actor *user.User, input ListCommentsInput) (*comment.ListResult, error) {
+	if _, err := visibleTask(ctx, uc.TaskRepo, actor, input.TaskID); err != nil {
+		return nil, err
+	}
+
+	return uc.CommentRepo.ListByTask(ctx, actor.CompanyID(), input.TaskID, comment.ListOptions{
+		PageSize: input.PageSize,
+		Cursor:   input.Cursor,
+	})
+}
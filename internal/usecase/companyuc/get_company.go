@@ -0,0 +1,21 @@
+package companyuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/company"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type GetCompany struct {
+	CompanyRepo company.Repo
+}
+
+func NewGetCompany(companyRepo company.Repo) *GetCompany {
+	return &GetCompany{CompanyRepo: companyRepo}
+}
+
+// Execute returns the actor's own company.
+func (uc *GetCompany) Execute(ctx context.Context, actor *user.User) (*company.Company, error) {
+	return uc.CompanyRepo.FindByID(ctx, actor.CompanyID())
+}
@@ -0,0 +1,21 @@
+package companyuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type GetWorkflow struct {
+	WorkflowRepo task.WorkflowRepo
+}
+
+func NewGetWorkflow(workflowRepo task.WorkflowRepo) *GetWorkflow {
+	return &GetWorkflow{WorkflowRepo: workflowRepo}
+}
+
+// Execute returns the workflow of the actor's company.
+func (uc *GetWorkflow) Execute(ctx context.Context, actor *user.User) (*task.Workflow, error) {
+	return uc.WorkflowRepo.FindByCompany(ctx, actor.CompanyID())
+}
@@ -0,0 +1,47 @@
+package companyuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/company"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type UpdateCompanySettingsInput struct {
+	AutoArchiveAfterDays *int // Zero turns auto-archiving off
+}
+
+type UpdateCompanySettings struct {
+	CompanyRepo company.Repo
+}
+
+func NewUpdateCompanySettings(companyRepo company.Repo) *UpdateCompanySettings {
+	return &UpdateCompanySettings{CompanyRepo: companyRepo}
+}
+
+func (uc *UpdateCompanySettings) Execute(ctx context.Context, actor *user.User, input UpdateCompanySettingsInput) (*company.Company, error) {
+	if err := authz.Require(actor, user.PermissionManageCompany, "update", "company"); err != nil {
+		return nil, err
+	}
+
+	if days := input.AutoArchiveAfterDays; days != nil && (*days < 0 || *days > company.MaxAutoArchiveAfterDays) {
+		return nil, apperr.NewErrInvalidInput("auto_archive_after_days", "must be between 0 and 3650")
+	}
+
+	existing, err := uc.CompanyRepo.FindByID(ctx, actor.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+
+	updated := existing.ApplyUpdate(company.Update{
+		AutoArchiveAfterDays: input.AutoArchiveAfterDays,
+	}, time.Now())
+	if err := uc.CompanyRepo.Update(ctx, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
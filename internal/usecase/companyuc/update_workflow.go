@@ -0,0 +1,44 @@
+package companyuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+// UpdateWorkflowInput replaces the whole workflow. Statuses are listed in
+// display order.
+type UpdateWorkflowInput struct {
+	Statuses    []task.WorkflowStatus
+	Transitions []task.Transition
+}
+
+type UpdateWorkflow struct {
+	WorkflowRepo task.WorkflowRepo
+}
+
+func NewUpdateWorkflow(workflowRepo task.WorkflowRepo) *UpdateWorkflow {
+	return &UpdateWorkflow{WorkflowRepo: workflowRepo}
+}
+
+// Execute fails with a failed precondition when it would remove a status
+// that tasks are still in; move those tasks first.
+func (uc *UpdateWorkflow) Execute(ctx context.Context, actor *user.User, input UpdateWorkflowInput) (*task.Workflow, error) {
+	if err := authz.Require(actor, user.PermissionManageCompany, "update", "workflow"); err != nil {
+		return nil, err
+	}
+
+	w, err := task.NewWorkflow(actor.CompanyID(), input.Statuses, input.Transitions)
+	if err != nil {
+		return nil, apperr.NewErrInvalidInput("workflow", err.Error())
+	}
+
+	if err := uc.WorkflowRepo.Save(ctx, w); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
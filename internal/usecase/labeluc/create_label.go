@@ -0,0 +1,68 @@
+package labeluc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/label"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type CreateLabelInput struct {
+	Name  string
+	Color string
+}
+
+type CreateLabel struct {
+	LabelRepo label.Repo
+}
+
+func NewCreateLabel(labelRepo label.Repo) *CreateLabel {
+	return &CreateLabel{LabelRepo: labelRepo}
+}
+
+func (uc *CreateLabel) Execute(ctx context.Context, actor *user.User, input CreateLabelInput) (*label.Label, error) {
+	if err := authz.Require(actor, user.PermissionEditTasks, "create", "label"); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	if !label.IsValidColor(input.Color) {
+		return nil, apperr.NewErrInvalidInput("color", "must be a hex color like #1a2b3c")
+	}
+
+	l, err := label.NewBuilder().
+		ID(id.NewLabelID()).
+		CompanyID(actor.CompanyID()).
+		Name(name).
+		Color(input.Color).
+		CreatedAt(time.Now()).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.LabelRepo.Create(ctx, l); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+func validateName(name string) error {
+	if name == "" {
+		return apperr.NewErrInvalidInput("name", "cannot be empty")
+	}
+	if len(name) > label.MaxNameLength {
+		return apperr.NewErrInvalidInput("name", "must be at most 50 characters")
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package labeluc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/label"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type DeleteLabel struct {
+	LabelRepo label.Repo
+}
+
+func NewDeleteLabel(labelRepo label.Repo) *DeleteLabel {
+	return &DeleteLabel{LabelRepo: labelRepo}
+}
+
+// Execute removes the label from the catalog; it is detached from every task
+// that carried it.
+func (uc *DeleteLabel) Execute(ctx context.Context, actor *user.User, labelID id.LabelID) error {
+	if err := authz.Require(actor, user.PermissionEditTasks, "delete", "label"); err != nil {
+		return err
+	}
+
+	return uc.LabelRepo.Delete(ctx, labelID, actor.CompanyID())
+}
@@ -0,0 +1,20 @@
+package labeluc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/label"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type ListLabels struct {
+	LabelRepo label.Repo
+}
+
+func NewListLabels(labelRepo label.Repo) *ListLabels {
+	return &ListLabels{LabelRepo: labelRepo}
+}
+
+func (uc *ListLabels) Execute(ctx context.Context, actor *user.User) ([]*label.Label, error) {
+	return uc.LabelRepo.ListByCompany(ctx, actor.CompanyID())
+}
@@ -0,0 +1,60 @@
+package labeluc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/label"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type UpdateLabelInput struct {
+	LabelID id.LabelID
+	Name    *string
+	Color   *string
+}
+
+type UpdateLabel struct {
+	LabelRepo label.Repo
+}
+
+func NewUpdateLabel(labelRepo label.Repo) *UpdateLabel {
+	return &UpdateLabel{LabelRepo: labelRepo}
+}
+
+func (uc *UpdateLabel) Execute(ctx context.Context, actor *user.User, input UpdateLabelInput) (*label.Label, error) {
+	if err := authz.Require(actor, user.PermissionEditTasks, "update", "label"); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.LabelRepo.FindByIDForCompany(ctx, input.LabelID, actor.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if err := validateName(name); err != nil {
+			return nil, err
+		}
+		input.Name = &name
+	}
+
+	if input.Color != nil && !label.IsValidColor(*input.Color) {
+		return nil, apperr.NewErrInvalidInput("color", "must be a hex color like #1a2b3c")
+	}
+
+	updated := existing.ApplyUpdate(label.Update{
+		Name:  input.Name,
+		Color: input.Color,
+	})
+
+	if err := uc.LabelRepo.Update(ctx, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,117 @@
+package mentionuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/mention"
+	"github.com/pyshx/todoapp/pkg/notification"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+// RecordMentions is shared by the task and comment use cases: they Resolve
+// mentions before saving text and call Execute once it is saved.
+type RecordMentions struct {
+	UserRepo         user.Repo
+	MentionRepo      mention.Repo
+	NotificationRepo notification.Repo
+}
+
+func NewRecordMentions(userRepo user.Repo, mentionRepo mention.Repo, notificationRepo notification.Repo) *RecordMentions {
+	return &RecordMentions{
+		UserRepo:         userRepo,
+		MentionRepo:      mentionRepo,
+		NotificationRepo: notificationRepo,
+	}
+}
+
+// Resolve returns the members of the actor's company mentioned in text.
+// Handles that match nobody stay plain text. A mention never widens who can
+// see a task, so mentioning someone t is hidden from is refused.
+func (uc *RecordMentions) Resolve(ctx context.Context, actor *user.User, t *task.Task, field string, text string) ([]*user.User, error) {
+	var mentioned []*user.User
+	seen := make(map[id.UserID]bool)
+
+	for _, h := range mention.Parse(text) {
+		u, err := uc.findUser(ctx, actor.CompanyID(), h)
+		if err != nil {
+			if apperr.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if seen[u.ID()] {
+			continue
+		}
+		seen[u.ID()] = true
+
+		if !t.CanBeViewedBy(u) {
+			return nil, apperr.NewErrInvalidInput(field, "mentions "+u.Email()+", who cannot see this task")
+		}
+		mentioned = append(mentioned, u)
+	}
+
+	return mentioned, nil
+}
+
+// Execute stores who is mentioned in source and notifies users mentioned
+// there for the first time. Mentioning yourself sends no notification.
+func (uc *RecordMentions) Execute(ctx context.Context, actor *user.User, source mention.Source, mentioned []*user.User) error {
+	userIDs := make([]id.UserID, len(mentioned))
+	for i, u := range mentioned {
+		userIDs[i] = u.ID()
+	}
+
+	added, err := uc.MentionRepo.Replace(ctx, source, userIDs)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	actorID := actor.ID()
+	var notifications []*notification.Notification
+
+	for _, userID := range added {
+		if userID.Equal(actor.ID()) {
+			continue
+		}
+		n, err := notification.NewBuilder().
+			ID(id.NewNotificationID()).
+			CompanyID(source.CompanyID).
+			RecipientID(userID).
+			ActorID(&actorID).
+			Kind(notification.KindMention).
+			TaskID(source.TaskID).
+			CommentID(source.CommentID).
+			CreatedAt(now).
+			Build()
+		if err != nil {
+			return err
+		}
+		notifications = append(notifications, n)
+	}
+
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	return uc.NotificationRepo.Create(ctx, notifications)
+}
+
+func (uc *RecordMentions) findUser(ctx context.Context, companyID id.CompanyID, h mention.Handle) (*user.User, error) {
+	if h.UserID == nil {
+		return uc.UserRepo.FindByEmailForCompany(ctx, h.Email, companyID)
+	}
+
+	u, err := uc.UserRepo.FindByID(ctx, *h.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !u.CompanyID().Equal(companyID) {
+		return nil, apperr.NewErrNotFound("user", h.UserID.String())
+	}
+	return u, nil
+}
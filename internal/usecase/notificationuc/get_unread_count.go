@@ -0,0 +1,20 @@
+package notificationuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/notification"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type GetUnreadCount struct {
+	NotificationRepo notification.Repo
+}
+
+func NewGetUnreadCount(notificationRepo notification.Repo) *GetUnreadCount {
+	return &GetUnreadCount{NotificationRepo: notificationRepo}
+}
+
+func (uc *GetUnreadCount) Execute(ctx context.Context, actor *user.User) (int, error) {
+	return uc.NotificationRepo.CountUnread(ctx, actor.ID())
+}
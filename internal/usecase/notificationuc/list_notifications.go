@@ -0,0 +1,31 @@
+package notificationuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/notification"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type ListNotificationsInput struct {
+	PageSize   int
+	Cursor     *notification.PageCursor
+	UnreadOnly bool
+}
+
+type ListNotifications struct {
+	NotificationRepo notification.Repo
+}
+
+func NewListNotifications(notificationRepo notification.Repo) *ListNotifications {
+	return &ListNotifications{NotificationRepo: notificationRepo}
+}
+
+// Execute lists the actor's own notifications, newest first.
+func (uc *ListNotifications) Execute(ctx context.Context, actor *user.User, input ListNotificationsInput) (*notification.ListResult, error) {
+	return uc.NotificationRepo.ListForRecipient(ctx, actor.ID(), notification.ListOptions{
+		PageSize:   input.PageSize,
+		Cursor:     input.Cursor,
+		UnreadOnly: input.UnreadOnly,
+	})
+}
@@ -0,0 +1,36 @@
+package notificationuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/notification"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+// MarkNotificationsReadInput names the notifications to mark, or sets All to
+// mark the whole inbox.
+type MarkNotificationsReadInput struct {
+	NotificationIDs []id.NotificationID
+	All             bool
+}
+
+type MarkNotificationsRead struct {
+	NotificationRepo notification.Repo
+}
+
+func NewMarkNotificationsRead(notificationRepo notification.Repo) *MarkNotificationsRead {
+	return &MarkNotificationsRead{NotificationRepo: notificationRepo}
+}
+
+// Execute returns how many notifications went from unread to read. IDs of
+// notifications that are already read or belong to someone else are ignored.
+func (uc *MarkNotificationsRead) Execute(ctx context.Context, actor *user.User, input MarkNotificationsReadInput) (int, error) {
+	if input.All == (len(input.NotificationIDs) > 0) {
+		return 0, apperr.NewErrInvalidInput("notification_ids", "give notification IDs or set all, not both")
+	}
+
+	return uc.NotificationRepo.MarkRead(ctx, actor.ID(), input.NotificationIDs, time.Now())
+}
@@ -0,0 +1,28 @@
+package notificationuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/notification"
+)
+
+// SendDueReminders raises a KindDueSoon notification for every open task
+// that becomes due within Window. It runs in the background with no actor,
+// and a task is reminded about once per due date however often it runs.
+type SendDueReminders struct {
+	NotificationRepo notification.Repo
+	Window           time.Duration
+}
+
+func NewSendDueReminders(notificationRepo notification.Repo, window time.Duration) *SendDueReminders {
+	return &SendDueReminders{
+		NotificationRepo: notificationRepo,
+		Window:           window,
+	}
+}
+
+// Execute returns the number of reminders sent.
+func (uc *SendDueReminders) Execute(ctx context.Context, now time.Time) (int, error) {
+	return uc.NotificationRepo.CreateDueSoon(ctx, now, uc.Window)
+}
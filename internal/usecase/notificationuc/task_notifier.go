@@ -0,0 +1,98 @@
+package notificationuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/notification"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+// TaskNotifier turns task changes into inbox notifications. It satisfies
+// taskuc.Notifier.
+type TaskNotifier struct {
+	NotificationRepo notification.Repo
+	WatcherRepo      task.WatcherRepo
+}
+
+func NewTaskNotifier(notificationRepo notification.Repo, watcherRepo task.WatcherRepo) *TaskNotifier {
+	return &TaskNotifier{
+		NotificationRepo: notificationRepo,
+		WatcherRepo:      watcherRepo,
+	}
+}
+
+// TaskChanged notifies new assignees, the creator when the status moves, and
+// every other watcher of the fields an update changed. Each user gets at most
+// one notification per change, and nobody is notified about their own
+// changes.
+func (n *TaskNotifier) TaskChanged(ctx context.Context, actor *user.User, change task.Change) error {
+	now := time.Now()
+	actorID := actor.ID()
+	t := change.After
+	notified := map[id.UserID]bool{actorID: true}
+	var notifications []*notification.Notification
+
+	add := func(kind notification.Kind, recipientID id.UserID, fields []string) error {
+		if notified[recipientID] {
+			return nil
+		}
+		nt, err := notification.NewBuilder().
+			ID(id.NewNotificationID()).
+			CompanyID(t.CompanyID()).
+			RecipientID(recipientID).
+			ActorID(&actorID).
+			Kind(kind).
+			TaskID(t.ID()).
+			ChangedFields(fields).
+			CreatedAt(now).
+			Build()
+		if err != nil {
+			return err
+		}
+		notified[recipientID] = true
+		notifications = append(notifications, nt)
+		return nil
+	}
+
+	for _, assigneeID := range change.NewAssigneeIDs() {
+		if err := add(notification.KindAssigned, assigneeID, nil); err != nil {
+			return err
+		}
+	}
+
+	if change.Has(task.FieldStatus) {
+		if err := add(notification.KindStatusChanged, t.CreatorID(), nil); err != nil {
+			return err
+		}
+	}
+
+	if len(change.Fields) > 0 {
+		watchers, err := n.WatcherRepo.ListByTask(ctx, t.CompanyID(), t.ID())
+		if err != nil {
+			return err
+		}
+
+		fields := make([]string, len(change.Fields))
+		for i, f := range change.Fields {
+			fields[i] = f.String()
+		}
+
+		for _, w := range watchers {
+			if !t.CanBeViewedBy(w) {
+				continue
+			}
+			if err := add(notification.KindTaskUpdated, w.ID(), fields); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	return n.NotificationRepo.Create(ctx, notifications)
+}
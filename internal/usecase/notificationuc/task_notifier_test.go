@@ -0,0 +1,203 @@
+package notificationuc_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/usecase/notificationuc"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/notification"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+// mockNotificationRepo collects created notifications; the inbox methods are
+// not used by TaskNotifier.
+type mockNotificationRepo struct {
+	notification.Repo
+	created []*notification.Notification
+}
+
+func (m *mockNotificationRepo) Create(ctx context.Context, notifications []*notification.Notification) error {
+	m.created = append(m.created, notifications...)
+	return nil
+}
+
+// mockWatcherRepo serves a fixed watcher list
+type mockWatcherRepo struct {
+	task.WatcherRepo
+	watchers []*user.User
+}
+
+func (m *mockWatcherRepo) ListByTask(ctx context.Context, companyID id.CompanyID, taskID id.TaskID) ([]*user.User, error) {
+	return m.watchers, nil
+}
+
+func TestTaskNotifier(t *testing.T) {
+	ctx := context.Background()
+	companyID := id.NewCompanyID()
+
+	newUser := func() *user.User {
+		return user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email("u@test.com").Role(user.RoleEditor).MustBuild()
+	}
+	creator := newUser()
+	assignee := newUser()
+	other := newUser()
+
+	base := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(companyID).
+		CreatorID(creator.ID()).
+		Title("Task").
+		Visibility(task.VisibilityCompanyWide).
+		Status(task.StatusTodo).
+		Version(1).
+		MustBuild()
+
+	assign := func(t *task.Task, u *user.User) *task.Task {
+		assigneeID := u.ID()
+		ref := &assigneeID
+		return t.ApplyUpdate(task.Update{AssigneeID: &ref}, time.Now())
+	}
+	move := func(t *task.Task, s task.Status) *task.Task {
+		ws, _ := task.DefaultWorkflow(companyID).Status(s)
+		return t.ApplyUpdate(task.Update{Status: &ws}, time.Now())
+	}
+
+	hide := func(t *task.Task) *task.Task {
+		v := task.VisibilityOnlyMe
+		return t.ApplyUpdate(task.Update{Visibility: &v}, time.Now())
+	}
+	rename := func(t *task.Task) *task.Task {
+		title := "Renamed"
+		return t.ApplyUpdate(task.Update{Title: &title}, time.Now())
+	}
+
+	type sent struct {
+		kind        notification.Kind
+		recipientID id.UserID
+		fields      []string
+	}
+
+	tests := []struct {
+		name     string
+		actor    *user.User
+		before   *task.Task
+		after    *task.Task
+		watchers []*user.User
+		want     []sent
+	}{
+		{
+			name:     "created with an assignee",
+			actor:    creator,
+			after:    assign(base, assignee),
+			watchers: []*user.User{creator, assignee},
+			want:     []sent{{notification.KindAssigned, assignee.ID(), nil}},
+		},
+		{
+			name:  "created and assigned to yourself",
+			actor: creator,
+			after: assign(base, creator),
+		},
+		{
+			name:   "reassigned",
+			actor:  creator,
+			before: assign(base, other),
+			after:  assign(base, assignee),
+			want:   []sent{{notification.KindAssigned, assignee.ID(), nil}},
+		},
+		{
+			name:   "co-assignee added",
+			actor:  creator,
+			before: assign(base, assignee),
+			after:  assign(base, assignee).ApplyUpdate(task.Update{AddAssigneeIDs: []id.UserID{other.ID()}}, time.Now()),
+			want:   []sent{{notification.KindAssigned, other.ID(), nil}},
+		},
+		{
+			name:   "assignee unchanged",
+			actor:  creator,
+			before: assign(base, assignee),
+			after:  assign(base, assignee).ApplyUpdate(task.Update{}, time.Now()),
+		},
+		{
+			name:   "status changed by someone else",
+			actor:  assignee,
+			before: base,
+			after:  move(base, task.StatusInProgress),
+			want:   []sent{{notification.KindStatusChanged, creator.ID(), nil}},
+		},
+		{
+			name:   "status changed by the creator",
+			actor:  creator,
+			before: base,
+			after:  move(base, task.StatusDone),
+		},
+		{
+			name:   "assigned and moved together",
+			actor:  other,
+			before: base,
+			after:  move(assign(base, assignee), task.StatusInProgress),
+			want: []sent{
+				{notification.KindAssigned, assignee.ID(), nil},
+				{notification.KindStatusChanged, creator.ID(), nil},
+			},
+		},
+		{
+			name:     "watchers hear about other changes",
+			actor:    creator,
+			before:   base,
+			after:    rename(base),
+			watchers: []*user.User{creator, other},
+			want:     []sent{{notification.KindTaskUpdated, other.ID(), []string{"title"}}},
+		},
+		{
+			name:     "one notification per watcher",
+			actor:    other,
+			before:   base,
+			after:    rename(move(base, task.StatusDone)),
+			watchers: []*user.User{creator, assignee, other},
+			want: []sent{
+				{notification.KindStatusChanged, creator.ID(), nil},
+				{notification.KindTaskUpdated, assignee.ID(), []string{"title", "status"}},
+			},
+		},
+		{
+			name:     "watchers who can no longer see the task",
+			actor:    creator,
+			before:   base,
+			after:    hide(base),
+			watchers: []*user.User{other},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockNotificationRepo{}
+			notifier := notificationuc.NewTaskNotifier(repo, &mockWatcherRepo{watchers: tt.watchers})
+
+			if err := notifier.TaskChanged(ctx, tt.actor, task.NewChange(tt.before, tt.after)); err != nil {
+				t.Fatalf("TaskChanged() error = %v", err)
+			}
+
+			if len(repo.created) != len(tt.want) {
+				t.Fatalf("created %d notifications, want %d", len(repo.created), len(tt.want))
+			}
+			for i, n := range repo.created {
+				if n.Kind() != tt.want[i].kind || !n.RecipientID().Equal(tt.want[i].recipientID) {
+					t.Errorf("notification %d = %s to %s, want %s to %s", i, n.Kind(), n.RecipientID(), tt.want[i].kind, tt.want[i].recipientID)
+				}
+				if strings.Join(n.ChangedFields(), ",") != strings.Join(tt.want[i].fields, ",") {
+					t.Errorf("notification %d fields = %v, want %v", i, n.ChangedFields(), tt.want[i].fields)
+				}
+				if n.ActorID() == nil || !n.ActorID().Equal(tt.actor.ID()) {
+					t.Errorf("notification %d actor = %v, want %s", i, n.ActorID(), tt.actor.ID())
+				}
+				if !n.TaskID().Equal(tt.after.ID()) {
+					t.Errorf("notification %d task = %s, want %s", i, n.TaskID(), tt.after.ID())
+				}
+			}
+		})
+	}
+}
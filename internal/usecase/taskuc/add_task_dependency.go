@@ -0,0 +1,61 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type AddTaskDependencyInput struct {
+	BlockerID id.TaskID
+	BlockedID id.TaskID
+}
+
+type AddTaskDependency struct {
+	TaskRepo       task.Repo
+	DependencyRepo task.DependencyRepo
+}
+
+func NewAddTaskDependency(taskRepo task.Repo, dependencyRepo task.DependencyRepo) *AddTaskDependency {
+	return &AddTaskDependency{
+		TaskRepo:       taskRepo,
+		DependencyRepo: dependencyRepo,
+	}
+}
+
+// Execute records that BlockerID must be done before BlockedID can be.
+func (uc *AddTaskDependency) Execute(ctx context.Context, actor *user.User, input AddTaskDependencyInput) error {
+	if err := authz.Require(actor, user.PermissionEditTasks, "update", "task"); err != nil {
+		return err
+	}
+
+	if input.BlockerID.Equal(input.BlockedID) {
+		return apperr.NewErrInvalidInput("blocker_id", "a task cannot block itself")
+	}
+
+	blocked, err := uc.TaskRepo.FindByIDForCompany(ctx, input.BlockedID, actor.CompanyID())
+	if err != nil {
+		return err
+	}
+	if !blocked.CanBeViewedBy(actor) {
+		return apperr.NewErrPermissionDenied("view", "task", "task is not visible to you")
+	}
+
+	blocker, err := uc.TaskRepo.FindByIDForCompany(ctx, input.BlockerID, actor.CompanyID())
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return apperr.NewErrInvalidInput("blocker_id", "task not found")
+		}
+		return err
+	}
+	if !blocker.CanBeViewedBy(actor) {
+		return apperr.NewErrInvalidInput("blocker_id", "task not found")
+	}
+
+	// Add rejects edges that would close a cycle.
+	return uc.DependencyRepo.Add(ctx, actor.CompanyID(), input.BlockerID, input.BlockedID)
+}
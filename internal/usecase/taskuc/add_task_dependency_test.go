@@ -0,0 +1,102 @@
+package taskuc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pyshx/todoapp/internal/usecase/taskuc"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+func TestAddTaskDependency_Execute(t *testing.T) {
+	companyID := id.NewCompanyID()
+
+	editor := user.NewBuilder().
+		ID(id.NewUserID()).
+		CompanyID(companyID).
+		Email("editor@test.com").
+		Role(user.RoleEditor).
+		MustBuild()
+
+	viewer := user.NewBuilder().
+		ID(id.NewUserID()).
+		CompanyID(companyID).
+		Email("viewer@test.com").
+		Role(user.RoleViewer).
+		MustBuild()
+
+	taskRepo := newMockTaskRepo()
+	var ids []id.TaskID
+	for i := 0; i < 3; i++ {
+		tk := task.NewBuilder().
+			ID(id.NewTaskID()).
+			CompanyID(companyID).
+			CreatorID(editor.ID()).
+			Title("Task").
+			Visibility(task.VisibilityCompanyWide).
+			MustBuild()
+		taskRepo.tasks[tk.ID().String()] = tk
+		ids = append(ids, tk.ID())
+	}
+	a, b, c := ids[0], ids[1], ids[2]
+
+	dependencyRepo := newMockDependencyRepo(taskRepo)
+	uc := taskuc.NewAddTaskDependency(taskRepo, dependencyRepo)
+	ctx := context.Background()
+
+	// a blocks b, b blocks c
+	for _, edge := range []taskuc.AddTaskDependencyInput{{BlockerID: a, BlockedID: b}, {BlockerID: b, BlockedID: c}} {
+		if err := uc.Execute(ctx, editor, edge); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		actor *user.User
+		input taskuc.AddTaskDependencyInput
+		check func(error) bool
+	}{
+		{
+			name:  "self dependency",
+			actor: editor,
+			input: taskuc.AddTaskDependencyInput{BlockerID: a, BlockedID: a},
+			check: apperr.IsInvalidInput,
+		},
+		{
+			name:  "direct cycle",
+			actor: editor,
+			input: taskuc.AddTaskDependencyInput{BlockerID: b, BlockedID: a},
+			check: apperr.IsInvalidInput,
+		},
+		{
+			name:  "transitive cycle",
+			actor: editor,
+			input: taskuc.AddTaskDependencyInput{BlockerID: c, BlockedID: a},
+			check: apperr.IsInvalidInput,
+		},
+		{
+			name:  "viewer cannot add",
+			actor: viewer,
+			input: taskuc.AddTaskDependencyInput{BlockerID: a, BlockedID: c},
+			check: apperr.IsPermissionDenied,
+		},
+		{
+			name:  "shortcut edge is allowed",
+			actor: editor,
+			input: taskuc.AddTaskDependencyInput{BlockerID: a, BlockedID: c},
+			check: func(err error) bool { return err == nil },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := uc.Execute(ctx, tt.actor, tt.input); !tt.check(err) {
+				t.Errorf("unexpected result: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package taskuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+// ArchiveCompletedTasks archives tasks that have been done for longer than
+// their company's auto-archive period. It runs in the background with no
+// actor; companies without a period are skipped.
+type ArchiveCompletedTasks struct {
+	TaskRepo task.Repo
+}
+
+func NewArchiveCompletedTasks(taskRepo task.Repo) *ArchiveCompletedTasks {
+	return &ArchiveCompletedTasks{TaskRepo: taskRepo}
+}
+
+// Execute returns the number of tasks archived.
+func (uc *ArchiveCompletedTasks) Execute(ctx context.Context, now time.Time) (int, error) {
+	return uc.TaskRepo.ArchiveCompleted(ctx, now)
+}
@@ -0,0 +1,64 @@
+package taskuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type ArchiveTask struct {
+	TaskRepo task.Repo
+	Notifier Notifier
+}
+
+func NewArchiveTask(taskRepo task.Repo, notifier Notifier) *ArchiveTask {
+	return &ArchiveTask{
+		TaskRepo: taskRepo,
+		Notifier: notifier,
+	}
+}
+
+// Execute archives a task whatever its status. Archiving an archived task
+// returns it unchanged.
+func (uc *ArchiveTask) Execute(ctx context.Context, actor *user.User, taskID id.TaskID) (*task.Task, error) {
+	return setArchived(ctx, uc.TaskRepo, uc.Notifier, actor, taskID, true)
+}
+
+// setArchived saves the archived flag through the same versioned update and
+// notification path as UpdateTask.
+func setArchived(ctx context.Context, repo task.Repo, notifier Notifier, actor *user.User, taskID id.TaskID, archived bool) (*task.Task, error) {
+	action := "archive"
+	if !archived {
+		action = "unarchive"
+	}
+	if err := authz.Require(actor, user.PermissionEditTasks, action, "task"); err != nil {
+		return nil, err
+	}
+
+	existing, err := repo.FindByIDForCompany(ctx, taskID, actor.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+	if !existing.CanBeViewedBy(actor) {
+		return nil, apperr.NewErrPermissionDenied("view", "task", "task is not visible to you")
+	}
+	if existing.IsArchived() == archived {
+		return existing, nil
+	}
+
+	updated := existing.ApplyUpdate(task.Update{Archived: &archived}, time.Now())
+	if err := repo.Update(ctx, updated, existing.Version(), actor.ID()); err != nil {
+		return nil, err
+	}
+
+	if err := notifier.TaskChanged(ctx, actor, task.NewChange(existing, updated)); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
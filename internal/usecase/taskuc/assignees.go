@@ -0,0 +1,43 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+// checkAssignees makes sure every assignee is an active user of the actor's
+// company.
+// field names the input the IDs came from.
+func checkAssignees(ctx context.Context, repo user.Repo, actor *user.User, field string, assigneeIDs []id.UserID) error {
+	for _, assigneeID := range assigneeIDs {
+		assignee, err := repo.FindByID(ctx, assigneeID)
+		if err != nil {
+			if apperr.IsNotFound(err) {
+				return apperr.NewErrInvalidInput(field, "user not found")
+			}
+			return err
+		}
+		if !assignee.CompanyID().Equal(actor.CompanyID()) {
+			return apperr.NewErrInvalidInput(field, "assignee must be in the same company")
+		}
+		if !assignee.IsActive() {
+			return apperr.NewErrInvalidInput(field, "user is deactivated")
+		}
+	}
+	return nil
+}
+
+func uniqueUserIDs(userIDs []id.UserID) []id.UserID {
+	var unique []id.UserID
+	seen := make(map[id.UserID]bool, len(userIDs))
+	for _, u := range userIDs {
+		if !seen[u] {
+			seen[u] = true
+			unique = append(unique, u)
+		}
+	}
+	return unique
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/internal/usecase/mentionuc"
 	"github.com/pyshx/todoapp/pkg/apperr"
 	"github.com/pyshx/todoapp/pkg/id"
 	"github.com/pyshx/todoapp/pkg/task"
@@ -14,25 +16,46 @@ type CreateTaskInput struct {
 	Title       string
 	Description *string
 	AssigneeID  *id.UserID
+	// AssigneeIDs assigns further users after AssigneeID, which stays the
+	// primary assignee when set.
+	AssigneeIDs []id.UserID
+	ParentID    *id.TaskID
 	DueDate     *time.Time
 	Visibility  task.Visibility
+	Priority    task.Priority
+	LabelIDs    []id.LabelID
+	// Recurrence makes the task the first instance of a series starting at
+	// DueDate.
+	Recurrence *RecurrenceInput
+	// TeamID is required for team visibility and rejected otherwise.
+	TeamID *id.TeamID
 }
 
 type CreateTask struct {
-	TaskRepo task.Repo
-	UserRepo user.Repo
+	TaskRepo       task.Repo
+	UserRepo       user.Repo
+	SeriesRepo     task.SeriesRepo
+	WorkflowRepo   task.WorkflowRepo
+	WatcherRepo    task.WatcherRepo
+	RecordMentions *mentionuc.RecordMentions
+	Notifier       Notifier
 }
 
-func NewCreateTask(taskRepo task.Repo, userRepo user.Repo) *CreateTask {
+func NewCreateTask(taskRepo task.Repo, userRepo user.Repo, seriesRepo task.SeriesRepo, workflowRepo task.WorkflowRepo, watcherRepo task.WatcherRepo, recordMentions *mentionuc.RecordMentions, notifier Notifier) *CreateTask {
 	return &CreateTask{
-		TaskRepo: taskRepo,
-		UserRepo: userRepo,
+		TaskRepo:       taskRepo,
+		UserRepo:       userRepo,
+		SeriesRepo:     seriesRepo,
+		WorkflowRepo:   workflowRepo,
+		WatcherRepo:    watcherRepo,
+		RecordMentions: recordMentions,
+		Notifier:       notifier,
 	}
 }
 
 func (uc *CreateTask) Execute(ctx context.Context, actor *user.User, input CreateTaskInput) (*task.Task, error) {
-	if !actor.CanEdit() {
-		return nil, apperr.NewErrPermissionDenied("create", "task", "viewer role cannot create tasks")
+	if err := authz.Require(actor, user.PermissionEditTasks, "create", "task"); err != nil {
+		return nil, err
 	}
 
 	if input.Title == "" {
@@ -40,20 +63,52 @@ func (uc *CreateTask) Execute(ctx context.Context, actor *user.User, input Creat
 	}
 
 	if !input.Visibility.IsValid() {
-		return nil, apperr.NewErrInvalidInput("visibility", "must be only_me or company_wide")
+		return nil, apperr.NewErrInvalidInput("visibility", "must be only_me, company_wide, or team")
+	}
+	if err := checkTeam(input.Visibility, input.TeamID); err != nil {
+		return nil, err
+	}
+
+	priority := input.Priority
+	if priority == "" {
+		priority = task.PriorityNone
+	}
+	if !priority.IsValid() {
+		return nil, apperr.NewErrInvalidInput("priority", "must be none, low, medium, high, or urgent")
+	}
+
+	if input.AssigneeID != nil {
+		if err := checkAssignees(ctx, uc.UserRepo, actor, "assignee_id", []id.UserID{*input.AssigneeID}); err != nil {
+			return nil, err
+		}
+	}
+	if err := checkAssignees(ctx, uc.UserRepo, actor, "assignee_ids", input.AssigneeIDs); err != nil {
+		return nil, err
 	}
 
+	var assigneeIDs []id.UserID
 	if input.AssigneeID != nil {
-		assignee, err := uc.UserRepo.FindByID(ctx, *input.AssigneeID)
-		if err != nil {
-			if apperr.IsNotFound(err) {
-				return nil, apperr.NewErrInvalidInput("assignee_id", "user not found")
-			}
+		assigneeIDs = append(assigneeIDs, *input.AssigneeID)
+	}
+	assigneeIDs = uniqueUserIDs(append(assigneeIDs, input.AssigneeIDs...))
+
+	if input.ParentID != nil {
+		if err := checkParent(ctx, uc.TaskRepo, actor, nil, *input.ParentID); err != nil {
 			return nil, err
 		}
-		if !assignee.CompanyID().Equal(actor.CompanyID()) {
-			return nil, apperr.NewErrInvalidInput("assignee_id", "assignee must be in the same company")
+	}
+
+	workflow, err := uc.WorkflowRepo.FindByCompany(ctx, actor.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+	status := workflow.Initial()
+
+	if input.Recurrence != nil {
+		if len(assigneeIDs) > 1 {
+			return nil, apperr.NewErrInvalidInput("assignee_ids", "recurring tasks take a single assignee")
 		}
+		return uc.createSeries(ctx, actor, input, assigneeIDs, priority, status)
 	}
 
 	now := time.Now()
@@ -61,12 +116,17 @@ func (uc *CreateTask) Execute(ctx context.Context, actor *user.User, input Creat
 		ID(id.NewTaskID()).
 		CompanyID(actor.CompanyID()).
 		CreatorID(actor.ID()).
-		AssigneeID(input.AssigneeID).
+		AssigneeIDs(assigneeIDs).
+		ParentID(input.ParentID).
 		Title(input.Title).
 		Description(input.Description).
 		DueDate(input.DueDate).
 		Visibility(input.Visibility).
-		Status(task.StatusTodo).
+		TeamID(input.TeamID).
+		Status(status.Key).
+		Category(status.Category).
+		Priority(priority).
+		LabelIDs(uniqueLabelIDs(input.LabelIDs)).
 		Version(1).
 		CreatedAt(now).
 		UpdatedAt(now).
@@ -75,9 +135,127 @@ func (uc *CreateTask) Execute(ctx context.Context, actor *user.User, input Creat
 		return nil, err
 	}
 
+	mentioned, err := descriptionMentions(ctx, uc.RecordMentions, actor, t)
+	if err != nil {
+		return nil, err
+	}
+
 	if err := uc.TaskRepo.Create(ctx, t); err != nil {
 		return nil, err
 	}
 
+	if err := recordDescriptionMentions(ctx, uc.RecordMentions, actor, t, mentioned); err != nil {
+		return nil, err
+	}
+
+	if err := watchNewTask(ctx, uc.WatcherRepo, t); err != nil {
+		return nil, err
+	}
+
+	if err := uc.Notifier.TaskChanged(ctx, actor, task.NewChange(nil, t)); err != nil {
+		return nil, err
+	}
+
 	return t, nil
 }
+
+func (uc *CreateTask) createSeries(ctx context.Context, actor *user.User, input CreateTaskInput, assigneeIDs []id.UserID, priority task.Priority, status task.WorkflowStatus) (*task.Task, error) {
+	if input.DueDate == nil {
+		return nil, apperr.NewErrInvalidInput("due_date", "required for recurring tasks")
+	}
+
+	rule, loc, err := parseRecurrence(*input.Recurrence)
+	if err != nil {
+		return nil, err
+	}
+
+	var assigneeID *id.UserID
+	if len(assigneeIDs) > 0 {
+		assigneeID = &assigneeIDs[0]
+	}
+
+	now := time.Now()
+	seriesID := id.NewSeriesID()
+	s, err := task.NewSeriesBuilder().
+		ID(seriesID).
+		CompanyID(actor.CompanyID()).
+		CreatorID(actor.ID()).
+		AssigneeID(assigneeID).
+		Rule(rule).
+		TimeZone(loc).
+		StartsAt(*input.DueDate).
+		LastOccurrenceAt(*input.DueDate).
+		Occurrences(1).
+		Title(input.Title).
+		Description(input.Description).
+		Visibility(input.Visibility).
+		TeamID(input.TeamID).
+		Priority(priority).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(actor.CompanyID()).
+		CreatorID(actor.ID()).
+		AssigneeID(assigneeID).
+		ParentID(input.ParentID).
+		Title(input.Title).
+		Description(input.Description).
+		DueDate(input.DueDate).
+		Visibility(input.Visibility).
+		TeamID(input.TeamID).
+		Status(status.Key).
+		Category(status.Category).
+		Priority(priority).
+		LabelIDs(uniqueLabelIDs(input.LabelIDs)).
+		SeriesID(&seriesID).
+		Occurrence(1).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	mentioned, err := descriptionMentions(ctx, uc.RecordMentions, actor, t)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.SeriesRepo.Create(ctx, s, t); err != nil {
+		return nil, err
+	}
+
+	if err := recordDescriptionMentions(ctx, uc.RecordMentions, actor, t, mentioned); err != nil {
+		return nil, err
+	}
+
+	if err := watchNewTask(ctx, uc.WatcherRepo, t); err != nil {
+		return nil, err
+	}
+
+	if err := uc.Notifier.TaskChanged(ctx, actor, task.NewChange(nil, t)); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func uniqueLabelIDs(labelIDs []id.LabelID) []id.LabelID {
+	var unique []id.LabelID
+	seen := make(map[id.LabelID]bool, len(labelIDs))
+	for _, l := range labelIDs {
+		if !seen[l] {
+			seen[l] = true
+			unique = append(unique, l)
+		}
+	}
+	return unique
+}
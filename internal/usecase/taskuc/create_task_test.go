@@ -2,11 +2,16 @@ package taskuc_test
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/pyshx/todoapp/internal/usecase/mentionuc"
 	"github.com/pyshx/todoapp/internal/usecase/taskuc"
 	"github.com/pyshx/todoapp/pkg/apperr"
 	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/mention"
+	"github.com/pyshx/todoapp/pkg/notification"
 	"github.com/pyshx/todoapp/pkg/task"
 	"github.com/pyshx/todoapp/pkg/user"
 )
@@ -14,11 +19,12 @@ import (
 // mockTaskRepo is a simple mock for task.Repo
 type mockTaskRepo struct {
 	tasks   map[string]*task.Task
+	trash   map[string]*task.Task
 	created *task.Task
 }
 
 func newMockTaskRepo() *mockTaskRepo {
-	return &mockTaskRepo{tasks: make(map[string]*task.Task)}
+	return &mockTaskRepo{tasks: make(map[string]*task.Task), trash: make(map[string]*task.Task)}
 }
 
 func (m *mockTaskRepo) Create(ctx context.Context, t *task.Task) error {
@@ -45,18 +51,256 @@ func (m *mockTaskRepo) ListByCompany(ctx context.Context, companyID id.CompanyID
 	return &task.ListResult{Tasks: nil}, nil
 }
 
+func (m *mockTaskRepo) ListVisibleByCompany(ctx context.Context, companyID id.CompanyID, viewerID id.UserID, opts task.ListOptions) (*task.ListResult, error) {
+	return &task.ListResult{Tasks: nil}, nil
+}
+
 func (m *mockTaskRepo) ListByAssignee(ctx context.Context, companyID id.CompanyID, assigneeID id.UserID, opts task.ListOptions) (*task.ListResult, error) {
 	return &task.ListResult{Tasks: nil}, nil
 }
 
-func (m *mockTaskRepo) Update(ctx context.Context, t *task.Task, expectedVersion int) error {
+func (m *mockTaskRepo) Search(ctx context.Context, companyID id.CompanyID, viewerID id.UserID, opts task.SearchOptions) (*task.SearchResult, error) {
+	return &task.SearchResult{Hits: nil}, nil
+}
+
+func (m *mockTaskRepo) ListSubtree(ctx context.Context, companyID id.CompanyID, viewerID id.UserID, rootID id.TaskID) (*task.Subtree, error) {
+	return &task.Subtree{}, nil
+}
+
+func (m *mockTaskRepo) ListAncestorIDs(ctx context.Context, companyID id.CompanyID, taskID id.TaskID) ([]id.TaskID, error) {
+	var ancestorIDs []id.TaskID
+	for t, ok := m.tasks[taskID.String()]; ok && t.ParentID() != nil; t, ok = m.tasks[t.ParentID().String()] {
+		ancestorIDs = append(ancestorIDs, *t.ParentID())
+	}
+	return ancestorIDs, nil
+}
+
+func (m *mockTaskRepo) Update(ctx context.Context, t *task.Task, expectedVersion int, actorID id.UserID) error {
+	if t.ParentID() == nil {
+		return nil
+	}
+	ancestorIDs, _ := m.ListAncestorIDs(ctx, t.CompanyID(), *t.ParentID())
+	for _, ancestorID := range ancestorIDs {
+		if ancestorID.Equal(t.ID()) {
+			return apperr.NewErrInvalidInput("parent_id", "would create a cycle")
+		}
+	}
+	return nil
+}
+
+func (m *mockTaskRepo) Delete(ctx context.Context, taskID id.TaskID, companyID id.CompanyID, actorID id.UserID) error {
+	if t, ok := m.tasks[taskID.String()]; ok {
+		m.trash[taskID.String()] = t
+		delete(m.tasks, taskID.String())
+	}
+	return nil
+}
+
+func (m *mockTaskRepo) ListDeleted(ctx context.Context, companyID id.CompanyID, viewerID id.UserID, opts task.ListOptions) (*task.ListResult, error) {
+	return &task.ListResult{Tasks: nil}, nil
+}
+
+func (m *mockTaskRepo) FindDeletedByIDForCompany(ctx context.Context, taskID id.TaskID, companyID id.CompanyID) (*task.Task, error) {
+	if t, ok := m.trash[taskID.String()]; ok && t.CompanyID().Equal(companyID) {
+		return t, nil
+	}
+	return nil, apperr.NewErrNotFound("task", taskID.String())
+}
+
+func (m *mockTaskRepo) Restore(ctx context.Context, taskID id.TaskID, companyID id.CompanyID, actorID id.UserID) error {
+	if t, ok := m.trash[taskID.String()]; ok {
+		m.tasks[taskID.String()] = t
+		delete(m.trash, taskID.String())
+	}
 	return nil
 }
 
-func (m *mockTaskRepo) Delete(ctx context.Context, taskID id.TaskID, companyID id.CompanyID) error {
+func (m *mockTaskRepo) Purge(ctx context.Context, taskID id.TaskID, companyID id.CompanyID, actorID id.UserID) error {
+	delete(m.trash, taskID.String())
+	return nil
+}
+
+func (m *mockTaskRepo) PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	return 0, nil
+}
+
+func (m *mockTaskRepo) ArchiveCompleted(ctx context.Context, now time.Time) (int, error) {
+	return 0, nil
+}
+
+func (m *mockTaskRepo) HandOver(ctx context.Context, h task.Handover, actorID id.UserID, now time.Time) ([]task.Change, error) {
+	var changes []task.Change
+	for _, tasks := range []map[string]*task.Task{m.tasks, m.trash} {
+		for key, t := range tasks {
+			if !t.CompanyID().Equal(h.CompanyID) {
+				continue
+			}
+			updated := t.HandOver(h, now)
+			if len(updated.Changes()) == 0 {
+				continue
+			}
+			tasks[key] = updated
+			changes = append(changes, task.NewChange(t, updated))
+		}
+	}
+	return changes, nil
+}
+
+// mockDependencyRepo keeps blocking edges in memory, resolving tasks
+// through a mockTaskRepo
+type mockDependencyRepo struct {
+	tasks *mockTaskRepo
+	edges map[id.TaskID][]id.TaskID // blocked -> blockers
+}
+
+func newMockDependencyRepo(tasks *mockTaskRepo) *mockDependencyRepo {
+	return &mockDependencyRepo{tasks: tasks, edges: make(map[id.TaskID][]id.TaskID)}
+}
+
+func (m *mockDependencyRepo) Add(ctx context.Context, companyID id.CompanyID, blockerID, blockedID id.TaskID) error {
+	for _, b := range m.transitiveBlockerIDs(blockerID) {
+		if b.Equal(blockedID) {
+			return apperr.NewErrInvalidInput("blocker_id", "would create a dependency cycle")
+		}
+	}
+	m.edges[blockedID] = append(m.edges[blockedID], blockerID)
 	return nil
 }
 
+func (m *mockDependencyRepo) Remove(ctx context.Context, companyID id.CompanyID, blockerID, blockedID id.TaskID) error {
+	for i, b := range m.edges[blockedID] {
+		if b.Equal(blockerID) {
+			m.edges[blockedID] = append(m.edges[blockedID][:i], m.edges[blockedID][i+1:]...)
+			return nil
+		}
+	}
+	return apperr.NewErrNotFound("task dependency", blockerID.String())
+}
+
+func (m *mockDependencyRepo) ListBlockers(ctx context.Context, companyID id.CompanyID, taskID id.TaskID) ([]*task.Task, error) {
+	var blockers []*task.Task
+	for _, b := range m.edges[taskID] {
+		blockers = append(blockers, m.tasks.tasks[b.String()])
+	}
+	return blockers, nil
+}
+
+func (m *mockDependencyRepo) ListDependents(ctx context.Context, companyID id.CompanyID, taskID id.TaskID) ([]*task.Task, error) {
+	var dependents []*task.Task
+	for blocked, blockers := range m.edges {
+		for _, b := range blockers {
+			if b.Equal(taskID) {
+				dependents = append(dependents, m.tasks.tasks[blocked.String()])
+			}
+		}
+	}
+	return dependents, nil
+}
+
+func (m *mockDependencyRepo) transitiveBlockerIDs(taskID id.TaskID) []id.TaskID {
+	var blockerIDs []id.TaskID
+	seen := map[id.TaskID]bool{}
+	queue := []id.TaskID{taskID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, b := range m.edges[current] {
+			if !seen[b] {
+				seen[b] = true
+				blockerIDs = append(blockerIDs, b)
+				queue = append(queue, b)
+			}
+		}
+	}
+	return blockerIDs
+}
+
+// mockSeriesRepo keeps series in memory and stores their instances in a
+// mockTaskRepo
+type mockSeriesRepo struct {
+	tasks  *mockTaskRepo
+	series map[id.SeriesID]*task.Series
+}
+
+func newMockSeriesRepo(tasks *mockTaskRepo) *mockSeriesRepo {
+	return &mockSeriesRepo{tasks: tasks, series: make(map[id.SeriesID]*task.Series)}
+}
+
+func (m *mockSeriesRepo) Create(ctx context.Context, s *task.Series, first *task.Task) error {
+	m.series[s.ID()] = s
+	return m.tasks.Create(ctx, first)
+}
+
+func (m *mockSeriesRepo) FindByIDForCompany(ctx context.Context, seriesID id.SeriesID, companyID id.CompanyID) (*task.Series, error) {
+	if s, ok := m.series[seriesID]; ok && s.CompanyID().Equal(companyID) {
+		return s, nil
+	}
+	return nil, apperr.NewErrNotFound("task series", seriesID.String())
+}
+
+func (m *mockSeriesRepo) Update(ctx context.Context, s *task.Series, expectedVersion int, instances []task.Change, actorID id.UserID) error {
+	for _, c := range instances {
+		existing, ok := m.tasks.tasks[c.Before.ID().String()]
+		if !ok {
+			return apperr.NewErrNotFound("task", c.Before.ID().String())
+		}
+		if existing.Version() != c.Before.Version() {
+			return apperr.NewErrVersionMismatch(c.Before.Version(), existing.Version())
+		}
+	}
+	if err := m.Advance(ctx, s, expectedVersion, nil); err != nil {
+		return err
+	}
+	for _, c := range instances {
+		m.tasks.tasks[c.After.ID().String()] = c.After
+	}
+	return nil
+}
+
+func (m *mockSeriesRepo) Advance(ctx context.Context, s *task.Series, expectedVersion int, next *task.Task) error {
+	existing, ok := m.series[s.ID()]
+	if !ok {
+		return apperr.NewErrNotFound("task series", s.ID().String())
+	}
+	if existing.Version() != expectedVersion {
+		return apperr.NewErrVersionMismatch(expectedVersion, existing.Version())
+	}
+	m.series[s.ID()] = s
+	if next != nil {
+		return m.tasks.Create(ctx, next)
+	}
+	return nil
+}
+
+func (m *mockSeriesRepo) ListDue(ctx context.Context, now time.Time, limit int) ([]*task.Series, error) {
+	var due []*task.Series
+	for _, s := range m.series {
+		if !s.Ended() && !s.LastOccurrenceAt().After(now) {
+			due = append(due, s)
+		}
+	}
+	return due, nil
+}
+
+func (m *mockSeriesRepo) FindInstance(ctx context.Context, companyID id.CompanyID, seriesID id.SeriesID, occurrence int) (*task.Task, error) {
+	for _, t := range m.tasks.tasks {
+		if t.SeriesID() != nil && t.SeriesID().Equal(seriesID) && t.Occurrence() == occurrence {
+			return t, nil
+		}
+	}
+	return nil, apperr.NewErrNotFound("task", seriesID.String())
+}
+
+func (m *mockSeriesRepo) ListOpenInstances(ctx context.Context, companyID id.CompanyID, seriesID id.SeriesID) ([]*task.Task, error) {
+	var open []*task.Task
+	for _, t := range m.tasks.tasks {
+		if t.SeriesID() != nil && t.SeriesID().Equal(seriesID) && t.Status() != task.StatusDone {
+			open = append(open, t)
+		}
+	}
+	return open, nil
+}
+
 // mockUserRepo is a simple mock for user.Repo
 type mockUserRepo struct {
 	users map[string]*user.User
@@ -77,10 +321,164 @@ func (m *mockUserRepo) FindByID(ctx context.Context, id id.UserID) (*user.User,
 	return nil, apperr.NewErrNotFound("user", id.String())
 }
 
+func (m *mockUserRepo) FindByEmailForCompany(ctx context.Context, email string, companyID id.CompanyID) (*user.User, error) {
+	for _, u := range m.users {
+		if strings.EqualFold(u.Email(), email) && u.CompanyID().Equal(companyID) {
+			return u, nil
+		}
+	}
+	return nil, apperr.NewErrNotFound("user", email)
+}
+
+func (m *mockUserRepo) ListByCompany(ctx context.Context, companyID id.CompanyID, includeDeactivated bool) ([]*user.User, error) {
+	var users []*user.User
+	for _, u := range m.users {
+		if u.CompanyID().Equal(companyID) && (includeDeactivated || u.IsActive()) {
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
+func (m *mockUserRepo) Update(ctx context.Context, u *user.User) error {
+	m.users[u.ID().String()] = u
+	return nil
+}
+
+// mockMentionRepo remembers the users mentioned in each task description
+type mockMentionRepo struct {
+	mentions map[id.TaskID]map[id.UserID]bool
+}
+
+func (m *mockMentionRepo) Replace(ctx context.Context, source mention.Source, userIDs []id.UserID) ([]id.UserID, error) {
+	previous := m.mentions[source.TaskID]
+	current := make(map[id.UserID]bool, len(userIDs))
+	var added []id.UserID
+	for _, u := range userIDs {
+		current[u] = true
+		if !previous[u] {
+			added = append(added, u)
+		}
+	}
+	m.mentions[source.TaskID] = current
+	return added, nil
+}
+
+// mockNotificationRepo collects created notifications; the inbox methods are
+// not used by task use cases.
+type mockNotificationRepo struct {
+	notification.Repo
+	created []*notification.Notification
+}
+
+func (m *mockNotificationRepo) Create(ctx context.Context, notifications []*notification.Notification) error {
+	m.created = append(m.created, notifications...)
+	return nil
+}
+
+// mockNotifier records the task changes it is told about
+type mockNotifier struct {
+	changes []task.Change
+}
+
+func (m *mockNotifier) TaskChanged(ctx context.Context, actor *user.User, change task.Change) error {
+	m.changes = append(m.changes, change)
+	return nil
+}
+
+// mockWatcherRepo keeps watchers in subscription order and resolves them
+// through the user mock
+// mockWorkflowRepo hands out the default workflow to companies that have not
+// saved one.
+type mockWorkflowRepo struct {
+	workflows map[id.CompanyID]*task.Workflow
+}
+
+func newMockWorkflowRepo() *mockWorkflowRepo {
+	return &mockWorkflowRepo{workflows: make(map[id.CompanyID]*task.Workflow)}
+}
+
+func (m *mockWorkflowRepo) FindByCompany(ctx context.Context, companyID id.CompanyID) (*task.Workflow, error) {
+	if w, ok := m.workflows[companyID]; ok {
+		return w, nil
+	}
+	return task.DefaultWorkflow(companyID), nil
+}
+
+func (m *mockWorkflowRepo) Save(ctx context.Context, w *task.Workflow) error {
+	m.workflows[w.CompanyID()] = w
+	return nil
+}
+
+type mockWatcherRepo struct {
+	users    *mockUserRepo
+	watchers map[id.TaskID][]id.UserID
+}
+
+func newMockWatcherRepo(users *mockUserRepo) *mockWatcherRepo {
+	return &mockWatcherRepo{users: users, watchers: make(map[id.TaskID][]id.UserID)}
+}
+
+func (m *mockWatcherRepo) Add(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, userIDs []id.UserID) error {
+	for _, userID := range userIDs {
+		if !m.isWatching(taskID, userID) {
+			m.watchers[taskID] = append(m.watchers[taskID], userID)
+		}
+	}
+	return nil
+}
+
+func (m *mockWatcherRepo) Remove(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, userIDs []id.UserID) error {
+	var kept []id.UserID
+	for _, w := range m.watchers[taskID] {
+		removed := false
+		for _, userID := range userIDs {
+			removed = removed || w.Equal(userID)
+		}
+		if !removed {
+			kept = append(kept, w)
+		}
+	}
+	m.watchers[taskID] = kept
+	return nil
+}
+
+func (m *mockWatcherRepo) ListByTask(ctx context.Context, companyID id.CompanyID, taskID id.TaskID) ([]*user.User, error) {
+	var watchers []*user.User
+	for _, userID := range m.watchers[taskID] {
+		u, err := m.users.FindByID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		watchers = append(watchers, u)
+	}
+	return watchers, nil
+}
+
+func (m *mockWatcherRepo) ListTeamTasks(ctx context.Context, companyID id.CompanyID, teamID id.TeamID, userID id.UserID) ([]*task.Task, error) {
+	return nil, nil
+}
+
+func (m *mockWatcherRepo) isWatching(taskID id.TaskID, userID id.UserID) bool {
+	for _, w := range m.watchers[taskID] {
+		if w.Equal(userID) {
+			return true
+		}
+	}
+	return false
+}
+
+func newRecordMentions(userRepo user.Repo) (*mentionuc.RecordMentions, *mockNotificationRepo) {
+	notificationRepo := &mockNotificationRepo{}
+	mentionRepo := &mockMentionRepo{mentions: make(map[id.TaskID]map[id.UserID]bool)}
+	return mentionuc.NewRecordMentions(userRepo, mentionRepo, notificationRepo), notificationRepo
+}
+
 func TestCreateTask_Execute(t *testing.T) {
 	companyID := id.NewCompanyID()
 	editorID := id.NewUserID()
 	viewerID := id.NewUserID()
+	teamID := id.NewTeamID()
 
 	editor := user.NewBuilder().
 		ID(editorID).
@@ -130,6 +528,35 @@ func TestCreateTask_Execute(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name:  "editor can create team task",
+			actor: editor,
+			input: taskuc.CreateTaskInput{
+				Title:      "Test Task",
+				Visibility: task.VisibilityTeam,
+				TeamID:     &teamID,
+			},
+			wantErr: false,
+		},
+		{
+			name:  "team visibility without team fails",
+			actor: editor,
+			input: taskuc.CreateTaskInput{
+				Title:      "Test Task",
+				Visibility: task.VisibilityTeam,
+			},
+			wantErr: true,
+		},
+		{
+			name:  "team without team visibility fails",
+			actor: editor,
+			input: taskuc.CreateTaskInput{
+				Title:      "Test Task",
+				Visibility: task.VisibilityCompanyWide,
+				TeamID:     &teamID,
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -139,7 +566,8 @@ func TestCreateTask_Execute(t *testing.T) {
 			userRepo.AddUser(editor)
 			userRepo.AddUser(viewer)
 
-			uc := taskuc.NewCreateTask(taskRepo, userRepo)
+			recordMentions, _ := newRecordMentions(userRepo)
+			uc := taskuc.NewCreateTask(taskRepo, userRepo, newMockSeriesRepo(taskRepo), newMockWorkflowRepo(), newMockWatcherRepo(userRepo), recordMentions, &mockNotifier{})
 			result, err := uc.Execute(context.Background(), tt.actor, tt.input)
 
 			if tt.wantErr {
@@ -206,7 +634,8 @@ func TestCreateTask_AssigneeValidation(t *testing.T) {
 	userRepo.AddUser(validAssignee)
 	userRepo.AddUser(invalidAssignee)
 
-	uc := taskuc.NewCreateTask(taskRepo, userRepo)
+	recordMentions, _ := newRecordMentions(userRepo)
+	uc := taskuc.NewCreateTask(taskRepo, userRepo, newMockSeriesRepo(taskRepo), newMockWorkflowRepo(), newMockWatcherRepo(userRepo), recordMentions, &mockNotifier{})
 
 	t.Run("valid assignee", func(t *testing.T) {
 		input := taskuc.CreateTaskInput{
@@ -248,3 +677,59 @@ func TestCreateTask_AssigneeValidation(t *testing.T) {
 		}
 	})
 }
+
+func TestCreateTask_Mentions(t *testing.T) {
+	ctx := context.Background()
+	companyID := id.NewCompanyID()
+
+	newUser := func(email string, companyID id.CompanyID, role user.Role) *user.User {
+		return user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email(email).Role(role).MustBuild()
+	}
+	editor := newUser("editor@test.com", companyID, user.RoleEditor)
+	viewer := newUser("viewer@test.com", companyID, user.RoleViewer)
+	outsider := newUser("outsider@test.com", id.NewCompanyID(), user.RoleViewer)
+
+	userRepo := newMockUserRepo()
+	userRepo.AddUser(editor)
+	userRepo.AddUser(viewer)
+	userRepo.AddUser(outsider)
+
+	recordMentions, notificationRepo := newRecordMentions(userRepo)
+	uc := taskuc.NewCreateTask(newMockTaskRepo(), userRepo, newMockSeriesRepo(newMockTaskRepo()), newMockWorkflowRepo(), newMockWatcherRepo(userRepo), recordMentions, &mockNotifier{})
+
+	description := "@Viewer@test.com and @" + editor.ID().String() + " please check; @outsider@test.com cannot"
+	if _, err := uc.Execute(ctx, editor, taskuc.CreateTaskInput{
+		Title:       "Review",
+		Description: &description,
+		Visibility:  task.VisibilityCompanyWide,
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(notificationRepo.created) != 1 {
+		t.Fatalf("expected 1 notification, got %d", len(notificationRepo.created))
+	}
+	if n := notificationRepo.created[0]; !n.RecipientID().Equal(viewer.ID()) || n.Kind() != notification.KindMention {
+		t.Errorf("unexpected notification for %s of kind %s", n.RecipientID(), n.Kind())
+	}
+
+	private := "@viewer@test.com see this"
+	_, err := uc.Execute(ctx, editor, taskuc.CreateTaskInput{
+		Title:       "Secret",
+		Description: &private,
+		Visibility:  task.VisibilityOnlyMe,
+	})
+	if !apperr.IsInvalidInput(err) {
+		t.Errorf("expected invalid input mentioning a user who cannot see the task, got %v", err)
+	}
+
+	assigned := viewer.ID()
+	if _, err := uc.Execute(ctx, editor, taskuc.CreateTaskInput{
+		Title:       "Secret",
+		Description: &private,
+		AssigneeID:  &assigned,
+		Visibility:  task.VisibilityOnlyMe,
+	}); err != nil {
+		t.Errorf("unexpected error mentioning the assignee of a private task: %v", err)
+	}
+}
@@ -3,7 +3,7 @@ package taskuc
 import (
 	"context"
 
-	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/internal/usecase/authz"
 	"github.com/pyshx/todoapp/pkg/id"
 	"github.com/pyshx/todoapp/pkg/task"
 	"github.com/pyshx/todoapp/pkg/user"
@@ -17,10 +17,18 @@ func NewDeleteTask(taskRepo task.Repo) *DeleteTask {
 	return &DeleteTask{TaskRepo: taskRepo}
 }
 
+// Execute moves the task to the trash. Besides editors, users the task is
+// shared with as editors may delete it.
 func (uc *DeleteTask) Execute(ctx context.Context, actor *user.User, taskID id.TaskID) error {
-	if !actor.CanEdit() {
-		return apperr.NewErrPermissionDenied("delete", "task", "viewer role cannot delete tasks")
+	if !actor.Can(user.PermissionEditTasks) {
+		t, err := uc.TaskRepo.FindByIDForCompany(ctx, taskID, actor.CompanyID())
+		if err != nil {
+			return err
+		}
+		if !t.IsSharedEditor(actor.ID()) {
+			return authz.Require(actor, user.PermissionEditTasks, "delete", "task")
+		}
 	}
 
-	return uc.TaskRepo.Delete(ctx, taskID, actor.CompanyID())
+	return uc.TaskRepo.Delete(ctx, taskID, actor.CompanyID(), actor.ID())
 }
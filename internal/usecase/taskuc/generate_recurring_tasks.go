@@ -0,0 +1,63 @@
+package taskuc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+// recurringBatchSize bounds how many series one run advances.
+const recurringBatchSize = 100
+
+// GenerateRecurringTasks creates the next instance of every series whose
+// latest occurrence is due, whether or not that instance is done. It runs in
+// the background with no actor. Each run advances a series by one
+// occurrence, so a series that fell behind catches up over several runs.
+type GenerateRecurringTasks struct {
+	SeriesRepo   task.SeriesRepo
+	WorkflowRepo task.WorkflowRepo
+	WatcherRepo  task.WatcherRepo
+}
+
+func NewGenerateRecurringTasks(seriesRepo task.SeriesRepo, workflowRepo task.WorkflowRepo, watcherRepo task.WatcherRepo) *GenerateRecurringTasks {
+	return &GenerateRecurringTasks{
+		SeriesRepo:   seriesRepo,
+		WorkflowRepo: workflowRepo,
+		WatcherRepo:  watcherRepo,
+	}
+}
+
+// Execute returns the number of instances created.
+func (uc *GenerateRecurringTasks) Execute(ctx context.Context, now time.Time) (int, error) {
+	due, err := uc.SeriesRepo.ListDue(ctx, now, recurringBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	var created int
+	var errs []error
+
+	for _, s := range due {
+		prev, err := uc.SeriesRepo.FindInstance(ctx, s.CompanyID(), s.ID(), s.Occurrences())
+		if err != nil && !apperr.IsNotFound(err) {
+			errs = append(errs, err)
+			continue
+		}
+
+		next, err := advanceSeries(ctx, uc.SeriesRepo, uc.WorkflowRepo, uc.WatcherRepo, s, prev, now)
+		if err != nil {
+			if !apperr.IsVersionMismatch(err) {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if next != nil {
+			created++
+		}
+	}
+
+	return created, errors.Join(errs...)
+}
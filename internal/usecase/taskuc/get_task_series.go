@@ -0,0 +1,31 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type GetTaskSeries struct {
+	SeriesRepo task.SeriesRepo
+}
+
+func NewGetTaskSeries(seriesRepo task.SeriesRepo) *GetTaskSeries {
+	return &GetTaskSeries{SeriesRepo: seriesRepo}
+}
+
+func (uc *GetTaskSeries) Execute(ctx context.Context, actor *user.User, seriesID id.SeriesID) (*task.Series, error) {
+	s, err := uc.SeriesRepo.FindByIDForCompany(ctx, seriesID, actor.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.CanBeViewedBy(actor) {
+		return nil, apperr.NewErrPermissionDenied("view", "task series", "series is not visible to you")
+	}
+
+	return s, nil
+}
@@ -0,0 +1,45 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type GetTaskTreeOutput struct {
+	Root        *task.Task
+	Descendants []*task.Task
+	Truncated   bool
+}
+
+type GetTaskTree struct {
+	TaskRepo task.Repo
+}
+
+func NewGetTaskTree(taskRepo task.Repo) *GetTaskTree {
+	return &GetTaskTree{TaskRepo: taskRepo}
+}
+
+func (uc *GetTaskTree) Execute(ctx context.Context, actor *user.User, taskID id.TaskID) (*GetTaskTreeOutput, error) {
+	root, err := uc.TaskRepo.FindByIDForCompany(ctx, taskID, actor.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+	if !root.CanBeViewedBy(actor) {
+		return nil, apperr.NewErrPermissionDenied("view", "task", "task is not visible to you")
+	}
+
+	subtree, err := uc.TaskRepo.ListSubtree(ctx, actor.CompanyID(), actor.ID(), taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetTaskTreeOutput{
+		Root:        root,
+		Descendants: subtree.Tasks,
+		Truncated:   subtree.Truncated,
+	}, nil
+}
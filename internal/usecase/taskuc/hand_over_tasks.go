@@ -0,0 +1,50 @@
+package taskuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type HandOverTasks struct {
+	TaskRepo    task.Repo
+	WatcherRepo task.WatcherRepo
+	Notifier    Notifier
+}
+
+func NewHandOverTasks(taskRepo task.Repo, watcherRepo task.WatcherRepo, notifier Notifier) *HandOverTasks {
+	return &HandOverTasks{
+		TaskRepo:    taskRepo,
+		WatcherRepo: watcherRepo,
+		Notifier:    notifier,
+	}
+}
+
+// Execute hands a departing user's tasks over in one step and returns how
+// many tasks changed. The users in h are expected to be checked by the
+// caller; watchers and notifications follow each change as for an update.
+func (uc *HandOverTasks) Execute(ctx context.Context, actor *user.User, h task.Handover) (int, error) {
+	if err := authz.Require(actor, user.PermissionManageUsers, "hand over", "tasks"); err != nil {
+		return 0, err
+	}
+
+	h.CompanyID = actor.CompanyID()
+	changes, err := uc.TaskRepo.HandOver(ctx, h, actor.ID(), time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	for _, change := range changes {
+		if err := syncWatchers(ctx, uc.WatcherRepo, change); err != nil {
+			return 0, err
+		}
+		if err := uc.Notifier.TaskChanged(ctx, actor, change); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(changes), nil
+}
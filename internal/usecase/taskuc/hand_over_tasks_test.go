@@ -0,0 +1,98 @@
+package taskuc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pyshx/todoapp/internal/usecase/taskuc"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+func TestHandOverTasks_Execute(t *testing.T) {
+	companyID := id.NewCompanyID()
+
+	newUser := func(role user.Role) *user.User {
+		return user.NewBuilder().
+			ID(id.NewUserID()).
+			CompanyID(companyID).
+			Email("user@test.com").
+			Role(role).
+			MustBuild()
+	}
+	admin := newUser(user.RoleAdmin)
+	editor := newUser(user.RoleEditor)
+	departing := newUser(user.RoleEditor)
+	successor := newUser(user.RoleEditor)
+
+	newTask := func(creatorID id.UserID, visibility task.Visibility, assigneeIDs ...id.UserID) *task.Task {
+		return task.NewBuilder().
+			ID(id.NewTaskID()).
+			CompanyID(companyID).
+			CreatorID(creatorID).
+			AssigneeIDs(assigneeIDs).
+			Title("Task").
+			Visibility(visibility).
+			MustBuild()
+	}
+
+	setup := func() (*mockTaskRepo, *mockWatcherRepo, *mockNotifier) {
+		userRepo := newMockUserRepo()
+		for _, u := range []*user.User{admin, editor, departing, successor} {
+			userRepo.AddUser(u)
+		}
+		return newMockTaskRepo(), newMockWatcherRepo(userRepo), &mockNotifier{}
+	}
+
+	handover := task.Handover{
+		FromID:    departing.ID(),
+		CreatorID: successor.ID(),
+		OnlyMe:    task.OnlyMeKeepPrivate,
+	}
+
+	t.Run("editor cannot hand over tasks", func(t *testing.T) {
+		taskRepo, watcherRepo, notifier := setup()
+
+		_, err := taskuc.NewHandOverTasks(taskRepo, watcherRepo, notifier).Execute(context.Background(), editor, handover)
+		if !apperr.IsPermissionDenied(err) {
+			t.Errorf("expected permission denied, got %v", err)
+		}
+	})
+
+	t.Run("successor takes over and watches private tasks", func(t *testing.T) {
+		taskRepo, watcherRepo, notifier := setup()
+		private := newTask(departing.ID(), task.VisibilityOnlyMe)
+		assigned := newTask(editor.ID(), task.VisibilityCompanyWide, departing.ID())
+		unrelated := newTask(editor.ID(), task.VisibilityCompanyWide)
+		for _, tk := range []*task.Task{private, assigned, unrelated} {
+			taskRepo.tasks[tk.ID().String()] = tk
+			watcherRepo.watchers[tk.ID()] = tk.DefaultWatcherIDs()
+		}
+
+		handedOver, err := taskuc.NewHandOverTasks(taskRepo, watcherRepo, notifier).Execute(context.Background(), admin, handover)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if handedOver != 2 {
+			t.Errorf("expected 2 tasks handed over, got %d", handedOver)
+		}
+		if len(notifier.changes) != 2 {
+			t.Errorf("expected 2 notified changes, got %d", len(notifier.changes))
+		}
+		if got := taskRepo.tasks[private.ID().String()].CreatorID(); !got.Equal(successor.ID()) {
+			t.Errorf("expected successor to be the creator, got %v", got)
+		}
+		if !watcherRepo.isWatching(private.ID(), successor.ID()) {
+			t.Error("expected successor to watch the private task")
+		}
+		if watcherRepo.isWatching(private.ID(), departing.ID()) {
+			t.Error("expected departing user to stop watching the private task")
+		}
+		if got := taskRepo.tasks[assigned.ID().String()].AssigneeIDs(); len(got) != 0 {
+			t.Errorf("expected the assignment to be removed, got %v", got)
+		}
+	})
+}
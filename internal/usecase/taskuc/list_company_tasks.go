@@ -10,6 +10,8 @@ import (
 type ListCompanyTasksInput struct {
 	PageSize int
 	Cursor   *task.PageCursor
+	Filter   task.Filter
+	Sort     task.Sort
 }
 
 type ListCompanyTasksOutput struct {
@@ -26,23 +28,25 @@ func NewListCompanyTasks(taskRepo task.Repo) *ListCompanyTasks {
 }
 
 func (uc *ListCompanyTasks) Execute(ctx context.Context, actor *user.User, input ListCompanyTasksInput) (*ListCompanyTasksOutput, error) {
-	result, err := uc.TaskRepo.ListByCompany(ctx, actor.CompanyID(), task.ListOptions{
+	if err := validateFilter(input.Filter); err != nil {
+		return nil, err
+	}
+	if err := validateSort(input.Sort); err != nil {
+		return nil, err
+	}
+
+	result, err := uc.TaskRepo.ListVisibleByCompany(ctx, actor.CompanyID(), actor.ID(), task.ListOptions{
 		PageSize: input.PageSize,
 		Cursor:   input.Cursor,
+		Filter:   input.Filter,
+		Sort:     input.Sort,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	visibleTasks := make([]*task.Task, 0, len(result.Tasks))
-	for _, t := range result.Tasks {
-		if t.CanBeViewedBy(actor) {
-			visibleTasks = append(visibleTasks, t)
-		}
-	}
-
 	return &ListCompanyTasksOutput{
-		Tasks:      visibleTasks,
+		Tasks:      result.Tasks,
 		NextCursor: result.NextCursor,
 	}, nil
 }
@@ -0,0 +1,49 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type ListDeletedTasksInput struct {
+	PageSize int
+	Cursor   *task.PageCursor
+	Sort     task.Sort
+}
+
+type ListDeletedTasksOutput struct {
+	Tasks      []*task.Task
+	NextCursor *task.PageCursor
+}
+
+type ListDeletedTasks struct {
+	TaskRepo task.Repo
+}
+
+func NewListDeletedTasks(taskRepo task.Repo) *ListDeletedTasks {
+	return &ListDeletedTasks{TaskRepo: taskRepo}
+}
+
+// Execute lists the trash, limited to tasks the actor could see before they
+// were deleted.
+func (uc *ListDeletedTasks) Execute(ctx context.Context, actor *user.User, input ListDeletedTasksInput) (*ListDeletedTasksOutput, error) {
+	if err := validateSort(input.Sort); err != nil {
+		return nil, err
+	}
+
+	result, err := uc.TaskRepo.ListDeleted(ctx, actor.CompanyID(), actor.ID(), task.ListOptions{
+		PageSize: input.PageSize,
+		Cursor:   input.Cursor,
+		Sort:     input.Sort,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListDeletedTasksOutput{
+		Tasks:      result.Tasks,
+		NextCursor: result.NextCursor,
+	}, nil
+}
@@ -3,6 +3,7 @@ package taskuc
 import (
 	"context"
 
+	"github.com/pyshx/todoapp/pkg/apperr"
 	"github.com/pyshx/todoapp/pkg/task"
 	"github.com/pyshx/todoapp/pkg/user"
 )
@@ -10,6 +11,8 @@ import (
 type ListMyTasksInput struct {
 	PageSize int
 	Cursor   *task.PageCursor
+	Filter   task.Filter
+	Sort     task.Sort
 }
 
 type ListMyTasksOutput struct {
@@ -26,9 +29,21 @@ func NewListMyTasks(taskRepo task.Repo) *ListMyTasks {
 }
 
 func (uc *ListMyTasks) Execute(ctx context.Context, actor *user.User, input ListMyTasksInput) (*ListMyTasksOutput, error) {
+	if input.Filter.AssigneeID != nil || input.Filter.Unassigned {
+		return nil, apperr.NewErrInvalidInput("filter.assignee_id", "not supported when listing your own tasks")
+	}
+	if err := validateFilter(input.Filter); err != nil {
+		return nil, err
+	}
+	if err := validateSort(input.Sort); err != nil {
+		return nil, err
+	}
+
 	result, err := uc.TaskRepo.ListByAssignee(ctx, actor.CompanyID(), actor.ID(), task.ListOptions{
 		PageSize: input.PageSize,
 		Cursor:   input.Cursor,
+		Filter:   input.Filter,
+		Sort:     input.Sort,
 	})
 	if err != nil {
 		return nil, err
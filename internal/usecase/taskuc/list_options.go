@@ -0,0 +1,59 @@
+package taskuc
+
+import (
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+func validateFilter(f task.Filter) error {
+	for _, s := range f.Statuses {
+		if !s.IsValid() {
+			return apperr.NewErrInvalidInput("filter.statuses", "must be lowercase letters, digits and underscores")
+		}
+	}
+
+	for _, p := range f.Priorities {
+		if !p.IsValid() {
+			return apperr.NewErrInvalidInput("filter.priorities", "must be none, low, medium, high, or urgent")
+		}
+	}
+
+	if f.Unassigned && f.AssigneeID != nil {
+		return apperr.NewErrInvalidInput("filter.assignee_id", "cannot be combined with unassigned")
+	}
+
+	if f.Visibility != nil && !f.Visibility.IsValid() {
+		return apperr.NewErrInvalidInput("filter.visibility", "must be only_me, company_wide, or team")
+	}
+
+	if !validRange(f.DueAfter, f.DueBefore) {
+		return apperr.NewErrInvalidInput("filter.due_before", "must be after due_after")
+	}
+	if !validRange(f.CreatedAfter, f.CreatedBefore) {
+		return apperr.NewErrInvalidInput("filter.created_before", "must be after created_after")
+	}
+	if !validRange(f.UpdatedAfter, f.UpdatedBefore) {
+		return apperr.NewErrInvalidInput("filter.updated_before", "must be after updated_after")
+	}
+
+	return nil
+}
+
+func validRange(after, before *time.Time) bool {
+	return after == nil || before == nil || after.Before(*before)
+}
+
+func validateSort(s task.Sort) error {
+	if s.IsZero() {
+		return nil
+	}
+	if !s.Field.IsValid() {
+		return apperr.NewErrInvalidInput("sort.field", "must be created_at, due_date, updated_at, title, status, or priority")
+	}
+	if s.Direction != "" && !s.Direction.IsValid() {
+		return apperr.NewErrInvalidInput("sort.direction", "must be asc or desc")
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type ListSubtasksInput struct {
+	TaskID   id.TaskID
+	PageSize int
+	Cursor   *task.PageCursor
+	Sort     task.Sort
+	// IncludeArchived also lists archived subtasks
+	IncludeArchived bool
+}
+
+type ListSubtasksOutput struct {
+	Tasks      []*task.Task
+	NextCursor *task.PageCursor
+}
+
+type ListSubtasks struct {
+	TaskRepo task.Repo
+}
+
+func NewListSubtasks(taskRepo task.Repo) *ListSubtasks {
+	return &ListSubtasks{TaskRepo: taskRepo}
+}
+
+// Execute lists the direct subtasks of a task that the actor can see.
+func (uc *ListSubtasks) Execute(ctx context.Context, actor *user.User, input ListSubtasksInput) (*ListSubtasksOutput, error) {
+	if err := validateSort(input.Sort); err != nil {
+		return nil, err
+	}
+
+	parent, err := uc.TaskRepo.FindByIDForCompany(ctx, input.TaskID, actor.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+	if !parent.CanBeViewedBy(actor) {
+		return nil, apperr.NewErrPermissionDenied("view", "task", "task is not visible to you")
+	}
+
+	result, err := uc.TaskRepo.ListVisibleByCompany(ctx, actor.CompanyID(), actor.ID(), task.ListOptions{
+		PageSize: input.PageSize,
+		Cursor:   input.Cursor,
+		Filter:   task.Filter{ParentID: &input.TaskID, IncludeArchived: input.IncludeArchived},
+		Sort:     input.Sort,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListSubtasksOutput{
+		Tasks:      result.Tasks,
+		NextCursor: result.NextCursor,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+// TaskDependenciesOutput lists the related tasks the actor can see. Hidden
+// tasks still take part in blocking, so they are counted but not returned.
+type TaskDependenciesOutput struct {
+	Tasks       []*task.Task
+	HiddenCount int
+}
+
+type ListTaskBlockers struct {
+	TaskRepo       task.Repo
+	DependencyRepo task.DependencyRepo
+}
+
+func NewListTaskBlockers(taskRepo task.Repo, dependencyRepo task.DependencyRepo) *ListTaskBlockers {
+	return &ListTaskBlockers{
+		TaskRepo:       taskRepo,
+		DependencyRepo: dependencyRepo,
+	}
+}
+
+func (uc *ListTaskBlockers) Execute(ctx context.Context, actor *user.User, taskID id.TaskID) (*TaskDependenciesOutput, error) {
+	t, err := uc.TaskRepo.FindByIDForCompany(ctx, taskID, actor.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+	if !t.CanBeViewedBy(actor) {
+		return nil, apperr.NewErrPermissionDenied("view", "task", "task is not visible to you")
+	}
+
+	blockers, err := uc.DependencyRepo.ListBlockers(ctx, actor.CompanyID(), taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return visibleDependencies(actor, blockers), nil
+}
+
+func visibleDependencies(actor *user.User, tasks []*task.Task) *TaskDependenciesOutput {
+	output := &TaskDependenciesOutput{}
+	for _, t := range tasks {
+		if t.CanBeViewedBy(actor) {
+			output.Tasks = append(output.Tasks, t)
+		} else {
+			output.HiddenCount++
+		}
+	}
+	return output
+}
@@ -0,0 +1,39 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type ListTaskDependents struct {
+	TaskRepo       task.Repo
+	DependencyRepo task.DependencyRepo
+}
+
+func NewListTaskDependents(taskRepo task.Repo, dependencyRepo task.DependencyRepo) *ListTaskDependents {
+	return &ListTaskDependents{
+		TaskRepo:       taskRepo,
+		DependencyRepo: dependencyRepo,
+	}
+}
+
+func (uc *ListTaskDependents) Execute(ctx context.Context, actor *user.User, taskID id.TaskID) (*TaskDependenciesOutput, error) {
+	t, err := uc.TaskRepo.FindByIDForCompany(ctx, taskID, actor.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+	if !t.CanBeViewedBy(actor) {
+		return nil, apperr.NewErrPermissionDenied("view", "task", "task is not visible to you")
+	}
+
+	dependents, err := uc.DependencyRepo.ListDependents(ctx, actor.CompanyID(), taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return visibleDependencies(actor, dependents), nil
+}
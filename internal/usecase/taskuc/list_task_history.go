@@ -0,0 +1,44 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type ListTaskHistoryInput struct {
+	TaskID   id.TaskID
+	PageSize int
+	Cursor   *task.HistoryCursor
+}
+
+type ListTaskHistory struct {
+	TaskRepo    task.Repo
+	HistoryRepo task.HistoryRepo
+}
+
+func NewListTaskHistory(taskRepo task.Repo, historyRepo task.HistoryRepo) *ListTaskHistory {
+	return &ListTaskHistory{
+		TaskRepo:    taskRepo,
+		HistoryRepo: historyRepo,
+	}
+}
+
+// Execute lists the changes to a visible task, newest first.
+func (uc *ListTaskHistory) Execute(ctx context.Context, actor *user.User, input ListTaskHistoryInput) (*task.HistoryListResult, error) {
+	t, err := uc.TaskRepo.FindByIDForCompany(ctx, input.TaskID, actor.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+	if !t.CanBeViewedBy(actor) {
+		return nil, apperr.NewErrPermissionDenied("view", "task", "task is not visible to you")
+	}
+
+	return uc.HistoryRepo.ListByTask(ctx, actor.CompanyID(), input.TaskID, task.HistoryListOptions{
+		PageSize: input.PageSize,
+		Cursor:   input.Cursor,
+	})
+}
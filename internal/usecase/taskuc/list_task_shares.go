@@ -0,0 +1,31 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type ListTaskShares struct {
+	TaskRepo task.Repo
+}
+
+func NewListTaskShares(taskRepo task.Repo) *ListTaskShares {
+	return &ListTaskShares{TaskRepo: taskRepo}
+}
+
+// Execute lists who a visible task is shared with, oldest share first.
+func (uc *ListTaskShares) Execute(ctx context.Context, actor *user.User, taskID id.TaskID) ([]task.Share, error) {
+	t, err := uc.TaskRepo.FindByIDForCompany(ctx, taskID, actor.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+	if !t.CanBeViewedBy(actor) {
+		return nil, apperr.NewErrPermissionDenied("view", "task", "task is not visible to you")
+	}
+
+	return t.Shares(), nil
+}
@@ -0,0 +1,34 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type ListTaskWatchers struct {
+	TaskRepo    task.Repo
+	WatcherRepo task.WatcherRepo
+}
+
+func NewListTaskWatchers(taskRepo task.Repo, watcherRepo task.WatcherRepo) *ListTaskWatchers {
+	return &ListTaskWatchers{
+		TaskRepo:    taskRepo,
+		WatcherRepo: watcherRepo,
+	}
+}
+
+func (uc *ListTaskWatchers) Execute(ctx context.Context, actor *user.User, taskID id.TaskID) ([]*user.User, error) {
+	t, err := uc.TaskRepo.FindByIDForCompany(ctx, taskID, actor.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+	if !t.CanBeViewedBy(actor) {
+		return nil, apperr.NewErrPermissionDenied("view", "task", "task is not visible to you")
+	}
+
+	return uc.WatcherRepo.ListByTask(ctx, actor.CompanyID(), taskID)
+}
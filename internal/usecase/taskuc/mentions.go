@@ -0,0 +1,26 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/internal/usecase/mentionuc"
+	"github.com/pyshx/todoapp/pkg/mention"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+// descriptionMentions resolves the users mentioned in t's description,
+// refusing mentions of users who cannot see t.
+func descriptionMentions(ctx context.Context, rm *mentionuc.RecordMentions, actor *user.User, t *task.Task) ([]*user.User, error) {
+	if t.Description() == nil {
+		return nil, nil
+	}
+	return rm.Resolve(ctx, actor, t, "description", *t.Description())
+}
+
+// recordDescriptionMentions stores the description's mentions once t is
+// saved, notifying newly mentioned users.
+func recordDescriptionMentions(ctx context.Context, rm *mentionuc.RecordMentions, actor *user.User, t *task.Task, mentioned []*user.User) error {
+	source := mention.Source{CompanyID: t.CompanyID(), TaskID: t.ID()}
+	return rm.Execute(ctx, actor, source, mentioned)
+}
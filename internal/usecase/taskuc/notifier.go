@@ -0,0 +1,16 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+// Notifier is told about every task a use case saves, so that the people
+// involved can be notified. Use cases do not know who is notified or how.
+type Notifier interface {
+	// TaskChanged is called once the task is saved and its watchers are up
+	// to date.
+	TaskChanged(ctx context.Context, actor *user.User, change task.Change) error
+}
@@ -0,0 +1,32 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type PurgeTask struct {
+	TaskRepo task.Repo
+}
+
+func NewPurgeTask(taskRepo task.Repo) *PurgeTask {
+	return &PurgeTask{TaskRepo: taskRepo}
+}
+
+// Execute permanently removes a task from the trash. Live tasks must be
+// deleted first.
+func (uc *PurgeTask) Execute(ctx context.Context, actor *user.User, taskID id.TaskID) error {
+	if err := authz.Require(actor, user.PermissionEditTasks, "purge", "task"); err != nil {
+		return err
+	}
+
+	if err := findDeletedTask(ctx, uc.TaskRepo, actor, taskID); err != nil {
+		return err
+	}
+
+	return uc.TaskRepo.Purge(ctx, taskID, actor.CompanyID(), actor.ID())
+}
@@ -0,0 +1,27 @@
+package taskuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+// PurgeTrash permanently removes tasks that have been in the trash for longer
+// than Retention. It runs in the background with no actor.
+type PurgeTrash struct {
+	TaskRepo  task.Repo
+	Retention time.Duration
+}
+
+func NewPurgeTrash(taskRepo task.Repo, retention time.Duration) *PurgeTrash {
+	return &PurgeTrash{
+		TaskRepo:  taskRepo,
+		Retention: retention,
+	}
+}
+
+// Execute returns the number of tasks purged.
+func (uc *PurgeTrash) Execute(ctx context.Context, now time.Time) (int, error) {
+	return uc.TaskRepo.PurgeDeletedBefore(ctx, now.Add(-uc.Retention))
+}
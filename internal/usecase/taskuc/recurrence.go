@@ -0,0 +1,62 @@
+package taskuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/recurrence"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+// RecurrenceInput describes how a task repeats. Rule is an RRULE subset and
+// TimeZone an IANA name that defaults to UTC.
+type RecurrenceInput struct {
+	Rule     string
+	TimeZone string
+}
+
+func parseRecurrence(input RecurrenceInput) (recurrence.Rule, *time.Location, error) {
+	rule, err := recurrence.Parse(input.Rule)
+	if err != nil {
+		return recurrence.Rule{}, nil, apperr.NewErrInvalidInput("recurrence.rule", err.Error())
+	}
+
+	if input.TimeZone == "" {
+		return rule, time.UTC, nil
+	}
+	loc, err := time.LoadLocation(input.TimeZone)
+	if err != nil {
+		return recurrence.Rule{}, nil, apperr.NewErrInvalidInput("recurrence.time_zone", "unknown time zone")
+	}
+
+	return rule, loc, nil
+}
+
+// advanceSeries creates the instance that follows prev, or ends the series
+// once its rule has no further occurrences. prev may be nil when the latest
+// instance was deleted. The new instance starts in the initial status of the
+// company's workflow. It returns the new instance, if any.
+func advanceSeries(ctx context.Context, repo task.SeriesRepo, workflowRepo task.WorkflowRepo, watcherRepo task.WatcherRepo, s *task.Series, prev *task.Task, now time.Time) (*task.Task, error) {
+	dueAt, ok := s.NextOccurrence()
+	if !ok {
+		return nil, repo.Advance(ctx, s.End(now), s.Version(), nil)
+	}
+
+	workflow, err := workflowRepo.FindByCompany(ctx, s.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+
+	advanced, next := s.Advance(prev, id.NewTaskID(), dueAt, workflow.Initial(), now)
+	if err := repo.Advance(ctx, advanced, s.Version(), next); err != nil {
+		return nil, err
+	}
+
+	if err := watchNewTask(ctx, watcherRepo, next); err != nil {
+		return nil, err
+	}
+
+	return next, nil
+}
@@ -0,0 +1,44 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type RemoveTaskDependencyInput struct {
+	BlockerID id.TaskID
+	BlockedID id.TaskID
+}
+
+type RemoveTaskDependency struct {
+	TaskRepo       task.Repo
+	DependencyRepo task.DependencyRepo
+}
+
+func NewRemoveTaskDependency(taskRepo task.Repo, dependencyRepo task.DependencyRepo) *RemoveTaskDependency {
+	return &RemoveTaskDependency{
+		TaskRepo:       taskRepo,
+		DependencyRepo: dependencyRepo,
+	}
+}
+
+func (uc *RemoveTaskDependency) Execute(ctx context.Context, actor *user.User, input RemoveTaskDependencyInput) error {
+	if err := authz.Require(actor, user.PermissionEditTasks, "update", "task"); err != nil {
+		return err
+	}
+
+	blocked, err := uc.TaskRepo.FindByIDForCompany(ctx, input.BlockedID, actor.CompanyID())
+	if err != nil {
+		return err
+	}
+	if !blocked.CanBeViewedBy(actor) {
+		return apperr.NewErrPermissionDenied("view", "task", "task is not visible to you")
+	}
+
+	return uc.DependencyRepo.Remove(ctx, actor.CompanyID(), input.BlockerID, input.BlockedID)
+}
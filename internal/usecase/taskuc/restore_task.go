@@ -0,0 +1,49 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type RestoreTask struct {
+	TaskRepo task.Repo
+}
+
+func NewRestoreTask(taskRepo task.Repo) *RestoreTask {
+	return &RestoreTask{TaskRepo: taskRepo}
+}
+
+// Execute takes a task out of the trash and returns it as it now stands.
+func (uc *RestoreTask) Execute(ctx context.Context, actor *user.User, taskID id.TaskID) (*task.Task, error) {
+	if err := authz.Require(actor, user.PermissionEditTasks, "restore", "task"); err != nil {
+		return nil, err
+	}
+
+	if err := findDeletedTask(ctx, uc.TaskRepo, actor, taskID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.TaskRepo.Restore(ctx, taskID, actor.CompanyID(), actor.ID()); err != nil {
+		return nil, err
+	}
+
+	return uc.TaskRepo.FindByIDForCompany(ctx, taskID, actor.CompanyID())
+}
+
+// findDeletedTask checks that taskID is in the trash and was visible to the
+// actor. Hidden tasks are reported as missing, as ListDeletedTasks omits them.
+func findDeletedTask(ctx context.Context, repo task.Repo, actor *user.User, taskID id.TaskID) error {
+	t, err := repo.FindDeletedByIDForCompany(ctx, taskID, actor.CompanyID())
+	if err != nil {
+		return err
+	}
+	if !t.CanBeViewedBy(actor) {
+		return apperr.NewErrNotFound("task", taskID.String())
+	}
+	return nil
+}
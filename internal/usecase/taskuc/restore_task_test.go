@@ -0,0 +1,81 @@
+package taskuc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pyshx/todoapp/internal/usecase/taskuc"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+func TestRestoreTask_Execute(t *testing.T) {
+	companyID := id.NewCompanyID()
+
+	newUser := func(role user.Role) *user.User {
+		return user.NewBuilder().
+			ID(id.NewUserID()).
+			CompanyID(companyID).
+			Email("user@test.com").
+			Role(role).
+			MustBuild()
+	}
+	editor := newUser(user.RoleEditor)
+	otherEditor := newUser(user.RoleEditor)
+	viewer := newUser(user.RoleViewer)
+
+	newTask := func(visibility task.Visibility) *task.Task {
+		return task.NewBuilder().
+			ID(id.NewTaskID()).
+			CompanyID(companyID).
+			CreatorID(editor.ID()).
+			Title("Task").
+			Visibility(visibility).
+			MustBuild()
+	}
+
+	tests := []struct {
+		name       string
+		actor      *user.User
+		visibility task.Visibility
+		live       bool
+		wantErr    func(error) bool
+	}{
+		{name: "editor restores", actor: editor, visibility: task.VisibilityCompanyWide},
+		{name: "viewer cannot restore", actor: viewer, visibility: task.VisibilityCompanyWide, wantErr: apperr.IsPermissionDenied},
+		{name: "hidden task is not found", actor: otherEditor, visibility: task.VisibilityOnlyMe, wantErr: apperr.IsNotFound},
+		{name: "live task is not in the trash", actor: editor, visibility: task.VisibilityCompanyWide, live: true, wantErr: apperr.IsNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepo := newMockTaskRepo()
+			tk := newTask(tt.visibility)
+			if tt.live {
+				taskRepo.tasks[tk.ID().String()] = tk
+			} else {
+				taskRepo.trash[tk.ID().String()] = tk
+			}
+
+			restored, err := taskuc.NewRestoreTask(taskRepo).Execute(context.Background(), tt.actor, tk.ID())
+
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !restored.ID().Equal(tk.ID()) {
+				t.Errorf("expected restored task %s, got %s", tk.ID(), restored.ID())
+			}
+			if _, ok := taskRepo.trash[tk.ID().String()]; ok {
+				t.Error("expected task to leave the trash")
+			}
+		})
+	}
+}
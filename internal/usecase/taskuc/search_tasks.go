@@ -0,0 +1,55 @@
+package taskuc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+const maxSearchQueryLength = 200
+
+type SearchTasksInput struct {
+	Query    string
+	PageSize int
+	Cursor   *task.SearchCursor
+}
+
+type SearchTasksOutput struct {
+	Hits       []*task.SearchHit
+	NextCursor *task.SearchCursor
+}
+
+type SearchTasks struct {
+	TaskRepo task.Repo
+}
+
+func NewSearchTasks(taskRepo task.Repo) *SearchTasks {
+	return &SearchTasks{TaskRepo: taskRepo}
+}
+
+func (uc *SearchTasks) Execute(ctx context.Context, actor *user.User, input SearchTasksInput) (*SearchTasksOutput, error) {
+	query := strings.TrimSpace(input.Query)
+	if query == "" {
+		return nil, apperr.NewErrInvalidInput("query", "cannot be empty")
+	}
+	if len(query) > maxSearchQueryLength {
+		return nil, apperr.NewErrInvalidInput("query", "must be at most 200 characters")
+	}
+
+	result, err := uc.TaskRepo.Search(ctx, actor.CompanyID(), actor.ID(), task.SearchOptions{
+		Query:    query,
+		PageSize: input.PageSize,
+		Cursor:   input.Cursor,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SearchTasksOutput{
+		Hits:       result.Hits,
+		NextCursor: result.NextCursor,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type ShareTaskInput struct {
+	TaskID id.TaskID
+	UserID id.UserID
+	Role   task.ShareRole
+}
+
+type ShareTask struct {
+	TaskRepo    task.Repo
+	UserRepo    user.Repo
+	ShareRepo   task.ShareRepo
+	WatcherRepo task.WatcherRepo
+}
+
+func NewShareTask(taskRepo task.Repo, userRepo user.Repo, shareRepo task.ShareRepo, watcherRepo task.WatcherRepo) *ShareTask {
+	return &ShareTask{
+		TaskRepo:    taskRepo,
+		UserRepo:    userRepo,
+		ShareRepo:   shareRepo,
+		WatcherRepo: watcherRepo,
+	}
+}
+
+// Execute lets the creator share a task with an active user of their
+// company, who starts watching it. Sharing again with the same user only
+// changes their role.
+func (uc *ShareTask) Execute(ctx context.Context, actor *user.User, input ShareTaskInput) (task.Share, error) {
+	if !input.Role.IsValid() {
+		return task.Share{}, apperr.NewErrInvalidInput("role", "must be viewer or editor")
+	}
+
+	t, err := uc.TaskRepo.FindByIDForCompany(ctx, input.TaskID, actor.CompanyID())
+	if err != nil {
+		return task.Share{}, err
+	}
+	if !t.CreatorID().Equal(actor.ID()) {
+		return task.Share{}, apperr.NewErrPermissionDenied("share", "task", "only the creator can share a task")
+	}
+
+	if input.UserID.Equal(t.CreatorID()) {
+		return task.Share{}, apperr.NewErrInvalidInput("user_id", "cannot share a task with its creator")
+	}
+	if err := checkShareUser(ctx, uc.UserRepo, actor, input.UserID); err != nil {
+		return task.Share{}, err
+	}
+
+	share := task.Share{UserID: input.UserID, Role: input.Role}
+	if err := uc.ShareRepo.Save(ctx, actor.CompanyID(), t.ID(), share); err != nil {
+		return task.Share{}, err
+	}
+
+	if _, ok := t.SharedRole(input.UserID); !ok {
+		if err := uc.WatcherRepo.Add(ctx, actor.CompanyID(), t.ID(), []id.UserID{input.UserID}); err != nil {
+			return task.Share{}, err
+		}
+	}
+
+	return share, nil
+}
+
+// checkShareUser makes sure a task is only shared with active users of the
+// actor's company.
+func checkShareUser(ctx context.Context, repo user.Repo, actor *user.User, userID id.UserID) error {
+	u, err := repo.FindByID(ctx, userID)
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return apperr.NewErrInvalidInput("user_id", "user not found")
+		}
+		return err
+	}
+	if !u.CompanyID().Equal(actor.CompanyID()) {
+		return apperr.NewErrInvalidInput("user_id", "user not found")
+	}
+	if !u.IsActive() {
+		return apperr.NewErrInvalidInput("user_id", "user is deactivated")
+	}
+	return nil
+}
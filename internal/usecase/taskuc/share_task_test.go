@@ -0,0 +1,181 @@
+package taskuc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/usecase/taskuc"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type mockShareRepo struct {
+	shares map[id.TaskID][]task.Share
+}
+
+func newMockShareRepo() *mockShareRepo {
+	return &mockShareRepo{shares: make(map[id.TaskID][]task.Share)}
+}
+
+func (m *mockShareRepo) Save(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, s task.Share) error {
+	for i, existing := range m.shares[taskID] {
+		if existing.UserID.Equal(s.UserID) {
+			m.shares[taskID][i] = s
+			return nil
+		}
+	}
+	m.shares[taskID] = append(m.shares[taskID], s)
+	return nil
+}
+
+func (m *mockShareRepo) Delete(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, userID id.UserID) error {
+	for i, existing := range m.shares[taskID] {
+		if existing.UserID.Equal(userID) {
+			m.shares[taskID] = append(m.shares[taskID][:i], m.shares[taskID][i+1:]...)
+			return nil
+		}
+	}
+	return apperr.NewErrNotFound("task share", userID.String())
+}
+
+func TestShareTask_Execute(t *testing.T) {
+	companyID := id.NewCompanyID()
+
+	newUser := func(companyID id.CompanyID, role user.Role) *user.User {
+		return user.NewBuilder().
+			ID(id.NewUserID()).
+			CompanyID(companyID).
+			Email("user@test.com").
+			Role(role).
+			MustBuild()
+	}
+	creator := newUser(companyID, user.RoleEditor)
+	otherEditor := newUser(companyID, user.RoleEditor)
+	viewer := newUser(companyID, user.RoleViewer)
+	outsider := newUser(id.NewCompanyID(), user.RoleViewer)
+	deactivatedAt := time.Now()
+	deactivated := user.NewBuilder().
+		ID(id.NewUserID()).
+		CompanyID(companyID).
+		Email("gone@test.com").
+		Role(user.RoleViewer).
+		DeactivatedAt(&deactivatedAt).
+		MustBuild()
+
+	tests := []struct {
+		name    string
+		actor   *user.User
+		target  *user.User
+		role    task.ShareRole
+		wantErr func(error) bool
+	}{
+		{name: "creator shares with a viewer", actor: creator, target: viewer, role: task.ShareRoleEditor},
+		{name: "other editor cannot share", actor: otherEditor, target: viewer, role: task.ShareRoleViewer, wantErr: apperr.IsPermissionDenied},
+		{name: "role is required", actor: creator, target: viewer, wantErr: apperr.IsInvalidInput},
+		{name: "cannot share with the creator", actor: creator, target: creator, role: task.ShareRoleViewer, wantErr: apperr.IsInvalidInput},
+		{name: "cannot share outside the company", actor: creator, target: outsider, role: task.ShareRoleViewer, wantErr: apperr.IsInvalidInput},
+		{name: "cannot share with deactivated users", actor: creator, target: deactivated, role: task.ShareRoleViewer, wantErr: apperr.IsInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepo := newMockTaskRepo()
+			userRepo := newMockUserRepo()
+			for _, u := range []*user.User{creator, otherEditor, viewer, outsider, deactivated} {
+				userRepo.AddUser(u)
+			}
+			shareRepo := newMockShareRepo()
+			watcherRepo := newMockWatcherRepo(userRepo)
+
+			tk := task.NewBuilder().
+				ID(id.NewTaskID()).
+				CompanyID(companyID).
+				CreatorID(creator.ID()).
+				Title("Task").
+				Visibility(task.VisibilityOnlyMe).
+				MustBuild()
+			taskRepo.tasks[tk.ID().String()] = tk
+
+			uc := taskuc.NewShareTask(taskRepo, userRepo, shareRepo, watcherRepo)
+			share, err := uc.Execute(context.Background(), tt.actor, taskuc.ShareTaskInput{
+				TaskID: tk.ID(),
+				UserID: tt.target.ID(),
+				Role:   tt.role,
+			})
+
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if len(shareRepo.shares[tk.ID()]) != 0 {
+					t.Error("expected no share to be saved")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if share.Role != tt.role || !share.UserID.Equal(tt.target.ID()) {
+				t.Errorf("unexpected share %+v", share)
+			}
+			if len(shareRepo.shares[tk.ID()]) != 1 {
+				t.Errorf("expected 1 saved share, got %d", len(shareRepo.shares[tk.ID()]))
+			}
+			if !watcherRepo.isWatching(tk.ID(), tt.target.ID()) {
+				t.Error("expected the shared user to watch the task")
+			}
+		})
+	}
+}
+
+func TestDeleteTask_SharedEditor(t *testing.T) {
+	companyID := id.NewCompanyID()
+	creator := user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email("c@test.com").Role(user.RoleEditor).MustBuild()
+	sharedEditor := user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email("e@test.com").Role(user.RoleViewer).MustBuild()
+	sharedViewer := user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email("v@test.com").Role(user.RoleViewer).MustBuild()
+
+	tests := []struct {
+		name    string
+		actor   *user.User
+		wantErr func(error) bool
+	}{
+		{name: "shared editor deletes", actor: sharedEditor},
+		{name: "shared viewer cannot delete", actor: sharedViewer, wantErr: apperr.IsPermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepo := newMockTaskRepo()
+			tk := task.NewBuilder().
+				ID(id.NewTaskID()).
+				CompanyID(companyID).
+				CreatorID(creator.ID()).
+				Title("Task").
+				Visibility(task.VisibilityOnlyMe).
+				Shares([]task.Share{
+					{UserID: sharedEditor.ID(), Role: task.ShareRoleEditor},
+					{UserID: sharedViewer.ID(), Role: task.ShareRoleViewer},
+				}).
+				MustBuild()
+			taskRepo.tasks[tk.ID().String()] = tk
+
+			err := taskuc.NewDeleteTask(taskRepo).Execute(context.Background(), tt.actor, tk.ID())
+
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if _, ok := taskRepo.tasks[tk.ID().String()]; ok {
+				t.Error("expected task to be deleted")
+			}
+		})
+	}
+}
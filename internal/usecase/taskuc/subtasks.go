@@ -0,0 +1,35 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+// checkParent validates parentID as the parent of taskID, or of a new task
+// when taskID is nil. The parent must be visible to the actor and must not
+// be the task itself; TaskRepo.Update rejects descendants.
+func checkParent(ctx context.Context, repo task.Repo, actor *user.User, taskID *id.TaskID, parentID id.TaskID) error {
+	parent, err := repo.FindByIDForCompany(ctx, parentID, actor.CompanyID())
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return apperr.NewErrInvalidInput("parent_id", "task not found")
+		}
+		return err
+	}
+	if !parent.CanBeViewedBy(actor) {
+		return apperr.NewErrInvalidInput("parent_id", "task not found")
+	}
+
+	if taskID == nil {
+		return nil
+	}
+	if parentID.Equal(*taskID) {
+		return apperr.NewErrInvalidInput("parent_id", "a task cannot be its own parent")
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type UnarchiveTask struct {
+	TaskRepo task.Repo
+	Notifier Notifier
+}
+
+func NewUnarchiveTask(taskRepo task.Repo, notifier Notifier) *UnarchiveTask {
+	return &UnarchiveTask{
+		TaskRepo: taskRepo,
+		Notifier: notifier,
+	}
+}
+
+// Execute brings an archived task back into listings.
+func (uc *UnarchiveTask) Execute(ctx context.Context, actor *user.User, taskID id.TaskID) (*task.Task, error) {
+	return setArchived(ctx, uc.TaskRepo, uc.Notifier, actor, taskID, false)
+}
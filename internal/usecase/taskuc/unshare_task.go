@@ -0,0 +1,63 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type UnshareTaskInput struct {
+	TaskID id.TaskID
+	UserID id.UserID
+}
+
+type UnshareTask struct {
+	TaskRepo    task.Repo
+	ShareRepo   task.ShareRepo
+	WatcherRepo task.WatcherRepo
+}
+
+func NewUnshareTask(taskRepo task.Repo, shareRepo task.ShareRepo, watcherRepo task.WatcherRepo) *UnshareTask {
+	return &UnshareTask{
+		TaskRepo:    taskRepo,
+		ShareRepo:   shareRepo,
+		WatcherRepo: watcherRepo,
+	}
+}
+
+// Execute removes a share. The creator may remove any share, and users may
+// give up a share of their own. The user stops watching the task if it is no
+// longer visible to them.
+func (uc *UnshareTask) Execute(ctx context.Context, actor *user.User, input UnshareTaskInput) error {
+	t, err := uc.TaskRepo.FindByIDForCompany(ctx, input.TaskID, actor.CompanyID())
+	if err != nil {
+		return err
+	}
+	if !t.CreatorID().Equal(actor.ID()) && !input.UserID.Equal(actor.ID()) {
+		return apperr.NewErrPermissionDenied("unshare", "task", "only the creator can unshare a task")
+	}
+
+	if err := uc.ShareRepo.Delete(ctx, actor.CompanyID(), t.ID(), input.UserID); err != nil {
+		return err
+	}
+
+	unshared, err := uc.TaskRepo.FindByIDForCompany(ctx, input.TaskID, actor.CompanyID())
+	if err != nil {
+		return err
+	}
+
+	watchers, err := uc.WatcherRepo.ListByTask(ctx, actor.CompanyID(), t.ID())
+	if err != nil {
+		return err
+	}
+
+	hidden := unshared.HiddenWatchers(watchers)
+	if len(hidden) == 0 {
+		return nil
+	}
+
+	return uc.WatcherRepo.Remove(ctx, actor.CompanyID(), t.ID(), hidden)
+}
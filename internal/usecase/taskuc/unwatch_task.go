@@ -0,0 +1,31 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type UnwatchTask struct {
+	TaskRepo    task.Repo
+	WatcherRepo task.WatcherRepo
+}
+
+func NewUnwatchTask(taskRepo task.Repo, watcherRepo task.WatcherRepo) *UnwatchTask {
+	return &UnwatchTask{
+		TaskRepo:    taskRepo,
+		WatcherRepo: watcherRepo,
+	}
+}
+
+// Execute unsubscribes the actor. Creators and assignees may unwatch too;
+// they are only subscribed again if they are assigned the task later.
+func (uc *UnwatchTask) Execute(ctx context.Context, actor *user.User, taskID id.TaskID) error {
+	if _, err := uc.TaskRepo.FindByIDForCompany(ctx, taskID, actor.CompanyID()); err != nil {
+		return err
+	}
+
+	return uc.WatcherRepo.Remove(ctx, actor.CompanyID(), taskID, []id.UserID{actor.ID()})
+}
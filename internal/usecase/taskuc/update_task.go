@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/pyshx/todoapp/internal/usecase/mentionuc"
 	"github.com/pyshx/todoapp/pkg/apperr"
 	"github.com/pyshx/todoapp/pkg/id"
 	"github.com/pyshx/todoapp/pkg/task"
@@ -15,73 +16,265 @@ type UpdateTaskInput struct {
 	Version     int
 	Title       *string
 	Description **string
-	AssigneeID  **id.UserID
-	DueDate     **time.Time
-	Visibility  *task.Visibility
-	Status      *task.Status
+	// AssigneeID replaces every assignee; the additions and removals apply
+	// on top of it.
+	AssigneeID        **id.UserID
+	AddAssigneeIDs    []id.UserID
+	RemoveAssigneeIDs []id.UserID
+	ParentID          **id.TaskID
+	DueDate           **time.Time
+	Visibility        *task.Visibility
+	Status            *task.Status
+	Priority          *task.Priority
+	AddLabelIDs       []id.LabelID
+	RemoveLabelIDs    []id.LabelID
+	// TeamID picks the team when the task is or becomes team-visible.
+	TeamID *id.TeamID
 }
 
 type UpdateTask struct {
-	TaskRepo task.Repo
-	UserRepo user.Repo
+	TaskRepo       task.Repo
+	UserRepo       user.Repo
+	DependencyRepo task.DependencyRepo
+	SeriesRepo     task.SeriesRepo
+	WorkflowRepo   task.WorkflowRepo
+	WatcherRepo    task.WatcherRepo
+	RecordMentions *mentionuc.RecordMentions
+	Notifier       Notifier
 }
 
-func NewUpdateTask(taskRepo task.Repo, userRepo user.Repo) *UpdateTask {
+func NewUpdateTask(taskRepo task.Repo, userRepo user.Repo, dependencyRepo task.DependencyRepo, seriesRepo task.SeriesRepo, workflowRepo task.WorkflowRepo, watcherRepo task.WatcherRepo, recordMentions *mentionuc.RecordMentions, notifier Notifier) *UpdateTask {
 	return &UpdateTask{
-		TaskRepo: taskRepo,
-		UserRepo: userRepo,
+		TaskRepo:       taskRepo,
+		UserRepo:       userRepo,
+		DependencyRepo: dependencyRepo,
+		SeriesRepo:     seriesRepo,
+		WorkflowRepo:   workflowRepo,
+		WatcherRepo:    watcherRepo,
+		RecordMentions: recordMentions,
+		Notifier:       notifier,
 	}
 }
 
 func (uc *UpdateTask) Execute(ctx context.Context, actor *user.User, input UpdateTaskInput) (*task.Task, error) {
-	if !actor.CanEdit() {
-		return nil, apperr.NewErrPermissionDenied("update", "task", "viewer role cannot update tasks")
-	}
-
 	existingTask, err := uc.TaskRepo.FindByIDForCompany(ctx, input.TaskID, actor.CompanyID())
 	if err != nil {
 		return nil, err
 	}
 
+	if err := checkFieldPermissions(existingTask, actor, input.fields()); err != nil {
+		return nil, err
+	}
+
 	if input.Title != nil && *input.Title == "" {
 		return nil, apperr.NewErrInvalidInput("title", "cannot be empty")
 	}
 
 	if input.Visibility != nil && !input.Visibility.IsValid() {
-		return nil, apperr.NewErrInvalidInput("visibility", "must be only_me or company_wide")
+		return nil, apperr.NewErrInvalidInput("visibility", "must be only_me, company_wide, or team")
 	}
 
-	if input.Status != nil && !input.Status.IsValid() {
-		return nil, apperr.NewErrInvalidInput("status", "must be todo, in_progress, or done")
+	var status *task.WorkflowStatus
+	if input.Status != nil {
+		s, err := uc.checkTransition(ctx, existingTask, *input.Status)
+		if err != nil {
+			return nil, err
+		}
+		status = &s
+	}
+
+	if status != nil && status.Category == task.CategoryDone && !existingTask.IsDone() {
+		if err := uc.checkBlockers(ctx, actor, input.TaskID); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Priority != nil && !input.Priority.IsValid() {
+		return nil, apperr.NewErrInvalidInput("priority", "must be none, low, medium, high, or urgent")
 	}
 
 	if input.AssigneeID != nil && *input.AssigneeID != nil {
-		assignee, err := uc.UserRepo.FindByID(ctx, **input.AssigneeID)
-		if err != nil {
-			if apperr.IsNotFound(err) {
-				return nil, apperr.NewErrInvalidInput("assignee_id", "user not found")
-			}
+		if err := checkAssignees(ctx, uc.UserRepo, actor, "assignee_id", []id.UserID{**input.AssigneeID}); err != nil {
 			return nil, err
 		}
-		if !assignee.CompanyID().Equal(actor.CompanyID()) {
-			return nil, apperr.NewErrInvalidInput("assignee_id", "assignee must be in the same company")
+	}
+	if err := checkAssignees(ctx, uc.UserRepo, actor, "add_assignee_ids", input.AddAssigneeIDs); err != nil {
+		return nil, err
+	}
+
+	if input.ParentID != nil && *input.ParentID != nil {
+		if err := checkParent(ctx, uc.TaskRepo, actor, &input.TaskID, **input.ParentID); err != nil {
+			return nil, err
 		}
 	}
 
 	update := task.Update{
-		Title:       input.Title,
-		Description: input.Description,
-		AssigneeID:  input.AssigneeID,
-		DueDate:     input.DueDate,
-		Visibility:  input.Visibility,
-		Status:      input.Status,
+		Title:             input.Title,
+		Description:       input.Description,
+		AssigneeID:        input.AssigneeID,
+		AddAssigneeIDs:    input.AddAssigneeIDs,
+		RemoveAssigneeIDs: input.RemoveAssigneeIDs,
+		ParentID:          input.ParentID,
+		DueDate:           input.DueDate,
+		Visibility:        input.Visibility,
+		TeamID:            input.TeamID,
+		Status:            status,
+		Priority:          input.Priority,
+		AddLabelIDs:       input.AddLabelIDs,
+		RemoveLabelIDs:    input.RemoveLabelIDs,
+	}
+
+	now := time.Now()
+	updatedTask := existingTask.ApplyUpdate(update, now)
+	if err := checkTeam(updatedTask.Visibility(), updatedTask.TeamID()); err != nil {
+		return nil, err
 	}
 
-	updatedTask := existingTask.ApplyUpdate(update, time.Now())
+	var mentioned []*user.User
+	if input.Description != nil {
+		if mentioned, err = descriptionMentions(ctx, uc.RecordMentions, actor, updatedTask); err != nil {
+			return nil, err
+		}
+	}
 
-	if err := uc.TaskRepo.Update(ctx, updatedTask, input.Version); err != nil {
+	if err := uc.TaskRepo.Update(ctx, updatedTask, input.Version, actor.ID()); err != nil {
 		return nil, err
 	}
 
+	if input.Description != nil {
+		if err := recordDescriptionMentions(ctx, uc.RecordMentions, actor, updatedTask, mentioned); err != nil {
+			return nil, err
+		}
+	}
+
+	change := task.NewChange(existingTask, updatedTask)
+	if err := syncWatchers(ctx, uc.WatcherRepo, change); err != nil {
+		return nil, err
+	}
+
+	if err := uc.Notifier.TaskChanged(ctx, actor, change); err != nil {
+		return nil, err
+	}
+
+	if updatedTask.SeriesID() != nil && updatedTask.IsDone() && !existingTask.IsDone() {
+		if err := uc.advanceSeries(ctx, updatedTask, now); err != nil {
+			return nil, err
+		}
+	}
+
 	return updatedTask, nil
 }
+
+// fields lists the task fields the input sets.
+func (input UpdateTaskInput) fields() []task.Field {
+	var fields []task.Field
+	if input.Title != nil {
+		fields = append(fields, task.FieldTitle)
+	}
+	if input.Description != nil {
+		fields = append(fields, task.FieldDescription)
+	}
+	if input.AssigneeID != nil || len(input.AddAssigneeIDs) > 0 || len(input.RemoveAssigneeIDs) > 0 {
+		fields = append(fields, task.FieldAssignees)
+	}
+	if input.ParentID != nil {
+		fields = append(fields, task.FieldParent)
+	}
+	if input.DueDate != nil {
+		fields = append(fields, task.FieldDueDate)
+	}
+	if input.Visibility != nil {
+		fields = append(fields, task.FieldVisibility)
+	}
+	if input.TeamID != nil {
+		fields = append(fields, task.FieldTeam)
+	}
+	if input.Status != nil {
+		fields = append(fields, task.FieldStatus)
+	}
+	if input.Priority != nil {
+		fields = append(fields, task.FieldPriority)
+	}
+	if len(input.AddLabelIDs) > 0 || len(input.RemoveLabelIDs) > 0 {
+		fields = append(fields, task.FieldLabels)
+	}
+	return fields
+}
+
+// checkFieldPermissions rejects the update with the first field the actor
+// may not change. Viewers get nothing but status, and only on tasks
+// assigned to them, unless the task is shared with them as editors.
+func checkFieldPermissions(t *task.Task, actor *user.User, fields []task.Field) error {
+	if len(fields) == 0 && !actor.Can(user.PermissionEditTasks) && !t.IsAssignee(actor.ID()) && !t.IsSharedEditor(actor.ID()) {
+		return apperr.NewErrPermissionDenied("update", "task", "viewer role cannot update tasks")
+	}
+	for _, f := range fields {
+		if t.CanUpdateField(actor, f) {
+			continue
+		}
+		if f == task.FieldStatus {
+			return apperr.NewErrPermissionDenied("update", "task", "viewer role can change status only on tasks assigned to you")
+		}
+		return apperr.NewErrPermissionDenied("update", "task", "viewer role cannot change "+f.String())
+	}
+	return nil
+}
+
+// advanceSeries creates the next instance when the latest one of a series is
+// completed. Completing an older instance changes nothing, and losing a race
+// with the scheduler or another completion is not an error.
+func (uc *UpdateTask) advanceSeries(ctx context.Context, done *task.Task, now time.Time) error {
+	s, err := uc.SeriesRepo.FindByIDForCompany(ctx, *done.SeriesID(), done.CompanyID())
+	if err != nil {
+		return err
+	}
+
+	if s.Ended() || !s.IsLatest(done) {
+		return nil
+	}
+
+	if _, err := advanceSeries(ctx, uc.SeriesRepo, uc.WorkflowRepo, uc.WatcherRepo, s, done, now); err != nil && !apperr.IsVersionMismatch(err) {
+		return err
+	}
+
+	return nil
+}
+
+// checkTransition resolves status in the company's workflow and checks that
+// the workflow allows t to move there.
+func (uc *UpdateTask) checkTransition(ctx context.Context, t *task.Task, status task.Status) (task.WorkflowStatus, error) {
+	workflow, err := uc.WorkflowRepo.FindByCompany(ctx, t.CompanyID())
+	if err != nil {
+		return task.WorkflowStatus{}, err
+	}
+
+	s, ok := workflow.Status(status)
+	if !ok {
+		return task.WorkflowStatus{}, apperr.NewErrInvalidInput("status", "is not a status of the company workflow")
+	}
+	if !workflow.CanTransition(t.Status(), s.Key) {
+		return task.WorkflowStatus{}, apperr.NewErrInvalidInput("status", "the workflow does not allow moving from "+t.Status().String()+" to "+s.Key.String())
+	}
+
+	return s, nil
+}
+
+// checkBlockers refuses completion while any blocker is unfinished, including
+// blockers the actor cannot see.
+func (uc *UpdateTask) checkBlockers(ctx context.Context, actor *user.User, taskID id.TaskID) error {
+	blockers, err := uc.DependencyRepo.ListBlockers(ctx, actor.CompanyID(), taskID)
+	if err != nil {
+		return err
+	}
+
+	unfinished := task.UnfinishedBlockerIDs(blockers)
+	if len(unfinished) == 0 {
+		return nil
+	}
+
+	blockerIDs := make([]string, len(unfinished))
+	for i, blockerID := range unfinished {
+		blockerIDs[i] = blockerID.String()
+	}
+	return apperr.NewErrTaskBlocked(taskID.String(), blockerIDs)
+}
@@ -0,0 +1,149 @@
+package taskuc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/internal/usecase/mentionuc"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+// UpdateTaskSeriesInput edits every future instance of a series. Template
+// changes are also applied to instances that are not done yet; editing a
+// single instance goes through UpdateTask instead.
+type UpdateTaskSeriesInput struct {
+	SeriesID    id.SeriesID
+	Version     int
+	Title       *string
+	Description **string
+	AssigneeID  **id.UserID
+	Visibility  *task.Visibility
+	TeamID      *id.TeamID
+	Priority    *task.Priority
+	Recurrence  *RecurrenceInput
+}
+
+type UpdateTaskSeriesOutput struct {
+	Series *task.Series
+	// Instances are the open instances after the template changes.
+	Instances []*task.Task
+}
+
+type UpdateTaskSeries struct {
+	SeriesRepo     task.SeriesRepo
+	UserRepo       user.Repo
+	WatcherRepo    task.WatcherRepo
+	RecordMentions *mentionuc.RecordMentions
+	Notifier       Notifier
+}
+
+func NewUpdateTaskSeries(seriesRepo task.SeriesRepo, userRepo user.Repo, watcherRepo task.WatcherRepo, recordMentions *mentionuc.RecordMentions, notifier Notifier) *UpdateTaskSeries {
+	return &UpdateTaskSeries{
+		SeriesRepo:     seriesRepo,
+		UserRepo:       userRepo,
+		WatcherRepo:    watcherRepo,
+		RecordMentions: recordMentions,
+		Notifier:       notifier,
+	}
+}
+
+func (uc *UpdateTaskSeries) Execute(ctx context.Context, actor *user.User, input UpdateTaskSeriesInput) (*UpdateTaskSeriesOutput, error) {
+	if err := authz.Require(actor, user.PermissionEditTasks, "update", "task series"); err != nil {
+		return nil, err
+	}
+
+	s, err := uc.SeriesRepo.FindByIDForCompany(ctx, input.SeriesID, actor.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.CanBeViewedBy(actor) {
+		return nil, apperr.NewErrPermissionDenied("view", "task series", "series is not visible to you")
+	}
+
+	if input.Title != nil && *input.Title == "" {
+		return nil, apperr.NewErrInvalidInput("title", "cannot be empty")
+	}
+
+	if input.Visibility != nil && !input.Visibility.IsValid() {
+		return nil, apperr.NewErrInvalidInput("visibility", "must be only_me, company_wide, or team")
+	}
+
+	if input.Priority != nil && !input.Priority.IsValid() {
+		return nil, apperr.NewErrInvalidInput("priority", "must be none, low, medium, high, or urgent")
+	}
+
+	if input.AssigneeID != nil && *input.AssigneeID != nil {
+		if err := checkAssignees(ctx, uc.UserRepo, actor, "assignee_id", []id.UserID{**input.AssigneeID}); err != nil {
+			return nil, err
+		}
+	}
+
+	update := task.SeriesUpdate{
+		Title:       input.Title,
+		Description: input.Description,
+		AssigneeID:  input.AssigneeID,
+		Visibility:  input.Visibility,
+		Priority:    input.Priority,
+		TeamID:      input.TeamID,
+	}
+
+	if input.Recurrence != nil {
+		rule, loc, err := parseRecurrence(*input.Recurrence)
+		if err != nil {
+			return nil, err
+		}
+		update.Rule = &rule
+		update.TimeZone = loc
+	}
+
+	now := time.Now()
+	updatedSeries := s.ApplyUpdate(update, now)
+	if err := checkTeam(updatedSeries.Visibility(), updatedSeries.TeamID()); err != nil {
+		return nil, err
+	}
+
+	instances, err := uc.SeriesRepo.ListOpenInstances(ctx, actor.CompanyID(), s.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the description's mentions on every open instance before
+	// saving anything, so a mention one of them hides fails the whole edit.
+	taskUpdate := update.TaskUpdate()
+	changes := make([]task.Change, len(instances))
+	mentioned := make([][]*user.User, len(instances))
+	for i, t := range instances {
+		changes[i] = task.NewChange(t, t.ApplyUpdate(taskUpdate, now))
+		if input.Description != nil {
+			if mentioned[i], err = descriptionMentions(ctx, uc.RecordMentions, actor, changes[i].After); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := uc.SeriesRepo.Update(ctx, updatedSeries, input.Version, changes, actor.ID()); err != nil {
+		return nil, err
+	}
+
+	for i, change := range changes {
+		if input.Description != nil {
+			if err := recordDescriptionMentions(ctx, uc.RecordMentions, actor, change.After, mentioned[i]); err != nil {
+				return nil, err
+			}
+		}
+		if err := syncWatchers(ctx, uc.WatcherRepo, change); err != nil {
+			return nil, err
+		}
+		if err := uc.Notifier.TaskChanged(ctx, actor, change); err != nil {
+			return nil, err
+		}
+		instances[i] = change.After
+	}
+
+	return &UpdateTaskSeriesOutput{Series: updatedSeries, Instances: instances}, nil
+}
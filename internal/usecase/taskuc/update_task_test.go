@@ -0,0 +1,572 @@
+package taskuc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/usecase/taskuc"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/notification"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+func TestUpdateTask_ParentValidation(t *testing.T) {
+	companyID := id.NewCompanyID()
+	otherCompanyID := id.NewCompanyID()
+
+	editor := user.NewBuilder().
+		ID(id.NewUserID()).
+		CompanyID(companyID).
+		Email("editor@test.com").
+		Role(user.RoleEditor).
+		MustBuild()
+
+	newTask := func(companyID id.CompanyID, parentID *id.TaskID) *task.Task {
+		return task.NewBuilder().
+			ID(id.NewTaskID()).
+			CompanyID(companyID).
+			CreatorID(editor.ID()).
+			ParentID(parentID).
+			Title("Task").
+			Visibility(task.VisibilityCompanyWide).
+			MustBuild()
+	}
+
+	// epic -> story -> subtask
+	epic := newTask(companyID, nil)
+	epicID := epic.ID()
+	story := newTask(companyID, &epicID)
+	storyID := story.ID()
+	subtask := newTask(companyID, &storyID)
+	subtaskID := subtask.ID()
+	other := newTask(companyID, nil)
+	foreign := newTask(otherCompanyID, nil)
+	foreignID := foreign.ID()
+
+	taskRepo := newMockTaskRepo()
+	for _, tk := range []*task.Task{epic, story, subtask, other, foreign} {
+		taskRepo.tasks[tk.ID().String()] = tk
+	}
+
+	userRepo := newMockUserRepo()
+	recordMentions, _ := newRecordMentions(userRepo)
+	uc := taskuc.NewUpdateTask(taskRepo, userRepo, newMockDependencyRepo(taskRepo), newMockSeriesRepo(taskRepo), newMockWorkflowRepo(), newMockWatcherRepo(userRepo), recordMentions, &mockNotifier{})
+
+	tests := []struct {
+		name     string
+		taskID   id.TaskID
+		parentID id.TaskID
+		wantErr  bool
+	}{
+		{name: "move under another task", taskID: other.ID(), parentID: subtaskID},
+		{name: "own parent", taskID: epicID, parentID: epicID, wantErr: true},
+		{name: "descendant as parent", taskID: epicID, parentID: subtaskID, wantErr: true},
+		{name: "parent from another company", taskID: other.ID(), parentID: foreignID, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parentID := &tt.parentID
+			_, err := uc.Execute(context.Background(), editor, taskuc.UpdateTaskInput{
+				TaskID:   tt.taskID,
+				Version:  1,
+				ParentID: &parentID,
+			})
+
+			if tt.wantErr {
+				if !apperr.IsInvalidInput(err) {
+					t.Errorf("expected invalid input error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestUpdateTask_BlockedCompletion(t *testing.T) {
+	companyID := id.NewCompanyID()
+
+	editor := user.NewBuilder().
+		ID(id.NewUserID()).
+		CompanyID(companyID).
+		Email("editor@test.com").
+		Role(user.RoleEditor).
+		MustBuild()
+
+	newTask := func(status task.Status) *task.Task {
+		return task.NewBuilder().
+			ID(id.NewTaskID()).
+			CompanyID(companyID).
+			CreatorID(editor.ID()).
+			Title("Task").
+			Visibility(task.VisibilityCompanyWide).
+			Status(status).
+			MustBuild()
+	}
+
+	blocker := newTask(task.StatusInProgress)
+	blocked := newTask(task.StatusTodo)
+
+	taskRepo := newMockTaskRepo()
+	taskRepo.tasks[blocker.ID().String()] = blocker
+	taskRepo.tasks[blocked.ID().String()] = blocked
+
+	dependencyRepo := newMockDependencyRepo(taskRepo)
+	dependencyRepo.Add(context.Background(), companyID, blocker.ID(), blocked.ID())
+
+	userRepo := newMockUserRepo()
+	recordMentions, _ := newRecordMentions(userRepo)
+	uc := taskuc.NewUpdateTask(taskRepo, userRepo, dependencyRepo, newMockSeriesRepo(taskRepo), newMockWorkflowRepo(), newMockWatcherRepo(userRepo), recordMentions, &mockNotifier{})
+
+	done := task.StatusDone
+	_, err := uc.Execute(context.Background(), editor, taskuc.UpdateTaskInput{
+		TaskID:  blocked.ID(),
+		Version: 1,
+		Status:  &done,
+	})
+	if !apperr.IsTaskBlocked(err) {
+		t.Fatalf("expected task blocked error, got %v", err)
+	}
+
+	inProgress := task.StatusInProgress
+	if _, err := uc.Execute(context.Background(), editor, taskuc.UpdateTaskInput{
+		TaskID:  blocked.ID(),
+		Version: 1,
+		Status:  &inProgress,
+	}); err != nil {
+		t.Errorf("unexpected error moving a blocked task to in_progress: %v", err)
+	}
+
+	finished := task.WorkflowStatus{Key: task.StatusDone, Category: task.CategoryDone}
+	taskRepo.tasks[blocker.ID().String()] = blocker.ApplyUpdate(task.Update{Status: &finished}, blocker.UpdatedAt())
+	if _, err := uc.Execute(context.Background(), editor, taskuc.UpdateTaskInput{
+		TaskID:  blocked.ID(),
+		Version: 1,
+		Status:  &done,
+	}); err != nil {
+		t.Errorf("unexpected error once the blocker is done: %v", err)
+	}
+}
+
+func TestUpdateTask_WorkflowTransitions(t *testing.T) {
+	companyID := id.NewCompanyID()
+
+	editor := user.NewBuilder().
+		ID(id.NewUserID()).
+		CompanyID(companyID).
+		Email("editor@test.com").
+		Role(user.RoleEditor).
+		MustBuild()
+
+	workflow, err := task.NewWorkflow(companyID, []task.WorkflowStatus{
+		{Key: "todo", Name: "To do", Category: task.CategoryTodo},
+		{Key: "in_review", Name: "In review", Category: task.CategoryActive},
+		{Key: "done", Name: "Done", Category: task.CategoryDone},
+	}, []task.Transition{
+		{From: "todo", To: "in_review"},
+		{From: "in_review", To: "done"},
+	})
+	if err != nil {
+		t.Fatalf("NewWorkflow() error = %v", err)
+	}
+	workflowRepo := newMockWorkflowRepo()
+	workflowRepo.workflows[companyID] = workflow
+
+	tk := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(companyID).
+		CreatorID(editor.ID()).
+		Title("Task").
+		Visibility(task.VisibilityCompanyWide).
+		Status("todo").
+		MustBuild()
+
+	taskRepo := newMockTaskRepo()
+	taskRepo.tasks[tk.ID().String()] = tk
+
+	userRepo := newMockUserRepo()
+	recordMentions, _ := newRecordMentions(userRepo)
+	uc := taskuc.NewUpdateTask(taskRepo, userRepo, newMockDependencyRepo(taskRepo), newMockSeriesRepo(taskRepo), workflowRepo, newMockWatcherRepo(userRepo), recordMentions, &mockNotifier{})
+
+	for _, status := range []task.Status{"done", "in_progress"} {
+		_, err := uc.Execute(context.Background(), editor, taskuc.UpdateTaskInput{
+			TaskID:  tk.ID(),
+			Version: 1,
+			Status:  &status,
+		})
+		if !apperr.IsInvalidInput(err) {
+			t.Errorf("moving to %s: expected invalid input error, got %v", status, err)
+		}
+	}
+
+	review := task.Status("in_review")
+	updated, err := uc.Execute(context.Background(), editor, taskuc.UpdateTaskInput{
+		TaskID:  tk.ID(),
+		Version: 1,
+		Status:  &review,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if updated.Status() != review || updated.Category() != task.CategoryActive {
+		t.Errorf("got status %s (%s), want in_review (active)", updated.Status(), updated.Category())
+	}
+}
+
+func TestUpdateTask_RecurringCompletion(t *testing.T) {
+	ctx := context.Background()
+
+	editor := user.NewBuilder().
+		ID(id.NewUserID()).
+		CompanyID(id.NewCompanyID()).
+		Email("editor@test.com").
+		Role(user.RoleEditor).
+		MustBuild()
+
+	taskRepo := newMockTaskRepo()
+	seriesRepo := newMockSeriesRepo(taskRepo)
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	due := time.Date(2026, 3, 23, 9, 0, 0, 0, berlin)
+
+	userRepo := newMockUserRepo()
+	recordMentions, _ := newRecordMentions(userRepo)
+
+	first, err := taskuc.NewCreateTask(taskRepo, userRepo, seriesRepo, newMockWorkflowRepo(), newMockWatcherRepo(userRepo), recordMentions, &mockNotifier{}).Execute(ctx, editor, taskuc.CreateTaskInput{
+		Title:      "Weekly report",
+		DueDate:    &due,
+		Visibility: task.VisibilityCompanyWide,
+		Recurrence: &taskuc.RecurrenceInput{Rule: "FREQ=WEEKLY;COUNT=2", TimeZone: "Europe/Berlin"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating series: %v", err)
+	}
+	if first.SeriesID() == nil || first.Occurrence() != 1 {
+		t.Fatalf("first instance not linked to its series: %v, %d", first.SeriesID(), first.Occurrence())
+	}
+
+	uc := taskuc.NewUpdateTask(taskRepo, userRepo, newMockDependencyRepo(taskRepo), seriesRepo, newMockWorkflowRepo(), newMockWatcherRepo(userRepo), recordMentions, &mockNotifier{})
+	done := task.StatusDone
+
+	if _, err := uc.Execute(ctx, editor, taskuc.UpdateTaskInput{TaskID: first.ID(), Version: 1, Status: &done}); err != nil {
+		t.Fatalf("unexpected error completing first instance: %v", err)
+	}
+
+	second, err := seriesRepo.FindInstance(ctx, editor.CompanyID(), *first.SeriesID(), 2)
+	if err != nil {
+		t.Fatalf("second instance not created: %v", err)
+	}
+	// The week spans the switch to summer time; the wall clock stays at 09:00.
+	if want := time.Date(2026, 3, 30, 9, 0, 0, 0, berlin); !second.DueDate().Equal(want) {
+		t.Errorf("second due date = %v, want %v", second.DueDate(), want)
+	}
+	if second.Status() != task.StatusTodo || second.Title() != "Weekly report" {
+		t.Errorf("second instance not built from the template: %s, %q", second.Status(), second.Title())
+	}
+
+	if _, err := uc.Execute(ctx, editor, taskuc.UpdateTaskInput{TaskID: second.ID(), Version: 1, Status: &done}); err != nil {
+		t.Fatalf("unexpected error completing second instance: %v", err)
+	}
+
+	if _, err := seriesRepo.FindInstance(ctx, editor.CompanyID(), *first.SeriesID(), 3); !apperr.IsNotFound(err) {
+		t.Errorf("expected no instance past COUNT, got %v", err)
+	}
+	if s, _ := seriesRepo.FindByIDForCompany(ctx, *first.SeriesID(), editor.CompanyID()); !s.Ended() {
+		t.Error("series should end once COUNT is reached")
+	}
+}
+
+func TestUpdateTask_Watchers(t *testing.T) {
+	ctx := context.Background()
+	companyID := id.NewCompanyID()
+
+	newUser := func(email string, role user.Role) *user.User {
+		return user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email(email).Role(role).MustBuild()
+	}
+	editor := newUser("editor@test.com", user.RoleEditor)
+	first := newUser("first@test.com", user.RoleViewer)
+	second := newUser("second@test.com", user.RoleViewer)
+	follower := newUser("follower@test.com", user.RoleViewer)
+
+	userRepo := newMockUserRepo()
+	for _, u := range []*user.User{editor, first, second, follower} {
+		userRepo.AddUser(u)
+	}
+	taskRepo := newMockTaskRepo()
+	seriesRepo := newMockSeriesRepo(taskRepo)
+	watcherRepo := newMockWatcherRepo(userRepo)
+	notifier := &mockNotifier{}
+	recordMentions, _ := newRecordMentions(userRepo)
+
+	firstID := first.ID()
+	created, err := taskuc.NewCreateTask(taskRepo, userRepo, seriesRepo, newMockWorkflowRepo(), watcherRepo, recordMentions, notifier).Execute(ctx, editor, taskuc.CreateTaskInput{
+		Title:      "Task",
+		AssigneeID: &firstID,
+		Visibility: task.VisibilityCompanyWide,
+	})
+	if err != nil {
+		t.Fatalf("CreateTask() error = %v", err)
+	}
+
+	if err := taskuc.NewWatchTask(taskRepo, watcherRepo).Execute(ctx, follower, created.ID()); err != nil {
+		t.Fatalf("WatchTask() error = %v", err)
+	}
+
+	watching := func() []string {
+		var emails []string
+		watchers, _ := watcherRepo.ListByTask(ctx, companyID, created.ID())
+		for _, w := range watchers {
+			emails = append(emails, w.Email())
+		}
+		return emails
+	}
+
+	if got := watching(); len(got) != 3 {
+		t.Fatalf("watchers after create = %v, want creator, assignee and follower", got)
+	}
+
+	uc := taskuc.NewUpdateTask(taskRepo, userRepo, newMockDependencyRepo(taskRepo), seriesRepo, newMockWorkflowRepo(), watcherRepo, recordMentions, notifier)
+
+	// Hiding the task and handing it to someone else unsubscribes the
+	// follower and the previous assignee, and subscribes the new assignee.
+	onlyMe := task.VisibilityOnlyMe
+	secondID := second.ID()
+	secondRef := &secondID
+	if _, err := uc.Execute(ctx, editor, taskuc.UpdateTaskInput{
+		TaskID:     created.ID(),
+		Version:    1,
+		Visibility: &onlyMe,
+		AssigneeID: &secondRef,
+	}); err != nil {
+		t.Fatalf("UpdateTask() error = %v", err)
+	}
+
+	got := watching()
+	if len(got) != 2 || got[0] != "editor@test.com" || got[1] != "second@test.com" {
+		t.Errorf("watchers after update = %v, want [editor@test.com second@test.com]", got)
+	}
+
+	last := notifier.changes[len(notifier.changes)-1]
+	if len(last.Fields) != 2 || last.Fields[0] != task.FieldAssignees || last.Fields[1] != task.FieldVisibility {
+		t.Errorf("notified fields = %v, want [assignee_id visibility]", last.Fields)
+	}
+}
+
+func TestUpdateTask_ViewerPermissions(t *testing.T) {
+	ctx := context.Background()
+	companyID := id.NewCompanyID()
+
+	newUser := func(email string, role user.Role) *user.User {
+		return user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email(email).Role(role).MustBuild()
+	}
+	editor := newUser("editor@test.com", user.RoleEditor)
+	assignee := newUser("assignee@test.com", user.RoleViewer)
+	viewer := newUser("viewer@test.com", user.RoleViewer)
+	sharedEditor := newUser("shared@test.com", user.RoleViewer)
+
+	assigneeID := assignee.ID()
+	tk := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(companyID).
+		CreatorID(editor.ID()).
+		AssigneeID(&assigneeID).
+		Title("Task").
+		Visibility(task.VisibilityCompanyWide).
+		Shares([]task.Share{{UserID: sharedEditor.ID(), Role: task.ShareRoleEditor}}).
+		MustBuild()
+
+	taskRepo := newMockTaskRepo()
+	taskRepo.tasks[tk.ID().String()] = tk
+	userRepo := newMockUserRepo()
+	recordMentions, _ := newRecordMentions(userRepo)
+	uc := taskuc.NewUpdateTask(taskRepo, userRepo, newMockDependencyRepo(taskRepo), newMockSeriesRepo(taskRepo), newMockWorkflowRepo(), newMockWatcherRepo(userRepo), recordMentions, &mockNotifier{})
+
+	inProgress := task.StatusInProgress
+	title := "Renamed"
+
+	tests := []struct {
+		name       string
+		actor      *user.User
+		input      taskuc.UpdateTaskInput
+		wantReason string
+	}{
+		{
+			name:  "assigned viewer changes status",
+			actor: assignee,
+			input: taskuc.UpdateTaskInput{Status: &inProgress},
+		},
+		{
+			name:       "assigned viewer changes title",
+			actor:      assignee,
+			input:      taskuc.UpdateTaskInput{Status: &inProgress, Title: &title},
+			wantReason: "viewer role cannot change title",
+		},
+		{
+			name:       "unassigned viewer changes status",
+			actor:      viewer,
+			input:      taskuc.UpdateTaskInput{Status: &inProgress},
+			wantReason: "viewer role can change status only on tasks assigned to you",
+		},
+		{
+			name:       "unassigned viewer sends an empty update",
+			actor:      viewer,
+			wantReason: "viewer role cannot update tasks",
+		},
+		{
+			name:  "shared editor changes title",
+			actor: sharedEditor,
+			input: taskuc.UpdateTaskInput{Title: &title},
+		},
+		{
+			name:       "shared editor adds an assignee",
+			actor:      sharedEditor,
+			input:      taskuc.UpdateTaskInput{AddAssigneeIDs: []id.UserID{viewer.ID()}},
+			wantReason: "viewer role cannot change assignee_ids",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.input.TaskID = tk.ID()
+			tt.input.Version = 1
+			_, err := uc.Execute(ctx, tt.actor, tt.input)
+
+			if tt.wantReason == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			var denied *apperr.ErrPermissionDenied
+			if !errors.As(err, &denied) || denied.Reason != tt.wantReason {
+				t.Errorf("error = %v, want permission denied: %s", err, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestUpdateTaskSeries_Mentions(t *testing.T) {
+	ctx := context.Background()
+	companyID := id.NewCompanyID()
+
+	editor := user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email("editor@test.com").Role(user.RoleEditor).MustBuild()
+	bob := user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email("bob@test.com").Role(user.RoleViewer).MustBuild()
+
+	due := time.Now().Add(24 * time.Hour)
+
+	tests := []struct {
+		name       string
+		visibility task.Visibility
+		wantErr    bool
+	}{
+		{name: "mention notifies", visibility: task.VisibilityCompanyWide},
+		{name: "mention hidden from the user", visibility: task.VisibilityOnlyMe, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			taskRepo := newMockTaskRepo()
+			seriesRepo := newMockSeriesRepo(taskRepo)
+			userRepo := newMockUserRepo()
+			userRepo.AddUser(editor)
+			userRepo.AddUser(bob)
+			recordMentions, notificationRepo := newRecordMentions(userRepo)
+			watcherRepo := newMockWatcherRepo(userRepo)
+
+			first, err := taskuc.NewCreateTask(taskRepo, userRepo, seriesRepo, newMockWorkflowRepo(), watcherRepo, recordMentions, &mockNotifier{}).Execute(ctx, editor, taskuc.CreateTaskInput{
+				Title:      "Weekly report",
+				DueDate:    &due,
+				Visibility: tt.visibility,
+				Recurrence: &taskuc.RecurrenceInput{Rule: "FREQ=WEEKLY", TimeZone: "UTC"},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error creating series: %v", err)
+			}
+
+			description := "Ping @bob@test.com"
+			descriptionPtr := &description
+			uc := taskuc.NewUpdateTaskSeries(seriesRepo, userRepo, watcherRepo, recordMentions, &mockNotifier{})
+			_, err = uc.Execute(ctx, editor, taskuc.UpdateTaskSeriesInput{
+				SeriesID:    *first.SeriesID(),
+				Version:     1,
+				Description: &descriptionPtr,
+			})
+
+			if tt.wantErr {
+				if !apperr.IsInvalidInput(err) {
+					t.Errorf("expected invalid input error, got %v", err)
+				}
+				if s, _ := seriesRepo.FindByIDForCompany(ctx, *first.SeriesID(), companyID); s.Description() != nil {
+					t.Error("expected the series to be left unchanged")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(notificationRepo.created) != 1 {
+				t.Fatalf("expected 1 notification, got %d", len(notificationRepo.created))
+			}
+			n := notificationRepo.created[0]
+			if n.Kind() != notification.KindMention || !n.RecipientID().Equal(bob.ID()) || !n.TaskID().Equal(first.ID()) {
+				t.Errorf("unexpected notification: %s to %s on %s", n.Kind(), n.RecipientID(), n.TaskID())
+			}
+		})
+	}
+}
+
+func TestUpdateTaskSeries_NotVisible(t *testing.T) {
+	ctx := context.Background()
+	companyID := id.NewCompanyID()
+
+	creator := user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email("creator@test.com").Role(user.RoleEditor).MustBuild()
+	other := user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email("other@test.com").Role(user.RoleEditor).MustBuild()
+
+	taskRepo := newMockTaskRepo()
+	seriesRepo := newMockSeriesRepo(taskRepo)
+	userRepo := newMockUserRepo()
+	userRepo.AddUser(creator)
+	userRepo.AddUser(other)
+	recordMentions, _ := newRecordMentions(userRepo)
+	watcherRepo := newMockWatcherRepo(userRepo)
+
+	due := time.Now().Add(24 * time.Hour)
+	first, err := taskuc.NewCreateTask(taskRepo, userRepo, seriesRepo, newMockWorkflowRepo(), watcherRepo, recordMentions, &mockNotifier{}).Execute(ctx, creator, taskuc.CreateTaskInput{
+		Title:      "Private standup",
+		DueDate:    &due,
+		Visibility: task.VisibilityOnlyMe,
+		Recurrence: &taskuc.RecurrenceInput{Rule: "FREQ=DAILY", TimeZone: "UTC"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error creating series: %v", err)
+	}
+
+	title := "Hijacked"
+	uc := taskuc.NewUpdateTaskSeries(seriesRepo, userRepo, watcherRepo, recordMentions, &mockNotifier{})
+	_, err = uc.Execute(ctx, other, taskuc.UpdateTaskSeriesInput{
+		SeriesID: *first.SeriesID(),
+		Version:  1,
+		Title:    &title,
+	})
+	if !apperr.IsPermissionDenied(err) {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+
+	s, _ := seriesRepo.FindByIDForCompany(ctx, *first.SeriesID(), companyID)
+	if s.Title() != "Private standup" {
+		t.Errorf("expected the series to be left unchanged, got title %q", s.Title())
+	}
+	if instance := taskRepo.tasks[first.ID().String()]; instance.Title() != "Private standup" {
+		t.Errorf("expected the instance to be left unchanged, got title %q", instance.Title())
+	}
+}
@@ -0,0 +1,20 @@
+package taskuc
+
+import (
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+// checkTeam validates the team of a task or series with the given
+// visibility. Only team-visible tasks take a team, and they need one; the
+// repo rejects teams of other companies.
+func checkTeam(visibility task.Visibility, teamID *id.TeamID) error {
+	if visibility == task.VisibilityTeam && teamID == nil {
+		return apperr.NewErrInvalidInput("team_id", "required for team visibility")
+	}
+	if visibility != task.VisibilityTeam && teamID != nil {
+		return apperr.NewErrInvalidInput("team_id", "only allowed with team visibility")
+	}
+	return nil
+}
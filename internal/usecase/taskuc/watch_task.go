@@ -0,0 +1,36 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type WatchTask struct {
+	TaskRepo    task.Repo
+	WatcherRepo task.WatcherRepo
+}
+
+func NewWatchTask(taskRepo task.Repo, watcherRepo task.WatcherRepo) *WatchTask {
+	return &WatchTask{
+		TaskRepo:    taskRepo,
+		WatcherRepo: watcherRepo,
+	}
+}
+
+// Execute subscribes the actor to a task they can see. Any role may watch,
+// and watching a task twice is not an error.
+func (uc *WatchTask) Execute(ctx context.Context, actor *user.User, taskID id.TaskID) error {
+	t, err := uc.TaskRepo.FindByIDForCompany(ctx, taskID, actor.CompanyID())
+	if err != nil {
+		return err
+	}
+	if !t.CanBeViewedBy(actor) {
+		return apperr.NewErrPermissionDenied("view", "task", "task is not visible to you")
+	}
+
+	return uc.WatcherRepo.Add(ctx, actor.CompanyID(), taskID, []id.UserID{actor.ID()})
+}
@@ -0,0 +1,45 @@
+package taskuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+// watchNewTask subscribes a new task's creator and assignees.
+func watchNewTask(ctx context.Context, repo task.WatcherRepo, t *task.Task) error {
+	return repo.Add(ctx, t.CompanyID(), t.ID(), t.DefaultWatcherIDs())
+}
+
+// syncWatchers subscribes new assignees and a new creator, and unsubscribes
+// everyone the change hid the task from, such as a removed assignee of an
+// only_me task or the members of a team the task moved away from.
+func syncWatchers(ctx context.Context, repo task.WatcherRepo, change task.Change) error {
+	t := change.After
+
+	subscribed := change.NewAssigneeIDs()
+	if change.Has(task.FieldCreator) {
+		subscribed = append(subscribed, t.CreatorID())
+	}
+	if len(subscribed) > 0 {
+		if err := repo.Add(ctx, t.CompanyID(), t.ID(), subscribed); err != nil {
+			return err
+		}
+	}
+
+	if !change.Has(task.FieldVisibility) && !change.Has(task.FieldTeam) && !change.Has(task.FieldAssignees) && !change.Has(task.FieldCreator) {
+		return nil
+	}
+
+	watchers, err := repo.ListByTask(ctx, t.CompanyID(), t.ID())
+	if err != nil {
+		return err
+	}
+
+	hidden := t.HiddenWatchers(watchers)
+	if len(hidden) == 0 {
+		return nil
+	}
+
+	return repo.Remove(ctx, t.CompanyID(), t.ID(), hidden)
+}
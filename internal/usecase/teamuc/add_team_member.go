@@ -0,0 +1,50 @@
+package teamuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/team"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type AddTeamMemberInput struct {
+	TeamID id.TeamID
+	UserID id.UserID
+}
+
+type AddTeamMember struct {
+	TeamRepo team.Repo
+	UserRepo user.Repo
+}
+
+func NewAddTeamMember(teamRepo team.Repo, userRepo user.Repo) *AddTeamMember {
+	return &AddTeamMember{TeamRepo: teamRepo, UserRepo: userRepo}
+}
+
+// Execute adds an active user of the actor's company to the team. Adding an
+// existing member succeeds without changes.
+func (uc *AddTeamMember) Execute(ctx context.Context, actor *user.User, input AddTeamMemberInput) error {
+	if err := authz.Require(actor, user.PermissionManageTeams, "add member to", "team"); err != nil {
+		return err
+	}
+
+	if _, err := uc.TeamRepo.FindByIDForCompany(ctx, input.TeamID, actor.CompanyID()); err != nil {
+		return err
+	}
+
+	member, err := uc.UserRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return err
+	}
+	if !member.CompanyID().Equal(actor.CompanyID()) {
+		return apperr.NewErrNotFound("user", input.UserID.String())
+	}
+	if !member.IsActive() {
+		return apperr.NewErrInvalidInput("user_id", "user is deactivated")
+	}
+
+	return uc.TeamRepo.AddMember(ctx, actor.CompanyID(), input.TeamID, input.UserID)
+}
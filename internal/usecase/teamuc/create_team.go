@@ -0,0 +1,62 @@
+package teamuc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/team"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type CreateTeamInput struct {
+	Name string
+}
+
+type CreateTeam struct {
+	TeamRepo team.Repo
+}
+
+func NewCreateTeam(teamRepo team.Repo) *CreateTeam {
+	return &CreateTeam{TeamRepo: teamRepo}
+}
+
+func (uc *CreateTeam) Execute(ctx context.Context, actor *user.User, input CreateTeamInput) (*team.Team, error) {
+	if err := authz.Require(actor, user.PermissionManageTeams, "create", "team"); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if err := validateName(name); err != nil {
+		return nil, err
+	}
+
+	t, err := team.NewBuilder().
+		ID(id.NewTeamID()).
+		CompanyID(actor.CompanyID()).
+		Name(name).
+		CreatedAt(time.Now()).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.TeamRepo.Create(ctx, t); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func validateName(name string) error {
+	if name == "" {
+		return apperr.NewErrInvalidInput("name", "cannot be empty")
+	}
+	if len(name) > team.MaxNameLength {
+		return apperr.NewErrInvalidInput("name", "must be at most 50 characters")
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package teamuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/team"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type DeleteTeam struct {
+	TeamRepo team.Repo
+}
+
+func NewDeleteTeam(teamRepo team.Repo) *DeleteTeam {
+	return &DeleteTeam{TeamRepo: teamRepo}
+}
+
+// Execute removes the team and its memberships. It fails while tasks or
+// series are still visible to the team; move them to another visibility
+// first.
+func (uc *DeleteTeam) Execute(ctx context.Context, actor *user.User, teamID id.TeamID) error {
+	if err := authz.Require(actor, user.PermissionManageTeams, "delete", "team"); err != nil {
+		return err
+	}
+
+	return uc.TeamRepo.Delete(ctx, teamID, actor.CompanyID())
+}
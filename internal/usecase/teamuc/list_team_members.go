@@ -0,0 +1,25 @@
+package teamuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/team"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type ListTeamMembers struct {
+	TeamRepo team.Repo
+}
+
+func NewListTeamMembers(teamRepo team.Repo) *ListTeamMembers {
+	return &ListTeamMembers{TeamRepo: teamRepo}
+}
+
+func (uc *ListTeamMembers) Execute(ctx context.Context, actor *user.User, teamID id.TeamID) ([]*user.User, error) {
+	if _, err := uc.TeamRepo.FindByIDForCompany(ctx, teamID, actor.CompanyID()); err != nil {
+		return nil, err
+	}
+
+	return uc.TeamRepo.ListMembers(ctx, actor.CompanyID(), teamID)
+}
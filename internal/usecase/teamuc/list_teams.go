@@ -0,0 +1,20 @@
+package teamuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/team"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type ListTeams struct {
+	TeamRepo team.Repo
+}
+
+func NewListTeams(teamRepo team.Repo) *ListTeams {
+	return &ListTeams{TeamRepo: teamRepo}
+}
+
+func (uc *ListTeams) Execute(ctx context.Context, actor *user.User) ([]*team.Team, error) {
+	return uc.TeamRepo.ListByCompany(ctx, actor.CompanyID())
+}
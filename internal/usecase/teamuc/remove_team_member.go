@@ -0,0 +1,62 @@
+package teamuc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/team"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type RemoveTeamMemberInput struct {
+	TeamID id.TeamID
+	UserID id.UserID
+}
+
+type RemoveTeamMember struct {
+	TeamRepo    team.Repo
+	UserRepo    user.Repo
+	WatcherRepo task.WatcherRepo
+}
+
+func NewRemoveTeamMember(teamRepo team.Repo, userRepo user.Repo, watcherRepo task.WatcherRepo) *RemoveTeamMember {
+	return &RemoveTeamMember{TeamRepo: teamRepo, UserRepo: userRepo, WatcherRepo: watcherRepo}
+}
+
+// Execute takes the user out of the team. Team-visible tasks the user did
+// not create and is not assigned to stop being visible to them, and they
+// stop watching those tasks.
+func (uc *RemoveTeamMember) Execute(ctx context.Context, actor *user.User, input RemoveTeamMemberInput) error {
+	if err := authz.Require(actor, user.PermissionManageTeams, "remove member from", "team"); err != nil {
+		return err
+	}
+
+	if err := uc.TeamRepo.RemoveMember(ctx, actor.CompanyID(), input.TeamID, input.UserID); err != nil {
+		return err
+	}
+
+	// Reload the user so their teams no longer include this one.
+	member, err := uc.UserRepo.FindByID(ctx, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	watched, err := uc.WatcherRepo.ListTeamTasks(ctx, actor.CompanyID(), input.TeamID, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range watched {
+		hidden := t.HiddenWatchers([]*user.User{member})
+		if len(hidden) == 0 {
+			continue
+		}
+		if err := uc.WatcherRepo.Remove(ctx, actor.CompanyID(), t.ID(), hidden); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
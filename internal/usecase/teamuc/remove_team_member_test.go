@@ -0,0 +1,96 @@
+package teamuc_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pyshx/todoapp/internal/usecase/teamuc"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/team"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+// mockTeamRepo removes members from a single user
+type mockTeamRepo struct {
+	team.Repo
+	member *user.User
+}
+
+func (m *mockTeamRepo) RemoveMember(ctx context.Context, companyID id.CompanyID, teamID id.TeamID, userID id.UserID) error {
+	m.member = user.NewBuilder().
+		ID(m.member.ID()).
+		CompanyID(m.member.CompanyID()).
+		Email(m.member.Email()).
+		Role(m.member.Role()).
+		MustBuild()
+	return nil
+}
+
+type mockUserRepo struct {
+	user.Repo
+	teams *mockTeamRepo
+}
+
+func (m *mockUserRepo) FindByID(ctx context.Context, userID id.UserID) (*user.User, error) {
+	return m.teams.member, nil
+}
+
+// mockWatcherRepo serves a fixed list of watched team tasks
+type mockWatcherRepo struct {
+	task.WatcherRepo
+	tasks   []*task.Task
+	removed map[id.TaskID][]id.UserID
+}
+
+func (m *mockWatcherRepo) ListTeamTasks(ctx context.Context, companyID id.CompanyID, teamID id.TeamID, userID id.UserID) ([]*task.Task, error) {
+	return m.tasks, nil
+}
+
+func (m *mockWatcherRepo) Remove(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, userIDs []id.UserID) error {
+	m.removed[taskID] = append(m.removed[taskID], userIDs...)
+	return nil
+}
+
+func TestRemoveTeamMember_Watchers(t *testing.T) {
+	companyID := id.NewCompanyID()
+	teamID := id.NewTeamID()
+
+	admin := user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email("admin@test.com").Role(user.RoleAdmin).MustBuild()
+	member := user.NewBuilder().
+		ID(id.NewUserID()).
+		CompanyID(companyID).
+		Email("member@test.com").
+		Role(user.RoleViewer).
+		TeamIDs([]id.TeamID{teamID}).
+		MustBuild()
+
+	newTask := func(assigneeIDs ...id.UserID) *task.Task {
+		return task.NewBuilder().
+			ID(id.NewTaskID()).
+			CompanyID(companyID).
+			CreatorID(admin.ID()).
+			AssigneeIDs(assigneeIDs).
+			Title("Team Task").
+			Visibility(task.VisibilityTeam).
+			TeamID(&teamID).
+			MustBuild()
+	}
+	hidden := newTask()
+	assigned := newTask(member.ID())
+
+	teamRepo := &mockTeamRepo{member: member}
+	watcherRepo := &mockWatcherRepo{tasks: []*task.Task{hidden, assigned}, removed: make(map[id.TaskID][]id.UserID)}
+	uc := teamuc.NewRemoveTeamMember(teamRepo, &mockUserRepo{teams: teamRepo}, watcherRepo)
+
+	if err := uc.Execute(context.Background(), admin, teamuc.RemoveTeamMemberInput{TeamID: teamID, UserID: member.ID()}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if removed := watcherRepo.removed[hidden.ID()]; len(removed) != 1 || !removed[0].Equal(member.ID()) {
+		t.Errorf("expected the member to stop watching the hidden task, removed %v", removed)
+	}
+	if removed := watcherRepo.removed[assigned.ID()]; len(removed) != 0 {
+		t.Errorf("expected the member to keep watching their assigned task, removed %v", removed)
+	}
+}
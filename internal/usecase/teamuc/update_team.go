@@ -0,0 +1,53 @@
+package teamuc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/team"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type UpdateTeamInput struct {
+	TeamID id.TeamID
+	Name   *string
+}
+
+type UpdateTeam struct {
+	TeamRepo team.Repo
+}
+
+func NewUpdateTeam(teamRepo team.Repo) *UpdateTeam {
+	return &UpdateTeam{TeamRepo: teamRepo}
+}
+
+func (uc *UpdateTeam) Execute(ctx context.Context, actor *user.User, input UpdateTeamInput) (*team.Team, error) {
+	if err := authz.Require(actor, user.PermissionManageTeams, "update", "team"); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.TeamRepo.FindByIDForCompany(ctx, input.TeamID, actor.CompanyID())
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if err := validateName(name); err != nil {
+			return nil, err
+		}
+		input.Name = &name
+	}
+
+	updated := existing.ApplyUpdate(team.Update{
+		Name: input.Name,
+	})
+
+	if err := uc.TeamRepo.Update(ctx, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,60 @@
+package useruc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type AcceptInviteInput struct {
+	Token string
+}
+
+type AcceptInvite struct {
+	InviteRepo user.InviteRepo
+}
+
+func NewAcceptInvite(inviteRepo user.InviteRepo) *AcceptInvite {
+	return &AcceptInvite{InviteRepo: inviteRepo}
+}
+
+// Execute turns an invite into a user. It runs without an actor: holding
+// the token is what authorizes it.
+func (uc *AcceptInvite) Execute(ctx context.Context, input AcceptInviteInput) (*user.User, error) {
+	if input.Token == "" {
+		return nil, apperr.NewErrInvalidInput("token", "cannot be empty")
+	}
+
+	invite, err := uc.InviteRepo.FindByTokenHash(ctx, user.HashInviteToken(input.Token))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if invite.IsAccepted() {
+		return nil, apperr.NewErrFailedPrecondition("accept", "invite", "invite was already used")
+	}
+	if invite.IsExpired(now) {
+		return nil, apperr.NewErrFailedPrecondition("accept", "invite", "invite has expired")
+	}
+
+	u, err := user.NewBuilder().
+		ID(id.NewUserID()).
+		CompanyID(invite.CompanyID()).
+		Email(invite.Email()).
+		Role(invite.Role()).
+		CreatedAt(now).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.InviteRepo.Accept(ctx, invite, u, now); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
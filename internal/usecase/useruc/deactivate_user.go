@@ -0,0 +1,49 @@
+package useruc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type DeactivateUser struct {
+	UserRepo user.Repo
+}
+
+func NewDeactivateUser(userRepo user.Repo) *DeactivateUser {
+	return &DeactivateUser{UserRepo: userRepo}
+}
+
+// Execute stops a user from signing in while keeping everything they did.
+// Deactivating someone above your own role, or yourself, is refused.
+func (uc *DeactivateUser) Execute(ctx context.Context, actor *user.User, userID id.UserID) (*user.User, error) {
+	if err := authz.Require(actor, user.PermissionManageUsers, "deactivate", "user"); err != nil {
+		return nil, err
+	}
+
+	if userID.Equal(actor.ID()) {
+		return nil, apperr.NewErrPermissionDenied("deactivate", "user", "cannot deactivate yourself")
+	}
+
+	target, err := companyUser(ctx, uc.UserRepo, actor, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.Role().AtLeast(target.Role()) {
+		return nil, apperr.NewErrPermissionDenied("deactivate", "user", "cannot deactivate a user above your own role")
+	}
+	if !target.IsActive() {
+		return target, nil
+	}
+
+	updated := target.ApplyUpdate(user.Update{Deactivated: true}, time.Now())
+	if err := uc.UserRepo.Update(ctx, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
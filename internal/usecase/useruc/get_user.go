@@ -0,0 +1,34 @@
+package useruc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type GetUser struct {
+	UserRepo user.Repo
+}
+
+func NewGetUser(userRepo user.Repo) *GetUser {
+	return &GetUser{UserRepo: userRepo}
+}
+
+func (uc *GetUser) Execute(ctx context.Context, actor *user.User, userID id.UserID) (*user.User, error) {
+	return companyUser(ctx, uc.UserRepo, actor, userID)
+}
+
+// companyUser loads a user of the actor's company; users of other companies
+// are reported as not found.
+func companyUser(ctx context.Context, repo user.Repo, actor *user.User, userID id.UserID) (*user.User, error) {
+	u, err := repo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !u.CompanyID().Equal(actor.CompanyID()) {
+		return nil, apperr.NewErrNotFound("user", userID.String())
+	}
+	return u, nil
+}
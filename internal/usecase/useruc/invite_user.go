@@ -0,0 +1,79 @@
+package useruc
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type InviteUserInput struct {
+	Email string
+	Role  user.Role
+}
+
+type InviteUser struct {
+	UserRepo   user.Repo
+	InviteRepo user.InviteRepo
+}
+
+func NewInviteUser(userRepo user.Repo, inviteRepo user.InviteRepo) *InviteUser {
+	return &InviteUser{UserRepo: userRepo, InviteRepo: inviteRepo}
+}
+
+// Execute creates an invite and returns it with its token. The token is
+// only available here; it is up to the caller to pass it to the invitee.
+func (uc *InviteUser) Execute(ctx context.Context, actor *user.User, input InviteUserInput) (*user.Invite, string, error) {
+	if err := authz.Require(actor, user.PermissionManageUsers, "invite", "user"); err != nil {
+		return nil, "", err
+	}
+
+	email := strings.TrimSpace(input.Email)
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return nil, "", apperr.NewErrInvalidInput("email", "must be an email address")
+	}
+
+	if !input.Role.IsValid() {
+		return nil, "", apperr.NewErrInvalidInput("role", "must be viewer, editor, admin, or owner")
+	}
+	if !actor.Role().AtLeast(input.Role) {
+		return nil, "", apperr.NewErrPermissionDenied("invite", "user", "cannot invite with a role above your own")
+	}
+
+	if _, err := uc.UserRepo.FindByEmailForCompany(ctx, email, actor.CompanyID()); err == nil {
+		return nil, "", apperr.NewErrAlreadyExists("user", "email is already in use")
+	} else if !apperr.IsNotFound(err) {
+		return nil, "", err
+	}
+
+	token, hash, err := user.NewInviteToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now()
+	invite, err := user.NewInviteBuilder().
+		ID(id.NewInviteID()).
+		CompanyID(actor.CompanyID()).
+		Email(email).
+		Role(input.Role).
+		InvitedBy(actor.ID()).
+		TokenHash(hash).
+		ExpiresAt(now.Add(user.InviteTTL)).
+		CreatedAt(now).
+		Build()
+	if err != nil {
+		return nil, "", err
+	}
+
+	if err := uc.InviteRepo.Create(ctx, invite); err != nil {
+		return nil, "", err
+	}
+
+	return invite, token, nil
+}
@@ -0,0 +1,159 @@
+package useruc_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/usecase/useruc"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type mockUserRepo struct {
+	users map[id.UserID]*user.User
+}
+
+func newMockUserRepo(users ...*user.User) *mockUserRepo {
+	m := &mockUserRepo{users: make(map[id.UserID]*user.User)}
+	for _, u := range users {
+		m.users[u.ID()] = u
+	}
+	return m
+}
+
+func (m *mockUserRepo) FindByID(ctx context.Context, userID id.UserID) (*user.User, error) {
+	if u, ok := m.users[userID]; ok {
+		return u, nil
+	}
+	return nil, apperr.NewErrNotFound("user", userID.String())
+}
+
+func (m *mockUserRepo) FindByEmailForCompany(ctx context.Context, email string, companyID id.CompanyID) (*user.User, error) {
+	for _, u := range m.users {
+		if strings.EqualFold(u.Email(), email) && u.CompanyID().Equal(companyID) {
+			return u, nil
+		}
+	}
+	return nil, apperr.NewErrNotFound("user", email)
+}
+
+func (m *mockUserRepo) ListByCompany(ctx context.Context, companyID id.CompanyID, includeDeactivated bool) ([]*user.User, error) {
+	var users []*user.User
+	for _, u := range m.users {
+		if u.CompanyID().Equal(companyID) && (includeDeactivated || u.IsActive()) {
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
+func (m *mockUserRepo) Update(ctx context.Context, u *user.User) error {
+	m.users[u.ID()] = u
+	return nil
+}
+
+// mockInviteRepo creates accepted users in the user repo it wraps
+type mockInviteRepo struct {
+	users   *mockUserRepo
+	invites map[string]*user.Invite
+}
+
+func newMockInviteRepo(users *mockUserRepo) *mockInviteRepo {
+	return &mockInviteRepo{users: users, invites: make(map[string]*user.Invite)}
+}
+
+func (m *mockInviteRepo) Create(ctx context.Context, i *user.Invite) error {
+	m.invites[i.TokenHash()] = i
+	return nil
+}
+
+func (m *mockInviteRepo) FindByTokenHash(ctx context.Context, tokenHash string) (*user.Invite, error) {
+	if i, ok := m.invites[tokenHash]; ok {
+		return i, nil
+	}
+	return nil, apperr.NewErrNotFound("invite", "token")
+}
+
+func (m *mockInviteRepo) Accept(ctx context.Context, i *user.Invite, u *user.User, now time.Time) error {
+	stored := m.invites[i.TokenHash()]
+	if stored.IsAccepted() {
+		return apperr.NewErrFailedPrecondition("accept", "invite", "invite was already used")
+	}
+	m.invites[i.TokenHash()] = user.NewInviteBuilder().
+		ID(stored.ID()).
+		CompanyID(stored.CompanyID()).
+		Email(stored.Email()).
+		Role(stored.Role()).
+		InvitedBy(stored.InvitedBy()).
+		TokenHash(stored.TokenHash()).
+		ExpiresAt(stored.ExpiresAt()).
+		AcceptedAt(&now).
+		CreatedAt(stored.CreatedAt()).
+		MustBuild()
+	m.users.users[u.ID()] = u
+	return nil
+}
+
+func newUser(companyID id.CompanyID, email string, role user.Role) *user.User {
+	return user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email(email).Role(role).MustBuild()
+}
+
+func TestInviteUser_AcceptFlow(t *testing.T) {
+	ctx := context.Background()
+	companyID := id.NewCompanyID()
+	admin := newUser(companyID, "admin@test.com", user.RoleAdmin)
+	editor := newUser(companyID, "editor@test.com", user.RoleEditor)
+
+	userRepo := newMockUserRepo(admin, editor)
+	inviteRepo := newMockInviteRepo(userRepo)
+	invite := useruc.NewInviteUser(userRepo, inviteRepo)
+	accept := useruc.NewAcceptInvite(inviteRepo)
+
+	tests := []struct {
+		name    string
+		actor   *user.User
+		input   useruc.InviteUserInput
+		wantErr func(error) bool
+	}{
+		{name: "editor cannot invite", actor: editor, input: useruc.InviteUserInput{Email: "new@test.com", Role: user.RoleViewer}, wantErr: apperr.IsPermissionDenied},
+		{name: "admin cannot invite an owner", actor: admin, input: useruc.InviteUserInput{Email: "new@test.com", Role: user.RoleOwner}, wantErr: apperr.IsPermissionDenied},
+		{name: "invalid email", actor: admin, input: useruc.InviteUserInput{Email: "not an email", Role: user.RoleViewer}, wantErr: apperr.IsInvalidInput},
+		{name: "missing role", actor: admin, input: useruc.InviteUserInput{Email: "new@test.com"}, wantErr: apperr.IsInvalidInput},
+		{name: "existing member", actor: admin, input: useruc.InviteUserInput{Email: "Editor@test.com", Role: user.RoleViewer}, wantErr: apperr.IsAlreadyExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := invite.Execute(ctx, tt.actor, tt.input); !tt.wantErr(err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+
+	created, token, err := invite.Execute(ctx, admin, useruc.InviteUserInput{Email: "new@test.com", Role: user.RoleAdmin})
+	if err != nil {
+		t.Fatalf("InviteUser() error = %v", err)
+	}
+	if token == "" || created.TokenHash() == token || created.TokenHash() != user.HashInviteToken(token) {
+		t.Fatal("expected the invite to keep only a hash of the returned token")
+	}
+
+	if _, err := accept.Execute(ctx, useruc.AcceptInviteInput{Token: "wrong"}); !apperr.IsNotFound(err) {
+		t.Errorf("expected not found for an unknown token, got %v", err)
+	}
+
+	joined, err := accept.Execute(ctx, useruc.AcceptInviteInput{Token: token})
+	if err != nil {
+		t.Fatalf("AcceptInvite() error = %v", err)
+	}
+	if !joined.CompanyID().Equal(companyID) || joined.Email() != "new@test.com" || joined.Role() != user.RoleAdmin {
+		t.Errorf("joined as %s %s in %s", joined.Email(), joined.Role(), joined.CompanyID())
+	}
+
+	if _, err := accept.Execute(ctx, useruc.AcceptInviteInput{Token: token}); !apperr.IsFailedPrecondition(err) {
+		t.Errorf("expected the token to be single-use, got %v", err)
+	}
+}
@@ -0,0 +1,25 @@
+package useruc
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type ListUsersInput struct {
+	IncludeDeactivated bool
+}
+
+type ListUsers struct {
+	UserRepo user.Repo
+}
+
+func NewListUsers(userRepo user.Repo) *ListUsers {
+	return &ListUsers{UserRepo: userRepo}
+}
+
+// Execute lists the users of the actor's company. Any role may list them,
+// since picking assignees and mentions needs the directory.
+func (uc *ListUsers) Execute(ctx context.Context, actor *user.User, input ListUsersInput) ([]*user.User, error) {
+	return uc.UserRepo.ListByCompany(ctx, actor.CompanyID(), input.IncludeDeactivated)
+}
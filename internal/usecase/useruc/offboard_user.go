@@ -0,0 +1,112 @@
+package useruc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/internal/usecase/taskuc"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type OffboardUserInput struct {
+	UserID id.UserID
+	// TransferToID becomes the creator of the user's tasks and series.
+	TransferToID id.UserID
+	// ReassignToID takes over the user's assignments; nil unassigns them.
+	ReassignToID *id.UserID
+	OnlyMe       task.OnlyMeHandover
+}
+
+type OffboardUser struct {
+	UserRepo      user.Repo
+	HandOverTasks *taskuc.HandOverTasks
+}
+
+func NewOffboardUser(userRepo user.Repo, handOverTasks *taskuc.HandOverTasks) *OffboardUser {
+	return &OffboardUser{UserRepo: userRepo, HandOverTasks: handOverTasks}
+}
+
+// Execute hands the user's tasks over and then deactivates them; nothing
+// they created is deleted. It can be run again for a user who is already
+// deactivated, for example to retry after a failure.
+func (uc *OffboardUser) Execute(ctx context.Context, actor *user.User, input OffboardUserInput) (*user.User, int, error) {
+	if err := authz.Require(actor, user.PermissionManageUsers, "offboard", "user"); err != nil {
+		return nil, 0, err
+	}
+
+	if input.UserID.Equal(actor.ID()) {
+		return nil, 0, apperr.NewErrPermissionDenied("offboard", "user", "cannot offboard yourself")
+	}
+
+	if !input.OnlyMe.IsValid() {
+		return nil, 0, apperr.NewErrInvalidInput("only_me_tasks", "must be keep_private or share")
+	}
+
+	target, err := companyUser(ctx, uc.UserRepo, actor, input.UserID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !actor.Role().AtLeast(target.Role()) {
+		return nil, 0, apperr.NewErrPermissionDenied("offboard", "user", "cannot offboard a user above your own role")
+	}
+
+	transferTo, err := uc.successor(ctx, actor, target, "transfer_to_id", input.TransferToID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if !transferTo.Can(user.PermissionEditTasks) {
+		return nil, 0, apperr.NewErrInvalidInput("transfer_to_id", "user must be able to edit tasks")
+	}
+
+	if input.ReassignToID != nil {
+		if _, err := uc.successor(ctx, actor, target, "reassign_to_id", *input.ReassignToID); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	handedOver, err := uc.HandOverTasks.Execute(ctx, actor, task.Handover{
+		FromID:     target.ID(),
+		CreatorID:  transferTo.ID(),
+		AssigneeID: input.ReassignToID,
+		OnlyMe:     input.OnlyMe,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !target.IsActive() {
+		return target, handedOver, nil
+	}
+
+	deactivated := target.ApplyUpdate(user.Update{Deactivated: true}, time.Now())
+	if err := uc.UserRepo.Update(ctx, deactivated); err != nil {
+		return nil, 0, err
+	}
+
+	return deactivated, handedOver, nil
+}
+
+// successor checks a user who takes something over from the departing one:
+// an active member of the actor's company other than the departing user.
+func (uc *OffboardUser) successor(ctx context.Context, actor, departing *user.User, field string, userID id.UserID) (*user.User, error) {
+	if userID.Equal(departing.ID()) {
+		return nil, apperr.NewErrInvalidInput(field, "cannot be the user being offboarded")
+	}
+
+	u, err := companyUser(ctx, uc.UserRepo, actor, userID)
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return nil, apperr.NewErrInvalidInput(field, "user not found")
+		}
+		return nil, err
+	}
+	if !u.IsActive() {
+		return nil, apperr.NewErrInvalidInput(field, "user is deactivated")
+	}
+
+	return u, nil
+}
@@ -0,0 +1,64 @@
+package useruc
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/internal/usecase/authz"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type UpdateUserRoleInput struct {
+	UserID id.UserID
+	Role   user.Role
+}
+
+type UpdateUserRole struct {
+	UserRepo user.Repo
+}
+
+func NewUpdateUserRole(userRepo user.Repo) *UpdateUserRole {
+	return &UpdateUserRole{UserRepo: userRepo}
+}
+
+// Execute changes another user's role. Nobody can act on a user above
+// their own role or grant a role above it, and nobody can change their own
+// role, which also keeps every company with at least one owner. Granting or
+// taking away the owner role takes the transfer ownership permission.
+func (uc *UpdateUserRole) Execute(ctx context.Context, actor *user.User, input UpdateUserRoleInput) (*user.User, error) {
+	if err := authz.Require(actor, user.PermissionManageUsers, "update", "user"); err != nil {
+		return nil, err
+	}
+
+	if !input.Role.IsValid() {
+		return nil, apperr.NewErrInvalidInput("role", "must be viewer, editor, admin, or owner")
+	}
+	if input.UserID.Equal(actor.ID()) {
+		return nil, apperr.NewErrPermissionDenied("update", "user", "cannot change your own role")
+	}
+
+	target, err := companyUser(ctx, uc.UserRepo, actor, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !target.IsActive() {
+		return nil, apperr.NewErrFailedPrecondition("update", "user", "user is deactivated")
+	}
+	if input.Role == user.RoleOwner || target.Role() == user.RoleOwner {
+		if err := authz.Require(actor, user.PermissionTransferOwnership, "update", "user"); err != nil {
+			return nil, err
+		}
+	}
+	if !actor.CanChangeRole(target, input.Role) {
+		return nil, apperr.NewErrPermissionDenied("update", "user", "cannot change a role above your own")
+	}
+
+	updated := target.ApplyUpdate(user.Update{Role: &input.Role}, time.Now())
+	if err := uc.UserRepo.Update(ctx, updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
@@ -0,0 +1,96 @@
+package useruc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pyshx/todoapp/internal/usecase/useruc"
+	"github.com/pyshx/todoapp/pkg/apperr"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+func TestUpdateUserRole_Execute(t *testing.T) {
+	ctx := context.Background()
+	companyID := id.NewCompanyID()
+	owner := newUser(companyID, "owner@test.com", user.RoleOwner)
+	admin := newUser(companyID, "admin@test.com", user.RoleAdmin)
+	editor := newUser(companyID, "editor@test.com", user.RoleEditor)
+	viewer := newUser(companyID, "viewer@test.com", user.RoleViewer)
+	outsider := newUser(id.NewCompanyID(), "outsider@test.com", user.RoleViewer)
+
+	tests := []struct {
+		name    string
+		actor   *user.User
+		target  *user.User
+		role    user.Role
+		wantErr func(error) bool
+	}{
+		{name: "admin promotes viewer", actor: admin, target: viewer, role: user.RoleEditor},
+		{name: "owner makes admin an owner", actor: owner, target: admin, role: user.RoleOwner},
+		{name: "admin cannot grant owner", actor: admin, target: editor, role: user.RoleOwner, wantErr: deniedOwnership},
+		{name: "admin cannot demote owner", actor: admin, target: owner, role: user.RoleEditor, wantErr: deniedOwnership},
+		{name: "editor cannot change roles", actor: editor, target: viewer, role: user.RoleEditor, wantErr: apperr.IsPermissionDenied},
+		{name: "own role", actor: owner, target: owner, role: user.RoleAdmin, wantErr: apperr.IsPermissionDenied},
+		{name: "user of another company", actor: owner, target: outsider, role: user.RoleEditor, wantErr: apperr.IsNotFound},
+		{name: "invalid role", actor: owner, target: viewer, role: user.Role("root"), wantErr: apperr.IsInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := useruc.NewUpdateUserRole(newMockUserRepo(owner, admin, editor, viewer, outsider))
+			updated, err := uc.Execute(ctx, tt.actor, useruc.UpdateUserRoleInput{UserID: tt.target.ID(), Role: tt.role})
+
+			if tt.wantErr != nil {
+				if !tt.wantErr(err) {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if updated.Role() != tt.role {
+				t.Errorf("Role() = %s, want %s", updated.Role(), tt.role)
+			}
+		})
+	}
+}
+
+// deniedOwnership matches the refusal to grant or take away the owner role.
+func deniedOwnership(err error) bool {
+	var denied *apperr.ErrPermissionDenied
+	return errors.As(err, &denied) && denied.Reason == "admin role cannot "+user.PermissionTransferOwnership.String()
+}
+
+func TestDeactivateUser_Execute(t *testing.T) {
+	ctx := context.Background()
+	companyID := id.NewCompanyID()
+	owner := newUser(companyID, "owner@test.com", user.RoleOwner)
+	admin := newUser(companyID, "admin@test.com", user.RoleAdmin)
+	editor := newUser(companyID, "editor@test.com", user.RoleEditor)
+
+	userRepo := newMockUserRepo(owner, admin, editor)
+	uc := useruc.NewDeactivateUser(userRepo)
+
+	if _, err := uc.Execute(ctx, admin, owner.ID()); !apperr.IsPermissionDenied(err) {
+		t.Errorf("expected admin to be refused deactivating the owner, got %v", err)
+	}
+	if _, err := uc.Execute(ctx, admin, admin.ID()); !apperr.IsPermissionDenied(err) {
+		t.Errorf("expected self-deactivation to be refused, got %v", err)
+	}
+
+	deactivated, err := uc.Execute(ctx, admin, editor.ID())
+	if err != nil {
+		t.Fatalf("DeactivateUser() error = %v", err)
+	}
+	if deactivated.IsActive() {
+		t.Error("expected user to be deactivated")
+	}
+
+	listed, _ := useruc.NewListUsers(userRepo).Execute(ctx, admin, useruc.ListUsersInput{})
+	if len(listed) != 2 {
+		t.Errorf("ListUsers() returned %d users, want the 2 active ones", len(listed))
+	}
+}
@@ -0,0 +1,71 @@
+// Package worker runs periodic background jobs alongside the server.
+package worker
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Job is a unit of background work run every Interval. A failed run is
+// logged and retried on the next tick.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context, now time.Time) error
+}
+
+type Runner struct {
+	jobs   []Job
+	logger *slog.Logger
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewRunner(logger *slog.Logger, jobs ...Job) *Runner {
+	return &Runner{jobs: jobs, logger: logger}
+}
+
+// Start launches one goroutine per job. Jobs with a non-positive interval
+// are disabled.
+func (r *Runner) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+
+	for _, job := range r.jobs {
+		if job.Interval <= 0 {
+			r.logger.Info("background job disabled", "job", job.Name)
+			continue
+		}
+
+		r.wg.Add(1)
+		go func(job Job) {
+			defer r.wg.Done()
+			r.loop(ctx, job)
+		}(job)
+	}
+}
+
+// Stop cancels running jobs and waits for them to return.
+func (r *Runner) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+}
+
+func (r *Runner) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := job.Run(ctx, now); err != nil && ctx.Err() == nil {
+				r.logger.Error("background job failed", "job", job.Name, "error", err)
+			}
+		}
+	}
+}
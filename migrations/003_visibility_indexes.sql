@@ -0,0 +1,10 @@
+-- 003_visibility_indexes.sql
+-- Indexes backing the viewer-aware keyset query in ListVisibleByCompany
+
+-- Company-wide tasks, in keyset order
+CREATE INDEX idx_tasks_company_wide_created ON tasks(company_id, created_at DESC, id DESC)
+    WHERE visibility = 'company_wide';
+
+-- Tasks created by or assigned to the viewer, in keyset order
+CREATE INDEX idx_tasks_creator_created ON tasks(company_id, creator_id, created_at DESC, id DESC);
+CREATE INDEX idx_tasks_assignee_created ON tasks(company_id, assignee_id, created_at DESC, id DESC);
@@ -0,0 +1,18 @@
+-- 004_sort_indexes.sql
+-- Composite indexes backing the selectable sort orders on task listings.
+-- Each index ends in id, the keyset tie-breaker; btree indexes are scanned
+-- backwards for descending sorts.
+
+-- Due date, nulls last in either direction
+CREATE INDEX idx_tasks_sort_due ON tasks(company_id, (due_date IS NULL), due_date, id);
+
+CREATE INDEX idx_tasks_sort_updated ON tasks(company_id, updated_at, id);
+
+CREATE INDEX idx_tasks_sort_title ON tasks(company_id, title, id);
+
+-- Workflow order; must match statusRank in internal/infra/postgres/task_repo.go
+CREATE INDEX idx_tasks_sort_status ON tasks(
+    company_id,
+    (CASE status WHEN 'todo' THEN 0 WHEN 'in_progress' THEN 1 WHEN 'done' THEN 2 END),
+    id
+);
@@ -0,0 +1,29 @@
+-- 006_labels.sql
+-- Per-company label catalog and the task/label many-to-many link
+
+CREATE TABLE labels (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    color TEXT NOT NULL CHECK (color ~ '^#[0-9a-fA-F]{6}$'),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (id, company_id)
+);
+
+CREATE UNIQUE INDEX idx_labels_company_name ON labels(company_id, lower(name));
+
+-- Composite keys let task_labels reference both sides within one company
+ALTER TABLE tasks ADD CONSTRAINT tasks_id_company_key UNIQUE (id, company_id);
+
+-- Both foreign keys share company_id, so a label can never be attached to a
+-- task from another company
+CREATE TABLE task_labels (
+    task_id UUID NOT NULL,
+    label_id UUID NOT NULL,
+    company_id UUID NOT NULL,
+    PRIMARY KEY (task_id, label_id),
+    FOREIGN KEY (task_id, company_id) REFERENCES tasks(id, company_id) ON DELETE CASCADE,
+    FOREIGN KEY (label_id, company_id) REFERENCES labels(id, company_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_labels_label ON task_labels(label_id);
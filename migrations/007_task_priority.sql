@@ -0,0 +1,13 @@
+-- 007_task_priority.sql
+-- Task priority, filterable and sortable in task listings
+
+ALTER TABLE tasks
+    ADD COLUMN priority TEXT NOT NULL DEFAULT 'none'
+    CHECK (priority IN ('none', 'low', 'medium', 'high', 'urgent'));
+
+-- Severity order; must match priorityRank in internal/infra/postgres/task_repo.go
+CREATE INDEX idx_tasks_sort_priority ON tasks(
+    company_id,
+    (CASE priority WHEN 'none' THEN 0 WHEN 'low' THEN 1 WHEN 'medium' THEN 2 WHEN 'high' THEN 3 WHEN 'urgent' THEN 4 END),
+    id
+);
@@ -0,0 +1,13 @@
+-- 008_subtasks.sql
+-- Parent/child hierarchy between tasks of the same company
+
+-- The composite key keeps parents within the task's company. RESTRICT makes
+-- deleting a task that still has subtasks fail; children must be moved or
+-- deleted first.
+ALTER TABLE tasks
+    ADD COLUMN parent_id UUID,
+    ADD CONSTRAINT tasks_parent_fkey FOREIGN KEY (parent_id, company_id)
+        REFERENCES tasks(id, company_id) ON DELETE RESTRICT,
+    ADD CONSTRAINT tasks_parent_not_self CHECK (parent_id <> id);
+
+CREATE INDEX idx_tasks_parent ON tasks(parent_id, created_at, id) WHERE parent_id IS NOT NULL;
@@ -0,0 +1,17 @@
+-- 009_task_dependencies.sql
+-- Blocking edges between tasks: blocker_id must be done before blocked_id
+
+-- Both foreign keys share company_id, so edges never cross companies.
+-- Cycles are rejected by the application before an edge is inserted.
+CREATE TABLE task_dependencies (
+    blocker_id UUID NOT NULL,
+    blocked_id UUID NOT NULL,
+    company_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (blocker_id, blocked_id),
+    FOREIGN KEY (blocker_id, company_id) REFERENCES tasks(id, company_id) ON DELETE CASCADE,
+    FOREIGN KEY (blocked_id, company_id) REFERENCES tasks(id, company_id) ON DELETE CASCADE,
+    CHECK (blocker_id <> blocked_id)
+);
+
+CREATE INDEX idx_task_dependencies_blocked ON task_dependencies(blocked_id);
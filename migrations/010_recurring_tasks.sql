@@ -0,0 +1,36 @@
+-- 010_recurring_tasks.sql
+-- Recurring task series; each occurrence is an ordinary task row
+
+-- rule is a canonical RRULE (FREQ, INTERVAL, BYDAY, COUNT, UNTIL) evaluated
+-- in time_zone, an IANA name. The template columns seed new instances.
+CREATE TABLE task_series (
+    id UUID PRIMARY KEY,
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    creator_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    assignee_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    rule TEXT NOT NULL,
+    time_zone TEXT NOT NULL DEFAULT 'UTC',
+    starts_at TIMESTAMPTZ NOT NULL,
+    last_occurrence_at TIMESTAMPTZ NOT NULL,
+    occurrences INT NOT NULL DEFAULT 1 CHECK (occurrences > 0),
+    ended BOOLEAN NOT NULL DEFAULT FALSE,
+    title TEXT NOT NULL,
+    description TEXT,
+    visibility TEXT NOT NULL CHECK (visibility IN ('only_me', 'company_wide')),
+    priority TEXT NOT NULL DEFAULT 'none' CHECK (priority IN ('none', 'low', 'medium', 'high', 'urgent')),
+    version INT NOT NULL DEFAULT 1,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (id, company_id)
+);
+
+-- The scheduler scans for active series whose latest occurrence has passed.
+CREATE INDEX idx_task_series_due ON task_series(last_occurrence_at) WHERE NOT ended;
+
+-- Deleting a series detaches its instances rather than removing them.
+ALTER TABLE tasks
+    ADD COLUMN series_id UUID,
+    ADD COLUMN series_occurrence INT,
+    ADD CONSTRAINT tasks_series_fkey FOREIGN KEY (series_id, company_id)
+        REFERENCES task_series(id, company_id) ON DELETE SET NULL (series_id),
+    ADD CONSTRAINT tasks_series_occurrence_key UNIQUE (series_id, series_occurrence);
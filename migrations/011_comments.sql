@@ -0,0 +1,22 @@
+-- 011_comments.sql
+-- Threaded comments on tasks
+
+-- Comments go away with their task. A reply references its parent through
+-- (parent_id, task_id), so a thread never spans tasks; a comment that still
+-- has replies cannot be deleted on its own.
+CREATE TABLE comments (
+    id UUID PRIMARY KEY,
+    company_id UUID NOT NULL,
+    task_id UUID NOT NULL,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    parent_id UUID,
+    body TEXT NOT NULL CHECK (length(body) > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    edited_at TIMESTAMPTZ,
+    UNIQUE (id, task_id),
+    FOREIGN KEY (task_id, company_id) REFERENCES tasks(id, company_id) ON DELETE CASCADE,
+    CONSTRAINT comments_parent_fkey FOREIGN KEY (parent_id, task_id) REFERENCES comments(id, task_id)
+);
+
+CREATE INDEX idx_comments_task ON comments(task_id, created_at, id);
+CREATE INDEX idx_comments_parent ON comments(parent_id) WHERE parent_id IS NOT NULL;
@@ -0,0 +1,31 @@
+-- 012_mentions.sql
+-- @-mentions in task descriptions and comments, and the notifications they raise
+
+-- comment_id is NULL for mentions in the task description. NULLS NOT
+-- DISTINCT keeps one row per user and source either way.
+CREATE TABLE mentions (
+    company_id UUID NOT NULL,
+    task_id UUID NOT NULL,
+    comment_id UUID REFERENCES comments(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (task_id, company_id) REFERENCES tasks(id, company_id) ON DELETE CASCADE,
+    UNIQUE NULLS NOT DISTINCT (task_id, comment_id, user_id)
+);
+
+CREATE INDEX idx_mentions_user ON mentions(user_id);
+
+CREATE TABLE notifications (
+    id UUID PRIMARY KEY,
+    company_id UUID NOT NULL,
+    recipient_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    actor_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind TEXT NOT NULL CHECK (kind IN ('mention')),
+    task_id UUID NOT NULL,
+    comment_id UUID REFERENCES comments(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    read_at TIMESTAMPTZ,
+    FOREIGN KEY (task_id, company_id) REFERENCES tasks(id, company_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_notifications_recipient ON notifications(recipient_id, created_at DESC, id DESC);
@@ -0,0 +1,19 @@
+-- 013_notifications.sql
+-- Notification inbox: assignments, status changes and due-date reminders
+
+-- Due-date reminders are raised by the server rather than a user.
+ALTER TABLE notifications ALTER COLUMN actor_id DROP NOT NULL;
+
+ALTER TABLE notifications DROP CONSTRAINT notifications_kind_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_kind_check
+    CHECK (kind IN ('mention', 'assigned', 'status_changed', 'due_soon'));
+
+-- The due date a reminder was sent for. Moving the due date earns a new
+-- reminder; the unique index stops the sweeper repeating itself otherwise.
+ALTER TABLE notifications ADD COLUMN due_date TIMESTAMPTZ;
+
+CREATE UNIQUE INDEX idx_notifications_due_soon ON notifications(task_id, recipient_id, due_date)
+    WHERE kind = 'due_soon';
+
+CREATE INDEX idx_notifications_unread ON notifications(recipient_id)
+    WHERE read_at IS NULL;
@@ -0,0 +1,27 @@
+-- 014_task_watchers.sql
+-- Users following a task to hear about every change
+
+CREATE TABLE task_watchers (
+    task_id UUID NOT NULL,
+    company_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (task_id, user_id),
+    FOREIGN KEY (task_id, company_id) REFERENCES tasks(id, company_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_watchers_user ON task_watchers(user_id);
+
+-- Existing tasks start out watched by their creator and assignee, as new
+-- tasks are.
+INSERT INTO task_watchers (task_id, company_id, user_id)
+SELECT id, company_id, creator_id FROM tasks
+UNION
+SELECT id, company_id, assignee_id FROM tasks WHERE assignee_id IS NOT NULL;
+
+-- Watchers are told which fields an update changed.
+ALTER TABLE notifications ADD COLUMN changed_fields TEXT[] NOT NULL DEFAULT '{}';
+
+ALTER TABLE notifications DROP CONSTRAINT notifications_kind_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_kind_check
+    CHECK (kind IN ('mention', 'assigned', 'status_changed', 'due_soon', 'task_updated'));
@@ -0,0 +1,27 @@
+-- 015_task_history.sql
+-- Append-only audit trail of task changes
+
+-- No foreign keys: history outlives both the task and the actor. changes is
+-- an array of {"field", "before", "after"} objects with text or null values.
+CREATE TABLE task_history (
+    id UUID PRIMARY KEY,
+    company_id UUID NOT NULL,
+    task_id UUID NOT NULL,
+    actor_id UUID,
+    action TEXT NOT NULL CHECK (action IN ('created', 'updated', 'deleted')),
+    version INTEGER NOT NULL,
+    changes JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_task_history_task ON task_history(task_id, created_at DESC, id DESC);
+
+CREATE FUNCTION reject_task_history_change() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'task_history rows are immutable';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER task_history_immutable
+    BEFORE UPDATE OR DELETE ON task_history
+    FOR EACH ROW EXECUTE FUNCTION reject_task_history_change();
@@ -0,0 +1,13 @@
+-- 016_soft_delete.sql
+-- Deleted tasks move to a trash bin until they are restored or purged
+
+-- A task in the trash keeps its labels, comments, watchers and dependencies
+-- so that restoring it brings everything back. Purging removes the row, and
+-- the existing cascades take the rest with it.
+ALTER TABLE tasks ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX idx_tasks_trash ON tasks(company_id, deleted_at) WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE task_history DROP CONSTRAINT task_history_action_check;
+ALTER TABLE task_history ADD CONSTRAINT task_history_action_check
+    CHECK (action IN ('created', 'updated', 'deleted', 'restored', 'purged'));
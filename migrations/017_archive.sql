@@ -0,0 +1,18 @@
+-- 017_archive.sql
+-- Archived tasks drop out of listings without being deleted
+
+-- completed_at is when the task last moved to done; tasks already done are
+-- taken to have finished at their last update.
+ALTER TABLE tasks
+    ADD COLUMN completed_at TIMESTAMPTZ,
+    ADD COLUMN archived_at TIMESTAMPTZ;
+
+UPDATE tasks SET completed_at = updated_at WHERE status = 'done';
+
+CREATE INDEX idx_tasks_auto_archive ON tasks(completed_at)
+    WHERE completed_at IS NOT NULL AND archived_at IS NULL AND deleted_at IS NULL;
+
+-- NULL leaves done tasks alone; otherwise they are archived this many days
+-- after completion.
+ALTER TABLE companies ADD COLUMN auto_archive_after_days INTEGER
+    CHECK (auto_archive_after_days > 0);
@@ -0,0 +1,51 @@
+-- 018_workflows.sql
+-- Per-company workflow: the statuses tasks can be in, their categories and
+-- the allowed moves between them
+
+CREATE TABLE workflow_statuses (
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    key TEXT NOT NULL,
+    name TEXT NOT NULL,
+    category TEXT NOT NULL CHECK (category IN ('todo', 'active', 'done')),
+    -- Display and sort order within the company
+    position INTEGER NOT NULL,
+    PRIMARY KEY (company_id, key)
+);
+
+CREATE TABLE workflow_transitions (
+    company_id UUID NOT NULL,
+    from_status TEXT NOT NULL,
+    to_status TEXT NOT NULL,
+    PRIMARY KEY (company_id, from_status, to_status),
+    FOREIGN KEY (company_id, from_status) REFERENCES workflow_statuses(company_id, key) ON DELETE CASCADE,
+    FOREIGN KEY (company_id, to_status) REFERENCES workflow_statuses(company_id, key) ON DELETE CASCADE
+);
+
+-- Existing companies get the default workflow: the three statuses tasks had
+-- so far, with every move allowed. Keep in sync with task.DefaultWorkflow.
+INSERT INTO workflow_statuses (company_id, key, name, category, position)
+SELECT c.id, s.key, s.name, s.category, s.position
+FROM companies c
+CROSS JOIN (VALUES
+    ('todo', 'To do', 'todo', 0),
+    ('in_progress', 'In progress', 'active', 1),
+    ('done', 'Done', 'done', 2)
+) AS s(key, name, category, position);
+
+INSERT INTO workflow_transitions (company_id, from_status, to_status)
+SELECT f.company_id, f.key, t.key
+FROM workflow_statuses f
+JOIN workflow_statuses t ON t.company_id = f.company_id AND t.key <> f.key;
+
+-- Statuses are now whatever the company's workflow defines. A status that
+-- tasks still use cannot be removed from the workflow.
+ALTER TABLE tasks
+    DROP CONSTRAINT tasks_status_check,
+    ALTER COLUMN status DROP DEFAULT,
+    ADD CONSTRAINT tasks_status_fkey FOREIGN KEY (company_id, status)
+        REFERENCES workflow_statuses(company_id, key);
+
+-- Status sorting follows the workflow position, which the old index on the
+-- fixed status order cannot serve.
+DROP INDEX idx_tasks_sort_status;
+CREATE INDEX idx_tasks_status ON tasks(company_id, status);
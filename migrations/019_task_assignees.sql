@@ -0,0 +1,25 @@
+-- 019_task_assignees.sql
+-- Tasks can be assigned to several users
+
+CREATE TABLE task_assignees (
+    task_id UUID NOT NULL,
+    company_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- Assignment order; the first assignee is the primary one
+    position INTEGER NOT NULL,
+    PRIMARY KEY (task_id, user_id),
+    FOREIGN KEY (task_id, company_id) REFERENCES tasks(id, company_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_assignees_user ON task_assignees(user_id, task_id);
+
+-- Assignee filters and visibility now look up task_assignees, which the
+-- index above serves; nothing reads tasks by assignee_id any more.
+DROP INDEX idx_tasks_assignee_created;
+
+INSERT INTO task_assignees (task_id, company_id, user_id, position)
+SELECT id, company_id, assignee_id, 0 FROM tasks WHERE assignee_id IS NOT NULL;
+
+-- tasks.assignee_id stays in step with the primary assignee for clients and
+-- reports that predate multiple assignees. task_assignees is authoritative.
+COMMENT ON COLUMN tasks.assignee_id IS 'Primary assignee; deprecated in favour of task_assignees';
@@ -0,0 +1,17 @@
+-- 020_admin_owner_roles.sql
+-- Admins manage users and company settings; owners can also hand the
+-- company over to someone else
+
+ALTER TABLE users DROP CONSTRAINT users_role_check;
+ALTER TABLE users ADD CONSTRAINT users_role_check
+    CHECK (role IN ('owner', 'admin', 'editor', 'viewer'));
+
+-- Every company needs someone who can manage it: its longest-standing
+-- editor becomes the owner.
+UPDATE users SET role = 'owner'
+WHERE id IN (
+    SELECT DISTINCT ON (company_id) id
+    FROM users
+    WHERE role = 'editor'
+    ORDER BY company_id, created_at, id
+);
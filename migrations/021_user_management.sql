@@ -0,0 +1,21 @@
+-- 021_user_management.sql
+-- Deactivated users and invites to join a company
+
+-- Deactivated users keep their tasks, comments and history but can no
+-- longer sign in
+ALTER TABLE users ADD COLUMN deactivated_at TIMESTAMPTZ;
+
+CREATE TABLE user_invites (
+    id UUID PRIMARY KEY,
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    email TEXT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('owner', 'admin', 'editor', 'viewer')),
+    invited_by UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- SHA-256 of the token handed to the invitee; the token is not stored
+    token_hash TEXT NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    accepted_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_user_invites_company ON user_invites(company_id, created_at DESC);
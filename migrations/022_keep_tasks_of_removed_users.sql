@@ -0,0 +1,16 @@
+-- 022_keep_tasks_of_removed_users.sql
+-- Deleting a user used to delete every task and series they created. Users
+-- are now offboarded and deactivated instead; a user who still created
+-- tasks cannot be deleted at all. NO ACTION, unlike RESTRICT, is checked at
+-- the end of the statement, so deleting a company still cascades through its
+-- users and their tasks.
+
+ALTER TABLE tasks
+    DROP CONSTRAINT tasks_creator_id_fkey,
+    ADD CONSTRAINT tasks_creator_id_fkey FOREIGN KEY (creator_id)
+        REFERENCES users(id) ON DELETE NO ACTION;
+
+ALTER TABLE task_series
+    DROP CONSTRAINT task_series_creator_id_fkey,
+    ADD CONSTRAINT task_series_creator_id_fkey FOREIGN KEY (creator_id)
+        REFERENCES users(id) ON DELETE NO ACTION;
@@ -0,0 +1,5 @@
+-- 023_company_suspension.sql
+-- Operators can suspend a company; its users cannot sign in until it is
+-- unsuspended. Nothing is deleted.
+
+ALTER TABLE companies ADD COLUMN suspended_at TIMESTAMPTZ;
@@ -0,0 +1,46 @@
+-- 024_teams.sql
+-- Teams within a company, and a team visibility for tasks and series that
+-- shows them to the team's members
+
+CREATE TABLE teams (
+    id UUID PRIMARY KEY,
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (id, company_id)
+);
+
+CREATE UNIQUE INDEX idx_teams_company_name ON teams(company_id, lower(name));
+
+CREATE TABLE team_members (
+    team_id UUID NOT NULL,
+    company_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (team_id, user_id),
+    FOREIGN KEY (team_id, company_id) REFERENCES teams(id, company_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_team_members_user ON team_members(user_id, team_id);
+
+-- A team cannot be deleted while tasks or series are visible to it. Sharing
+-- company_id keeps a task from pointing at another company's team.
+ALTER TABLE tasks ADD COLUMN team_id UUID;
+ALTER TABLE tasks ADD CONSTRAINT tasks_team_fkey
+    FOREIGN KEY (team_id, company_id) REFERENCES teams(id, company_id);
+ALTER TABLE tasks DROP CONSTRAINT tasks_visibility_check;
+ALTER TABLE tasks ADD CONSTRAINT tasks_visibility_check
+    CHECK (visibility IN ('only_me', 'company_wide', 'team'));
+ALTER TABLE tasks ADD CONSTRAINT tasks_team_visibility_check
+    CHECK ((visibility = 'team') = (team_id IS NOT NULL));
+
+CREATE INDEX idx_tasks_team ON tasks(team_id) WHERE team_id IS NOT NULL;
+
+ALTER TABLE task_series ADD COLUMN team_id UUID;
+ALTER TABLE task_series ADD CONSTRAINT task_series_team_fkey
+    FOREIGN KEY (team_id, company_id) REFERENCES teams(id, company_id);
+ALTER TABLE task_series DROP CONSTRAINT task_series_visibility_check;
+ALTER TABLE task_series ADD CONSTRAINT task_series_visibility_check
+    CHECK (visibility IN ('only_me', 'company_wide', 'team'));
+ALTER TABLE task_series ADD CONSTRAINT task_series_team_visibility_check
+    CHECK ((visibility = 'team') = (team_id IS NOT NULL));
@@ -0,0 +1,14 @@
+-- 025_task_shares.sql
+-- Tasks shared with individual users of the company, as viewers or editors
+
+CREATE TABLE task_shares (
+    task_id UUID NOT NULL,
+    company_id UUID NOT NULL,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role TEXT NOT NULL CHECK (role IN ('viewer', 'editor')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (task_id, user_id),
+    FOREIGN KEY (task_id, company_id) REFERENCES tasks(id, company_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_task_shares_user ON task_shares(user_id, task_id);
@@ -1,6 +1,9 @@
 package apperr
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 type ErrorKind string
 
@@ -95,6 +98,12 @@ func KindFromError(err error) ErrorKind {
 		return ErrorKindValidation
 	case *ErrUnauthenticated:
 		return ErrorKindAuth
+	case *ErrAlreadyExists:
+		return ErrorKindConflict
+	case *ErrFailedPrecondition:
+		return ErrorKindConflict
+	case *ErrTaskBlocked:
+		return ErrorKindConflict
 	default:
 		return ErrorKindInternal
 	}
@@ -116,9 +125,40 @@ func NewErrAlreadyExists(resource, reason string) *ErrAlreadyExists {
 	return &ErrAlreadyExists{Resource: resource, Reason: reason}
 }
 
-func IsNotFound(err error) bool         { _, ok := err.(*ErrNotFound); return ok }
-func IsPermissionDenied(err error) bool { _, ok := err.(*ErrPermissionDenied); return ok }
-func IsVersionMismatch(err error) bool  { _, ok := err.(*ErrVersionMismatch); return ok }
-func IsInvalidInput(err error) bool     { _, ok := err.(*ErrInvalidInput); return ok }
-func IsUnauthenticated(err error) bool  { _, ok := err.(*ErrUnauthenticated); return ok }
-func IsAlreadyExists(err error) bool    { _, ok := err.(*ErrAlreadyExists); return ok }
+type ErrFailedPrecondition struct {
+	Action   string
+	Resource string
+	Reason   string
+}
+
+func (e *ErrFailedPrecondition) Error() string {
+	return fmt.Sprintf("cannot %s %s: %s", e.Action, e.Resource, e.Reason)
+}
+
+func NewErrFailedPrecondition(action, resource, reason string) *ErrFailedPrecondition {
+	return &ErrFailedPrecondition{Action: action, Resource: resource, Reason: reason}
+}
+
+// ErrTaskBlocked is returned when a task cannot be completed because other
+// tasks blocking it are not done yet.
+type ErrTaskBlocked struct {
+	TaskID     string
+	BlockerIDs []string
+}
+
+func (e *ErrTaskBlocked) Error() string {
+	return fmt.Sprintf("task %s is blocked by %d unfinished task(s): %s", e.TaskID, len(e.BlockerIDs), strings.Join(e.BlockerIDs, ", "))
+}
+
+func NewErrTaskBlocked(taskID string, blockerIDs []string) *ErrTaskBlocked {
+	return &ErrTaskBlocked{TaskID: taskID, BlockerIDs: blockerIDs}
+}
+
+func IsNotFound(err error) bool           { _, ok := err.(*ErrNotFound); return ok }
+func IsPermissionDenied(err error) bool   { _, ok := err.(*ErrPermissionDenied); return ok }
+func IsVersionMismatch(err error) bool    { _, ok := err.(*ErrVersionMismatch); return ok }
+func IsInvalidInput(err error) bool       { _, ok := err.(*ErrInvalidInput); return ok }
+func IsUnauthenticated(err error) bool    { _, ok := err.(*ErrUnauthenticated); return ok }
+func IsAlreadyExists(err error) bool      { _, ok := err.(*ErrAlreadyExists); return ok }
+func IsFailedPrecondition(err error) bool { _, ok := err.(*ErrFailedPrecondition); return ok }
+func IsTaskBlocked(err error) bool        { _, ok := err.(*ErrTaskBlocked); return ok }
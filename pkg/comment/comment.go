@@ -0,0 +1,137 @@
+package comment
+
+import (
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+const MaxBodyLength = 10000
+
+// Comment is a message on a task. Replies point at the comment they answer
+// through ParentID; parent and reply always belong to the same task.
+type Comment struct {
+	id        id.CommentID
+	companyID id.CompanyID
+	taskID    id.TaskID
+	authorID  id.UserID
+	parentID  *id.CommentID
+	body      string
+	createdAt time.Time
+	editedAt  *time.Time
+}
+
+func (c *Comment) ID() id.CommentID        { return c.id }
+func (c *Comment) CompanyID() id.CompanyID { return c.companyID }
+func (c *Comment) TaskID() id.TaskID       { return c.taskID }
+func (c *Comment) AuthorID() id.UserID     { return c.authorID }
+func (c *Comment) ParentID() *id.CommentID { return c.parentID }
+func (c *Comment) Body() string            { return c.body }
+func (c *Comment) CreatedAt() time.Time    { return c.createdAt }
+func (c *Comment) EditedAt() *time.Time    { return c.editedAt }
+
+// CanBeEditedBy reports whether u may edit or delete the comment: its author
+// or anyone in the company with PermissionEditTasks. Callers still check
+// that u can see the task.
+func (c *Comment) CanBeEditedBy(u *user.User) bool {
+	if !c.companyID.Equal(u.CompanyID()) {
+		return false
+	}
+	return c.authorID.Equal(u.ID()) || u.Can(user.PermissionEditTasks)
+}
+
+type Builder struct {
+	c   *Comment
+	err error
+}
+
+func NewBuilder() *Builder {
+	return &Builder{c: &Comment{}}
+}
+
+func (b *Builder) ID(id id.CommentID) *Builder {
+	if b.err == nil {
+		b.c.id = id
+	}
+	return b
+}
+
+func (b *Builder) CompanyID(companyID id.CompanyID) *Builder {
+	if b.err == nil {
+		b.c.companyID = companyID
+	}
+	return b
+}
+
+func (b *Builder) TaskID(taskID id.TaskID) *Builder {
+	if b.err == nil {
+		b.c.taskID = taskID
+	}
+	return b
+}
+
+func (b *Builder) AuthorID(authorID id.UserID) *Builder {
+	if b.err == nil {
+		b.c.authorID = authorID
+	}
+	return b
+}
+
+func (b *Builder) ParentID(parentID *id.CommentID) *Builder {
+	if b.err == nil {
+		b.c.parentID = parentID
+	}
+	return b
+}
+
+func (b *Builder) Body(body string) *Builder {
+	if b.err == nil {
+		b.c.body = body
+	}
+	return b
+}
+
+func (b *Builder) CreatedAt(t time.Time) *Builder {
+	if b.err == nil {
+		b.c.createdAt = t
+	}
+	return b
+}
+
+func (b *Builder) EditedAt(t *time.Time) *Builder {
+	if b.err == nil {
+		b.c.editedAt = t
+	}
+	return b
+}
+
+func (b *Builder) Build() (*Comment, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.c, nil
+}
+
+func (b *Builder) MustBuild() *Comment {
+	c, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+type Update struct {
+	Body *string
+}
+
+func (c *Comment) ApplyUpdate(u Update, now time.Time) *Comment {
+	newComment := *c
+
+	if u.Body != nil {
+		newComment.body = *u.Body
+		newComment.editedAt = &now
+	}
+
+	return &newComment
+}
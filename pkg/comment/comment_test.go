@@ -0,0 +1,68 @@
+package comment_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/comment"
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+func TestComment_CanBeEditedBy(t *testing.T) {
+	companyID := id.NewCompanyID()
+	authorID := id.NewUserID()
+
+	c := comment.NewBuilder().
+		ID(id.NewCommentID()).
+		CompanyID(companyID).
+		TaskID(id.NewTaskID()).
+		AuthorID(authorID).
+		Body("Looks good").
+		MustBuild()
+
+	newUser := func(userID id.UserID, companyID id.CompanyID, role user.Role) *user.User {
+		return user.NewBuilder().ID(userID).CompanyID(companyID).Email("u@test.com").Role(role).MustBuild()
+	}
+
+	tests := []struct {
+		name string
+		user *user.User
+		want bool
+	}{
+		{"author with viewer role", newUser(authorID, companyID, user.RoleViewer), true},
+		{"other viewer", newUser(id.NewUserID(), companyID, user.RoleViewer), false},
+		{"editor", newUser(id.NewUserID(), companyID, user.RoleEditor), true},
+		{"admin", newUser(id.NewUserID(), companyID, user.RoleAdmin), true},
+		{"editor from another company", newUser(id.NewUserID(), id.NewCompanyID(), user.RoleEditor), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.CanBeEditedBy(tt.user); got != tt.want {
+				t.Errorf("CanBeEditedBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComment_ApplyUpdate(t *testing.T) {
+	c := comment.NewBuilder().
+		ID(id.NewCommentID()).
+		Body("first").
+		MustBuild()
+
+	now := time.Now()
+	body := "second"
+	updated := c.ApplyUpdate(comment.Update{Body: &body}, now)
+
+	if updated.Body() != "second" {
+		t.Errorf("Body() = %q, want %q", updated.Body(), "second")
+	}
+	if updated.EditedAt() == nil || !updated.EditedAt().Equal(now) {
+		t.Errorf("EditedAt() = %v, want %v", updated.EditedAt(), now)
+	}
+	if c.Body() != "first" || c.EditedAt() != nil {
+		t.Error("ApplyUpdate must not modify the original comment")
+	}
+}
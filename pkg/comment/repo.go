@@ -0,0 +1,34 @@
+package comment
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+// PageCursor marks the last comment of a page; comments are listed oldest
+// first.
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        id.CommentID
+}
+
+type ListOptions struct {
+	PageSize int
+	Cursor   *PageCursor
+}
+
+type ListResult struct {
+	Comments   []*Comment
+	NextCursor *PageCursor
+}
+
+type Repo interface {
+	Create(ctx context.Context, comment *Comment) error
+	FindByIDForCompany(ctx context.Context, commentID id.CommentID, companyID id.CompanyID) (*Comment, error)
+	ListByTask(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, opts ListOptions) (*ListResult, error)
+	Update(ctx context.Context, comment *Comment) error
+	// Delete fails with ErrFailedPrecondition while the comment has replies.
+	Delete(ctx context.Context, commentID id.CommentID, companyID id.CompanyID) error
+}
@@ -6,16 +6,33 @@ import (
 	"github.com/pyshx/todoapp/pkg/id"
 )
 
+// MaxAutoArchiveAfterDays caps the auto-archive period at about ten years.
+const MaxAutoArchiveAfterDays = 3650
+
+// MaxNameLength caps company names, in bytes.
+const MaxNameLength = 200
+
 type Company struct {
-	id        id.CompanyID
-	name      string
-	createdAt time.Time
+	id                   id.CompanyID
+	name                 string
+	autoArchiveAfterDays int
+	suspendedAt          *time.Time
+	createdAt            time.Time
 }
 
 func (c *Company) ID() id.CompanyID    { return c.id }
 func (c *Company) Name() string        { return c.name }
 func (c *Company) CreatedAt() time.Time { return c.createdAt }
 
+// SuspendedAt is when an operator suspended the company; its users cannot
+// sign in while it is set.
+func (c *Company) SuspendedAt() *time.Time { return c.suspendedAt }
+func (c *Company) IsSuspended() bool       { return c.suspendedAt != nil }
+
+// AutoArchiveAfterDays is how many days done tasks stay in listings before
+// they are archived. Zero turns auto-archiving off.
+func (c *Company) AutoArchiveAfterDays() int { return c.autoArchiveAfterDays }
+
 type Builder struct {
 	c   *Company
 	err error
@@ -39,6 +56,20 @@ func (b *Builder) Name(name string) *Builder {
 	return b
 }
 
+func (b *Builder) AutoArchiveAfterDays(days int) *Builder {
+	if b.err == nil {
+		b.c.autoArchiveAfterDays = days
+	}
+	return b
+}
+
+func (b *Builder) SuspendedAt(t *time.Time) *Builder {
+	if b.err == nil {
+		b.c.suspendedAt = t
+	}
+	return b
+}
+
 func (b *Builder) CreatedAt(t time.Time) *Builder {
 	if b.err == nil {
 		b.c.createdAt = t
@@ -60,3 +91,32 @@ func (b *Builder) MustBuild() *Company {
 	}
 	return c
 }
+
+type Update struct {
+	Name                 *string
+	AutoArchiveAfterDays *int
+	Suspended            *bool
+}
+
+// ApplyUpdate returns a copy with the update applied. Suspending a company
+// that is already suspended keeps the original time.
+func (c *Company) ApplyUpdate(u Update, now time.Time) *Company {
+	newCompany := *c
+
+	if u.Name != nil {
+		newCompany.name = *u.Name
+	}
+	if u.AutoArchiveAfterDays != nil {
+		newCompany.autoArchiveAfterDays = *u.AutoArchiveAfterDays
+	}
+	if u.Suspended != nil {
+		switch {
+		case !*u.Suspended:
+			newCompany.suspendedAt = nil
+		case c.suspendedAt == nil:
+			newCompany.suspendedAt = &now
+		}
+	}
+
+	return &newCompany
+}
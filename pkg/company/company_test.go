@@ -0,0 +1,51 @@
+package company_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/company"
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+func TestCompany_ApplyUpdateSuspended(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	tests := []struct {
+		name        string
+		suspendedAt *time.Time
+		suspend     bool
+		want        *time.Time
+	}{
+		{name: "suspend", suspend: true, want: &now},
+		{name: "suspend again keeps the time", suspendedAt: &earlier, suspend: true, want: &earlier},
+		{name: "unsuspend", suspendedAt: &earlier, suspend: false},
+		{name: "unsuspend active company", suspend: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := company.NewBuilder().
+				ID(id.NewCompanyID()).
+				Name("Acme").
+				SuspendedAt(tt.suspendedAt).
+				MustBuild()
+
+			got := c.ApplyUpdate(company.Update{Suspended: &tt.suspend}, now)
+
+			switch {
+			case tt.want == nil && got.SuspendedAt() != nil:
+				t.Errorf("SuspendedAt() = %v, want nil", *got.SuspendedAt())
+			case tt.want != nil && (got.SuspendedAt() == nil || !got.SuspendedAt().Equal(*tt.want)):
+				t.Errorf("SuspendedAt() = %v, want %v", got.SuspendedAt(), *tt.want)
+			}
+			if got.IsSuspended() != (tt.want != nil) {
+				t.Errorf("IsSuspended() = %v, want %v", got.IsSuspended(), tt.want != nil)
+			}
+			if c.IsSuspended() != (tt.suspendedAt != nil) {
+				t.Error("ApplyUpdate() changed the original company")
+			}
+		})
+	}
+}
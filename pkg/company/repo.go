@@ -4,8 +4,16 @@ import (
 	"context"
 
 	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+	"github.com/pyshx/todoapp/pkg/user"
 )
 
 type Repo interface {
+	// Create stores a new company together with its first owner and its
+	// workflow, so a tenant is never left without either.
+	Create(ctx context.Context, c *Company, owner *user.User, workflow *task.Workflow) error
 	FindByID(ctx context.Context, id id.CompanyID) (*Company, error)
+	// List returns every company, oldest first.
+	List(ctx context.Context) ([]*Company, error)
+	Update(ctx context.Context, c *Company) error
 }
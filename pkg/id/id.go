@@ -52,25 +52,60 @@ func (id *ID[T]) UnmarshalJSON(data []byte) error {
 }
 
 type (
-	companyIDType struct{}
-	userIDType    struct{}
-	taskIDType    struct{}
+	companyIDType      struct{}
+	userIDType         struct{}
+	taskIDType         struct{}
+	labelIDType        struct{}
+	seriesIDType       struct{}
+	commentIDType      struct{}
+	notificationIDType struct{}
+	historyIDType      struct{}
+	inviteIDType       struct{}
+	teamIDType         struct{}
 )
 
 type (
-	CompanyID = ID[companyIDType]
-	UserID    = ID[userIDType]
-	TaskID    = ID[taskIDType]
+	CompanyID      = ID[companyIDType]
+	UserID         = ID[userIDType]
+	TaskID         = ID[taskIDType]
+	LabelID        = ID[labelIDType]
+	SeriesID       = ID[seriesIDType]
+	CommentID      = ID[commentIDType]
+	NotificationID = ID[notificationIDType]
+	HistoryID      = ID[historyIDType]
+	InviteID       = ID[inviteIDType]
+	TeamID         = ID[teamIDType]
 )
 
-func NewCompanyID() CompanyID { return New[companyIDType]() }
-func NewUserID() UserID       { return New[userIDType]() }
-func NewTaskID() TaskID       { return New[taskIDType]() }
+func NewCompanyID() CompanyID           { return New[companyIDType]() }
+func NewUserID() UserID                 { return New[userIDType]() }
+func NewTaskID() TaskID                 { return New[taskIDType]() }
+func NewLabelID() LabelID               { return New[labelIDType]() }
+func NewSeriesID() SeriesID             { return New[seriesIDType]() }
+func NewCommentID() CommentID           { return New[commentIDType]() }
+func NewNotificationID() NotificationID { return New[notificationIDType]() }
+func NewHistoryID() HistoryID           { return New[historyIDType]() }
+func NewInviteID() InviteID             { return New[inviteIDType]() }
+func NewTeamID() TeamID                 { return New[teamIDType]() }
 
-func ParseCompanyID(s string) (CompanyID, error) { return Parse[companyIDType](s) }
-func ParseUserID(s string) (UserID, error)       { return Parse[userIDType](s) }
-func ParseTaskID(s string) (TaskID, error)       { return Parse[taskIDType](s) }
+func ParseCompanyID(s string) (CompanyID, error)           { return Parse[companyIDType](s) }
+func ParseUserID(s string) (UserID, error)                 { return Parse[userIDType](s) }
+func ParseTaskID(s string) (TaskID, error)                 { return Parse[taskIDType](s) }
+func ParseLabelID(s string) (LabelID, error)               { return Parse[labelIDType](s) }
+func ParseSeriesID(s string) (SeriesID, error)             { return Parse[seriesIDType](s) }
+func ParseCommentID(s string) (CommentID, error)           { return Parse[commentIDType](s) }
+func ParseNotificationID(s string) (NotificationID, error) { return Parse[notificationIDType](s) }
+func ParseHistoryID(s string) (HistoryID, error)           { return Parse[historyIDType](s) }
+func ParseInviteID(s string) (InviteID, error)             { return Parse[inviteIDType](s) }
+func ParseTeamID(s string) (TeamID, error)                 { return Parse[teamIDType](s) }
 
-func MustParseCompanyID(s string) CompanyID { return MustParse[companyIDType](s) }
-func MustParseUserID(s string) UserID       { return MustParse[userIDType](s) }
-func MustParseTaskID(s string) TaskID       { return MustParse[taskIDType](s) }
+func MustParseCompanyID(s string) CompanyID           { return MustParse[companyIDType](s) }
+func MustParseUserID(s string) UserID                 { return MustParse[userIDType](s) }
+func MustParseTaskID(s string) TaskID                 { return MustParse[taskIDType](s) }
+func MustParseLabelID(s string) LabelID               { return MustParse[labelIDType](s) }
+func MustParseSeriesID(s string) SeriesID             { return MustParse[seriesIDType](s) }
+func MustParseCommentID(s string) CommentID           { return MustParse[commentIDType](s) }
+func MustParseNotificationID(s string) NotificationID { return MustParse[notificationIDType](s) }
+func MustParseHistoryID(s string) HistoryID           { return MustParse[historyIDType](s) }
+func MustParseInviteID(s string) InviteID             { return MustParse[inviteIDType](s) }
+func MustParseTeamID(s string) TeamID                 { return MustParse[teamIDType](s) }
@@ -0,0 +1,110 @@
+package label
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+const MaxNameLength = 50
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// IsValidColor reports whether s is a #RRGGBB hex color.
+func IsValidColor(s string) bool { return colorPattern.MatchString(s) }
+
+type Label struct {
+	id        id.LabelID
+	companyID id.CompanyID
+	name      string
+	color     string
+	createdAt time.Time
+}
+
+func (l *Label) ID() id.LabelID          { return l.id }
+func (l *Label) CompanyID() id.CompanyID { return l.companyID }
+func (l *Label) Name() string            { return l.name }
+func (l *Label) Color() string           { return l.color }
+func (l *Label) CreatedAt() time.Time    { return l.createdAt }
+
+func (l *Label) BelongsToCompany(companyID id.CompanyID) bool {
+	return l.companyID.Equal(companyID)
+}
+
+type Builder struct {
+	l   *Label
+	err error
+}
+
+func NewBuilder() *Builder {
+	return &Builder{l: &Label{}}
+}
+
+func (b *Builder) ID(id id.LabelID) *Builder {
+	if b.err == nil {
+		b.l.id = id
+	}
+	return b
+}
+
+func (b *Builder) CompanyID(companyID id.CompanyID) *Builder {
+	if b.err == nil {
+		b.l.companyID = companyID
+	}
+	return b
+}
+
+func (b *Builder) Name(name string) *Builder {
+	if b.err == nil {
+		b.l.name = name
+	}
+	return b
+}
+
+func (b *Builder) Color(color string) *Builder {
+	if b.err == nil {
+		b.l.color = color
+	}
+	return b
+}
+
+func (b *Builder) CreatedAt(t time.Time) *Builder {
+	if b.err == nil {
+		b.l.createdAt = t
+	}
+	return b
+}
+
+func (b *Builder) Build() (*Label, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.l, nil
+}
+
+func (b *Builder) MustBuild() *Label {
+	l, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return l
+}
+
+type Update struct {
+	Name  *string
+	Color *string
+}
+
+func (l *Label) ApplyUpdate(u Update) *Label {
+	newLabel := *l
+
+	if u.Name != nil {
+		newLabel.name = *u.Name
+	}
+	if u.Color != nil {
+		newLabel.color = *u.Color
+	}
+
+	return &newLabel
+}
@@ -0,0 +1,29 @@
+package label_test
+
+import (
+	"testing"
+
+	"github.com/pyshx/todoapp/pkg/label"
+)
+
+func TestIsValidColor(t *testing.T) {
+	tests := []struct {
+		color string
+		want  bool
+	}{
+		{"#ff0000", true},
+		{"#A1B2C3", true},
+		{"ff0000", false},
+		{"#fff", false},
+		{"#gggggg", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.color, func(t *testing.T) {
+			if got := label.IsValidColor(tt.color); got != tt.want {
+				t.Errorf("IsValidColor() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package label
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+type Repo interface {
+	Create(ctx context.Context, label *Label) error
+	FindByIDForCompany(ctx context.Context, labelID id.LabelID, companyID id.CompanyID) (*Label, error)
+	FindByIDsForCompany(ctx context.Context, labelIDs []id.LabelID, companyID id.CompanyID) ([]*Label, error)
+	ListByCompany(ctx context.Context, companyID id.CompanyID) ([]*Label, error)
+	Update(ctx context.Context, label *Label) error
+	Delete(ctx context.Context, labelID id.LabelID, companyID id.CompanyID) error
+}
@@ -0,0 +1,63 @@
+// Package mention finds @-mentions of users in free text and records who was
+// mentioned where.
+package mention
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+// handlePattern matches "@" followed by an email address or a user ID. The
+// mention must start the text or follow a character that cannot be part of
+// an email, so "bob@example.com" alone is not a mention.
+var handlePattern = regexp.MustCompile(`(?:^|[^\w.@+-])@([\w.%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}|[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})`)
+
+// Handle is a mention as written: either an email or a user ID.
+type Handle struct {
+	Email  string
+	UserID *id.UserID
+}
+
+// Parse returns the distinct handles mentioned in text, in order of first
+// appearance. Emails are lowercased.
+func Parse(text string) []Handle {
+	var handles []Handle
+	seen := make(map[string]bool)
+
+	for _, m := range handlePattern.FindAllStringSubmatch(text, -1) {
+		raw := strings.ToLower(m[1])
+		if seen[raw] {
+			continue
+		}
+		seen[raw] = true
+
+		if strings.Contains(raw, "@") {
+			handles = append(handles, Handle{Email: raw})
+			continue
+		}
+		userID, err := id.ParseUserID(raw)
+		if err != nil {
+			continue
+		}
+		handles = append(handles, Handle{UserID: &userID})
+	}
+
+	return handles
+}
+
+// Source is where mentions were written: a task's description, or one of
+// its comments when CommentID is set.
+type Source struct {
+	CompanyID id.CompanyID
+	TaskID    id.TaskID
+	CommentID *id.CommentID
+}
+
+type Repo interface {
+	// Replace makes userIDs the full set of users mentioned in source and
+	// returns the ones that were not mentioned there before.
+	Replace(ctx context.Context, source Source, userIDs []id.UserID) ([]id.UserID, error)
+}
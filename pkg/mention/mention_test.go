@@ -0,0 +1,45 @@
+package mention_test
+
+import (
+	"testing"
+
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/mention"
+)
+
+func TestParse(t *testing.T) {
+	userID := id.NewUserID()
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"email", "ping @alice@example.com please", []string{"alice@example.com"}},
+		{"start of text", "@bob@example.com: done?", []string{"bob@example.com"}},
+		{"trailing punctuation", "thanks @bob@example.com.", []string{"bob@example.com"}},
+		{"user id", "cc @" + userID.String(), []string{userID.String()}},
+		{"case folded and deduplicated", "@Alice@Example.com and @alice@example.com", []string{"alice@example.com"}},
+		{"plain email is not a mention", "mail alice@example.com", nil},
+		{"bare at sign", "meet @ noon", nil},
+		{"several", "(@a@x.io, @b@x.io)", []string{"a@x.io", "b@x.io"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handles := mention.Parse(tt.text)
+			if len(handles) != len(tt.want) {
+				t.Fatalf("Parse() returned %d handles, want %d: %+v", len(handles), len(tt.want), handles)
+			}
+			for i, h := range handles {
+				got := h.Email
+				if h.UserID != nil {
+					got = h.UserID.String()
+				}
+				if got != tt.want[i] {
+					t.Errorf("handle %d = %q, want %q", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+// Package notification models each user's inbox of task activity.
+package notification
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+type Kind string
+
+const (
+	// KindMention is sent to a user mentioned in a task description or
+	// comment.
+	KindMention Kind = "mention"
+	// KindAssigned is sent to the new assignee of a task.
+	KindAssigned Kind = "assigned"
+	// KindStatusChanged is sent to a task's creator when someone else moves
+	// it to another status.
+	KindStatusChanged Kind = "status_changed"
+	// KindDueSoon is sent once per due date to the assignee, or the creator
+	// of an unassigned task, shortly before the task is due.
+	KindDueSoon Kind = "due_soon"
+	// KindTaskUpdated is sent to a task's watchers when someone else changes
+	// it, listing the fields that changed.
+	KindTaskUpdated Kind = "task_updated"
+)
+
+func (k Kind) IsValid() bool {
+	switch k {
+	case KindMention, KindAssigned, KindStatusChanged, KindDueSoon, KindTaskUpdated:
+		return true
+	}
+	return false
+}
+
+func (k Kind) String() string { return string(k) }
+
+func ParseKind(s string) (Kind, bool) {
+	k := Kind(s)
+	if !k.IsValid() {
+		return "", false
+	}
+	return k, true
+}
+
+type Notification struct {
+	id          id.NotificationID
+	companyID   id.CompanyID
+	recipientID id.UserID
+	actorID     *id.UserID
+	kind        Kind
+	taskID      id.TaskID
+	commentID   *id.CommentID
+	dueDate     *time.Time
+	fields      []string
+	createdAt   time.Time
+	readAt      *time.Time
+}
+
+func (n *Notification) ID() id.NotificationID    { return n.id }
+func (n *Notification) CompanyID() id.CompanyID  { return n.companyID }
+func (n *Notification) RecipientID() id.UserID   { return n.recipientID }
+func (n *Notification) ActorID() *id.UserID      { return n.actorID }
+func (n *Notification) Kind() Kind               { return n.kind }
+func (n *Notification) TaskID() id.TaskID        { return n.taskID }
+func (n *Notification) CommentID() *id.CommentID { return n.commentID }
+func (n *Notification) DueDate() *time.Time      { return n.dueDate }
+func (n *Notification) ChangedFields() []string  { return n.fields }
+func (n *Notification) CreatedAt() time.Time     { return n.createdAt }
+func (n *Notification) ReadAt() *time.Time       { return n.readAt }
+
+// IsRead reports whether the recipient has marked the notification read.
+func (n *Notification) IsRead() bool { return n.readAt != nil }
+
+type Builder struct {
+	n   *Notification
+	err error
+}
+
+func NewBuilder() *Builder {
+	return &Builder{n: &Notification{}}
+}
+
+func (b *Builder) ID(id id.NotificationID) *Builder {
+	if b.err == nil {
+		b.n.id = id
+	}
+	return b
+}
+
+func (b *Builder) CompanyID(companyID id.CompanyID) *Builder {
+	if b.err == nil {
+		b.n.companyID = companyID
+	}
+	return b
+}
+
+func (b *Builder) RecipientID(recipientID id.UserID) *Builder {
+	if b.err == nil {
+		b.n.recipientID = recipientID
+	}
+	return b
+}
+
+// ActorID is the user whose action raised the notification; nil for
+// notifications raised by the system, such as KindDueSoon.
+func (b *Builder) ActorID(actorID *id.UserID) *Builder {
+	if b.err == nil {
+		b.n.actorID = actorID
+	}
+	return b
+}
+
+func (b *Builder) Kind(kind Kind) *Builder {
+	if b.err == nil {
+		b.n.kind = kind
+	}
+	return b
+}
+
+func (b *Builder) TaskID(taskID id.TaskID) *Builder {
+	if b.err == nil {
+		b.n.taskID = taskID
+	}
+	return b
+}
+
+func (b *Builder) CommentID(commentID *id.CommentID) *Builder {
+	if b.err == nil {
+		b.n.commentID = commentID
+	}
+	return b
+}
+
+func (b *Builder) DueDate(t *time.Time) *Builder {
+	if b.err == nil {
+		b.n.dueDate = t
+	}
+	return b
+}
+
+// ChangedFields lists the task fields a KindTaskUpdated notification is
+// about.
+func (b *Builder) ChangedFields(fields []string) *Builder {
+	if b.err == nil {
+		b.n.fields = fields
+	}
+	return b
+}
+
+func (b *Builder) CreatedAt(t time.Time) *Builder {
+	if b.err == nil {
+		b.n.createdAt = t
+	}
+	return b
+}
+
+func (b *Builder) ReadAt(t *time.Time) *Builder {
+	if b.err == nil {
+		b.n.readAt = t
+	}
+	return b
+}
+
+func (b *Builder) Build() (*Notification, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.n, nil
+}
+
+func (b *Builder) MustBuild() *Notification {
+	n, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// PageCursor marks the last notification of a page; inboxes list newest
+// first.
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        id.NotificationID
+}
+
+type ListOptions struct {
+	PageSize   int
+	Cursor     *PageCursor
+	UnreadOnly bool
+}
+
+type ListResult struct {
+	Notifications []*Notification
+	NextCursor    *PageCursor
+}
+
+type Repo interface {
+	Create(ctx context.Context, notifications []*Notification) error
+	ListForRecipient(ctx context.Context, recipientID id.UserID, opts ListOptions) (*ListResult, error)
+	// MarkRead marks the recipient's notifications with the given IDs as
+	// read, or all of them when ids is empty, and returns how many changed.
+	MarkRead(ctx context.Context, recipientID id.UserID, ids []id.NotificationID, at time.Time) (int, error)
+	CountUnread(ctx context.Context, recipientID id.UserID) (int, error)
+	// CreateDueSoon raises KindDueSoon for open, unarchived tasks due within
+	// window of now, at most once per task and due date, for active
+	// recipients only, and returns how many it made.
+	CreateDueSoon(ctx context.Context, now time.Time, window time.Duration) (int, error)
+}
@@ -0,0 +1,360 @@
+// Package recurrence implements the subset of RFC 5545 recurrence rules the
+// task series support: FREQ (DAILY, WEEKLY, MONTHLY), INTERVAL, BYDAY, COUNT
+// and UNTIL. Weeks start on Monday.
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+)
+
+func (f Frequency) IsValid() bool { return f == Daily || f == Weekly || f == Monthly }
+
+// MaxInterval bounds INTERVAL so that finding the next occurrence stays cheap.
+const MaxInterval = 1000
+
+// maxPeriods bounds the search for the next occurrence. A period is one
+// interval's worth of days, weeks or months; every valid rule has a match
+// within a few periods.
+const maxPeriods = 1000
+
+// WeekdayNum is a BYDAY entry. N selects the N-th weekday of the month
+// (negative counts from the end) and is only allowed with MONTHLY; zero
+// means every such weekday.
+type WeekdayNum struct {
+	Weekday time.Weekday
+	N       int
+}
+
+// Rule is a parsed recurrence rule. Until, when set, is inclusive; a
+// date-only UNTIL covers that whole calendar day in the series time zone.
+type Rule struct {
+	Freq      Frequency
+	Interval  int
+	ByDay     []WeekdayNum
+	Count     int
+	Until     *time.Time
+	untilDate bool
+}
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+var weekdayNames = map[time.Weekday]string{
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+	time.Sunday:    "SU",
+}
+
+const (
+	untilDateTimeLayout = "20060102T150405Z"
+	untilDateLayout     = "20060102"
+)
+
+// Parse reads a rule such as "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;COUNT=10".
+// An "RRULE:" prefix is accepted.
+func Parse(s string) (Rule, error) {
+	r := Rule{Interval: 1}
+
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return r, errors.New("rule is empty")
+	}
+
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return r, fmt.Errorf("malformed rule part %q", part)
+		}
+		name = strings.ToUpper(name)
+		if seen[name] {
+			return r, fmt.Errorf("%s is given more than once", name)
+		}
+		seen[name] = true
+
+		switch name {
+		case "FREQ":
+			r.Freq = Frequency(strings.ToUpper(value))
+			if !r.Freq.IsValid() {
+				return r, fmt.Errorf("FREQ must be DAILY, WEEKLY or MONTHLY")
+			}
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 || n > MaxInterval {
+				return r, fmt.Errorf("INTERVAL must be between 1 and %d", MaxInterval)
+			}
+			r.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return r, errors.New("COUNT must be a positive integer")
+			}
+			r.Count = n
+		case "UNTIL":
+			if t, err := time.Parse(untilDateTimeLayout, value); err == nil {
+				r.Until = &t
+			} else if t, err := time.Parse(untilDateLayout, value); err == nil {
+				r.Until = &t
+				r.untilDate = true
+			} else {
+				return r, errors.New("UNTIL must be YYYYMMDD or YYYYMMDDTHHMMSSZ")
+			}
+		case "BYDAY":
+			for _, code := range strings.Split(strings.ToUpper(value), ",") {
+				wd, err := parseWeekdayNum(code)
+				if err != nil {
+					return r, err
+				}
+				r.ByDay = append(r.ByDay, wd)
+			}
+		default:
+			return r, fmt.Errorf("%s is not supported", name)
+		}
+	}
+
+	if r.Freq == "" {
+		return r, errors.New("FREQ is required")
+	}
+	if r.Count > 0 && r.Until != nil {
+		return r, errors.New("COUNT and UNTIL cannot be combined")
+	}
+	if r.Freq != Monthly {
+		for _, wd := range r.ByDay {
+			if wd.N != 0 {
+				return r, errors.New("BYDAY ordinals are only allowed with FREQ=MONTHLY")
+			}
+		}
+	}
+
+	return r, nil
+}
+
+func parseWeekdayNum(code string) (WeekdayNum, error) {
+	if len(code) < 2 {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY value %q", code)
+	}
+	weekday, ok := weekdayCodes[code[len(code)-2:]]
+	if !ok {
+		return WeekdayNum{}, fmt.Errorf("invalid BYDAY value %q", code)
+	}
+
+	wd := WeekdayNum{Weekday: weekday}
+	if prefix := code[:len(code)-2]; prefix != "" {
+		n, err := strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return WeekdayNum{}, fmt.Errorf("invalid BYDAY value %q", code)
+		}
+		wd.N = n
+	}
+	return wd, nil
+}
+
+// String formats the rule in canonical RRULE form without the prefix.
+func (r Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		codes := make([]string, len(r.ByDay))
+		for i, wd := range r.ByDay {
+			codes[i] = weekdayNames[wd.Weekday]
+			if wd.N != 0 {
+				codes[i] = strconv.Itoa(wd.N) + codes[i]
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		if r.untilDate {
+			parts = append(parts, "UNTIL="+r.Until.Format(untilDateLayout))
+		} else {
+			parts = append(parts, "UNTIL="+r.Until.UTC().Format(untilDateTimeLayout))
+		}
+	}
+	return strings.Join(parts, ";")
+}
+
+// Next returns the occurrence that follows prev in a series that started at
+// start, where prev is the n-th occurrence (start itself being the first).
+// Occurrences keep the wall-clock time of start in loc across DST changes.
+// It reports false once COUNT or UNTIL is exhausted.
+func (r Rule) Next(start, prev time.Time, n int, loc *time.Location) (time.Time, bool) {
+	if r.Count > 0 && n >= r.Count {
+		return time.Time{}, false
+	}
+
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	start = start.In(loc)
+	prev = prev.In(loc)
+
+	// Start the search in the period containing prev
+	k := r.periodsBetween(start, prev) / interval
+	if k < 0 {
+		k = 0
+	}
+
+	for i := 0; i < maxPeriods; i++ {
+		for _, candidate := range r.candidates(start, (k+i)*interval, loc) {
+			if !candidate.After(prev) || candidate.Before(start) {
+				continue
+			}
+			if !r.withinUntil(candidate, loc) {
+				return time.Time{}, false
+			}
+			return candidate, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (r Rule) withinUntil(t time.Time, loc *time.Location) bool {
+	if r.Until == nil {
+		return true
+	}
+	if r.untilDate {
+		y, m, d := r.Until.Date()
+		return t.Before(time.Date(y, m, d+1, 0, 0, 0, 0, loc))
+	}
+	return !t.After(*r.Until)
+}
+
+// periodsBetween counts whole days, weeks or months from start to t.
+func (r Rule) periodsBetween(start, t time.Time) int {
+	switch r.Freq {
+	case Weekly:
+		return (civilDay(weekStart(t)) - civilDay(weekStart(start))) / 7
+	case Monthly:
+		return (t.Year()-start.Year())*12 + int(t.Month()) - int(start.Month())
+	default:
+		return civilDay(t) - civilDay(start)
+	}
+}
+
+// candidates returns the sorted occurrences in the period offset periods
+// after the one containing start.
+func (r Rule) candidates(start time.Time, offset int, loc *time.Location) []time.Time {
+	at := func(y int, m time.Month, d int) time.Time {
+		return time.Date(y, m, d, start.Hour(), start.Minute(), start.Second(), 0, loc)
+	}
+
+	var out []time.Time
+
+	switch r.Freq {
+	case Daily:
+		day := at(start.Year(), start.Month(), start.Day()+offset)
+		if len(r.ByDay) == 0 || r.hasWeekday(day.Weekday()) {
+			out = append(out, day)
+		}
+
+	case Weekly:
+		monday := weekStart(start).AddDate(0, 0, 7*offset)
+		if len(r.ByDay) == 0 {
+			out = append(out, at(monday.Year(), monday.Month(), monday.Day()+weekdayIndex(start.Weekday())))
+		}
+		for _, wd := range r.ByDay {
+			out = append(out, at(monday.Year(), monday.Month(), monday.Day()+weekdayIndex(wd.Weekday)))
+		}
+
+	case Monthly:
+		first := time.Date(start.Year(), start.Month()+time.Month(offset), 1, 0, 0, 0, 0, loc)
+		y, m := first.Year(), first.Month()
+		days := daysIn(y, m)
+
+		if len(r.ByDay) == 0 {
+			// Months without this day are skipped, as RFC 5545 requires
+			if start.Day() <= days {
+				out = append(out, at(y, m, start.Day()))
+			}
+		}
+		for _, wd := range r.ByDay {
+			for _, d := range monthDays(y, m, days, wd) {
+				out = append(out, at(y, m, d))
+			}
+		}
+	}
+
+	sort.Slice(out, func(i, j int) bool { return out[i].Before(out[j]) })
+	return out
+}
+
+func (r Rule) hasWeekday(weekday time.Weekday) bool {
+	for _, wd := range r.ByDay {
+		if wd.Weekday == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// monthDays returns the days of the month matching a BYDAY entry.
+func monthDays(y int, m time.Month, days int, wd WeekdayNum) []int {
+	firstWeekday := time.Date(y, m, 1, 0, 0, 0, 0, time.UTC).Weekday()
+	first := 1 + (int(wd.Weekday)-int(firstWeekday)+7)%7
+
+	var all []int
+	for d := first; d <= days; d += 7 {
+		all = append(all, d)
+	}
+
+	switch {
+	case wd.N > 0 && wd.N <= len(all):
+		return all[wd.N-1 : wd.N]
+	case wd.N < 0 && -wd.N <= len(all):
+		return all[len(all)+wd.N : len(all)+wd.N+1]
+	case wd.N == 0:
+		return all
+	}
+	return nil
+}
+
+func daysIn(y int, m time.Month) int {
+	return time.Date(y, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// weekdayIndex numbers weekdays from Monday (0) to Sunday (6).
+func weekdayIndex(weekday time.Weekday) int {
+	return (int(weekday) + 6) % 7
+}
+
+// weekStart returns midnight of the Monday starting t's week.
+func weekStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day()-weekdayIndex(t.Weekday()), 0, 0, 0, 0, t.Location())
+}
+
+// civilDay numbers calendar days so that differences ignore DST shifts.
+func civilDay(t time.Time) int {
+	return int(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
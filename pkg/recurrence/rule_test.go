@@ -0,0 +1,153 @@
+package recurrence_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/recurrence"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "FREQ=DAILY", want: "FREQ=DAILY"},
+		{in: "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE", want: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE"},
+		{in: "freq=monthly;byday=-1fr;count=6", want: "FREQ=MONTHLY;BYDAY=-1FR;COUNT=6"},
+		{in: "FREQ=DAILY;UNTIL=20261231", want: "FREQ=DAILY;UNTIL=20261231"},
+		{in: "FREQ=DAILY;UNTIL=20261231T170000Z", want: "FREQ=DAILY;UNTIL=20261231T170000Z"},
+		{in: "", wantErr: true},
+		{in: "INTERVAL=2", wantErr: true},
+		{in: "FREQ=YEARLY", wantErr: true},
+		{in: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{in: "FREQ=DAILY;COUNT=3;UNTIL=20261231", wantErr: true},
+		{in: "FREQ=WEEKLY;BYDAY=1MO", wantErr: true},
+		{in: "FREQ=MONTHLY;BYDAY=6MO", wantErr: true},
+		{in: "FREQ=DAILY;BYHOUR=9", wantErr: true},
+		{in: "FREQ=DAILY;FREQ=WEEKLY", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			r, err := recurrence.Parse(tt.in)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %s", r)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := r.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRule_Next(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	date := func(y int, m time.Month, d, h int) time.Time {
+		return time.Date(y, m, d, h, 0, 0, 0, berlin)
+	}
+
+	tests := []struct {
+		name  string
+		rule  string
+		start time.Time
+		want  []time.Time
+	}{
+		{
+			name:  "daily keeps wall clock across DST",
+			rule:  "FREQ=DAILY",
+			start: date(2026, time.March, 28, 9),
+			want:  []time.Time{date(2026, time.March, 29, 9), date(2026, time.March, 30, 9)},
+		},
+		{
+			name:  "every other day",
+			rule:  "FREQ=DAILY;INTERVAL=2",
+			start: date(2026, time.January, 1, 9),
+			want:  []time.Time{date(2026, time.January, 3, 9), date(2026, time.January, 5, 9)},
+		},
+		{
+			name:  "weekdays only",
+			rule:  "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			start: date(2026, time.October, 16, 9), // Friday
+			want:  []time.Time{date(2026, time.October, 19, 9), date(2026, time.October, 20, 9)},
+		},
+		{
+			name:  "weekly on start weekday",
+			rule:  "FREQ=WEEKLY",
+			start: date(2026, time.October, 14, 18), // Wednesday
+			want:  []time.Time{date(2026, time.October, 21, 18), date(2026, time.October, 28, 18)},
+		},
+		{
+			name:  "biweekly on monday and thursday",
+			rule:  "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TH",
+			start: date(2026, time.October, 12, 9), // Monday
+			want: []time.Time{
+				date(2026, time.October, 15, 9),
+				date(2026, time.October, 26, 9),
+				date(2026, time.October, 29, 9),
+			},
+		},
+		{
+			name:  "monthly skips short months",
+			rule:  "FREQ=MONTHLY",
+			start: date(2026, time.January, 31, 9),
+			want:  []time.Time{date(2026, time.March, 31, 9), date(2026, time.May, 31, 9)},
+		},
+		{
+			name:  "last friday of the month",
+			rule:  "FREQ=MONTHLY;BYDAY=-1FR",
+			start: date(2026, time.January, 30, 16),
+			want:  []time.Time{date(2026, time.February, 27, 16), date(2026, time.March, 27, 16)},
+		},
+		{
+			name:  "count limits occurrences",
+			rule:  "FREQ=DAILY;COUNT=2",
+			start: date(2026, time.January, 1, 9),
+			want:  []time.Time{date(2026, time.January, 2, 9)},
+		},
+		{
+			name:  "date-only until is inclusive",
+			rule:  "FREQ=DAILY;UNTIL=20260103",
+			start: date(2026, time.January, 1, 23),
+			want:  []time.Time{date(2026, time.January, 2, 23), date(2026, time.January, 3, 23)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := recurrence.Parse(tt.rule)
+			if err != nil {
+				t.Fatalf("failed to parse rule: %v", err)
+			}
+
+			prev := tt.start
+			for i, want := range tt.want {
+				got, ok := r.Next(tt.start, prev, i+1, berlin)
+				if !ok {
+					t.Fatalf("occurrence %d: series ended early", i+2)
+				}
+				if !got.Equal(want) {
+					t.Fatalf("occurrence %d = %v, want %v", i+2, got, want)
+				}
+				prev = got
+			}
+
+			if r.Count > 0 || r.Until != nil {
+				if got, ok := r.Next(tt.start, prev, len(tt.want)+1, berlin); ok {
+					t.Errorf("expected series to end, got %v", got)
+				}
+			}
+		})
+	}
+}
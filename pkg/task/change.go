@@ -0,0 +1,147 @@
+package task
+
+import (
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+// Field names a task field that a change touched. The values match the API
+// field names.
+type Field string
+
+const (
+	FieldTitle       Field = "title"
+	FieldDescription Field = "description"
+	FieldAssignees   Field = "assignee_ids"
+	FieldParent      Field = "parent_id"
+	FieldDueDate     Field = "due_date"
+	FieldVisibility  Field = "visibility"
+	FieldTeam        Field = "team_id"
+	FieldStatus      Field = "status"
+	FieldPriority    Field = "priority"
+	FieldLabels      Field = "label_ids"
+	FieldArchived    Field = "archived_at"
+	// FieldCreator only changes when a departing user's tasks are handed
+	// over; creation history does not list it.
+	FieldCreator Field = "creator_id"
+)
+
+func (f Field) String() string { return string(f) }
+
+// Change is a saved edit of a task. Before is nil when the task was created.
+type Change struct {
+	Before *Task
+	After  *Task
+	// Fields lists what differs between Before and After, in declaration
+	// order; it is empty for created tasks and for updates that set fields
+	// to the values they already had.
+	Fields []Field
+}
+
+func NewChange(before, after *Task) Change {
+	c := Change{Before: before, After: after}
+	if before != nil {
+		c.Fields = Diff(before, after)
+	}
+	return c
+}
+
+// NewAssigneeIDs returns the users the change assigned the task to who were
+// not assigned before; for created tasks that is every assignee.
+func (c Change) NewAssigneeIDs() []id.UserID {
+	if c.Before == nil {
+		return c.After.assigneeIDs
+	}
+	var ids []id.UserID
+	for _, a := range c.After.assigneeIDs {
+		if !c.Before.IsAssignee(a) {
+			ids = append(ids, a)
+		}
+	}
+	return ids
+}
+
+// Has reports whether the change touched f.
+func (c Change) Has(f Field) bool {
+	for _, changed := range c.Fields {
+		if changed == f {
+			return true
+		}
+	}
+	return false
+}
+
+// Diff returns the fields whose values differ between two versions of a
+// task. The order of labels and assignees is ignored.
+func Diff(before, after *Task) []Field {
+	var fields []Field
+	if before.title != after.title {
+		fields = append(fields, FieldTitle)
+	}
+	if !equalPtr(before.description, after.description, func(a, b string) bool { return a == b }) {
+		fields = append(fields, FieldDescription)
+	}
+	if !sameUsers(before.assigneeIDs, after.assigneeIDs) {
+		fields = append(fields, FieldAssignees)
+	}
+	if !equalPtr(before.parentID, after.parentID, id.TaskID.Equal) {
+		fields = append(fields, FieldParent)
+	}
+	if !equalPtr(before.dueDate, after.dueDate, time.Time.Equal) {
+		fields = append(fields, FieldDueDate)
+	}
+	if before.visibility != after.visibility {
+		fields = append(fields, FieldVisibility)
+	}
+	if !equalPtr(before.teamID, after.teamID, id.TeamID.Equal) {
+		fields = append(fields, FieldTeam)
+	}
+	if before.status != after.status {
+		fields = append(fields, FieldStatus)
+	}
+	if before.priority != after.priority {
+		fields = append(fields, FieldPriority)
+	}
+	if !sameLabels(before.labelIDs, after.labelIDs) {
+		fields = append(fields, FieldLabels)
+	}
+	if !equalPtr(before.archivedAt, after.archivedAt, time.Time.Equal) {
+		fields = append(fields, FieldArchived)
+	}
+	if !before.creatorID.Equal(after.creatorID) {
+		fields = append(fields, FieldCreator)
+	}
+	return fields
+}
+
+func equalPtr[T any](a, b *T, equal func(T, T) bool) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return equal(*a, *b)
+}
+
+func sameUsers(a, b []id.UserID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, u := range a {
+		if !containsUser(b, u) {
+			return false
+		}
+	}
+	return true
+}
+
+func sameLabels(a, b []id.LabelID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, l := range a {
+		if !containsLabel(b, l) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,32 @@
+package task
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+// DependencyRepo stores "blocker blocks blocked" edges between tasks of the
+// same company. The edges form a DAG.
+type DependencyRepo interface {
+	// Add fails with invalid input when blockedID already blocks blockerID,
+	// directly or transitively, so that the edge would close a cycle. The
+	// check and the insert are atomic with respect to concurrent Adds.
+	Add(ctx context.Context, companyID id.CompanyID, blockerID, blockedID id.TaskID) error
+	Remove(ctx context.Context, companyID id.CompanyID, blockerID, blockedID id.TaskID) error
+	// ListBlockers returns the tasks that directly block taskID.
+	ListBlockers(ctx context.Context, companyID id.CompanyID, taskID id.TaskID) ([]*Task, error)
+	// ListDependents returns the tasks that taskID directly blocks.
+	ListDependents(ctx context.Context, companyID id.CompanyID, taskID id.TaskID) ([]*Task, error)
+}
+
+// UnfinishedBlockerIDs returns the IDs of blockers that are not done yet.
+func UnfinishedBlockerIDs(blockers []*Task) []id.TaskID {
+	var ids []id.TaskID
+	for _, b := range blockers {
+		if !b.IsDone() {
+			ids = append(ids, b.id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,55 @@
+package task
+
+import (
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+// OnlyMeHandover says what becomes of a departing user's only_me tasks,
+// which nobody else may have been able to see.
+type OnlyMeHandover string
+
+const (
+	// OnlyMeKeepPrivate keeps them only_me; the new creator sees them.
+	OnlyMeKeepPrivate OnlyMeHandover = "keep_private"
+	// OnlyMeShare makes them company_wide.
+	OnlyMeShare OnlyMeHandover = "share"
+)
+
+func (h OnlyMeHandover) IsValid() bool {
+	return h == OnlyMeKeepPrivate || h == OnlyMeShare
+}
+
+// Handover moves what a departing user holds on their company's tasks to
+// other users.
+type Handover struct {
+	CompanyID id.CompanyID
+	FromID    id.UserID
+	// CreatorID becomes the creator of every task and series FromID created.
+	CreatorID id.UserID
+	// AssigneeID takes FromID's place among the assignees; nil just
+	// removes FromID.
+	AssigneeID *id.UserID
+	OnlyMe     OnlyMeHandover
+}
+
+// HandOver applies h to the task. The result has no Changes when h.FromID
+// neither created the task nor is assigned to it.
+func (t *Task) HandOver(h Handover, now time.Time) *Task {
+	var u Update
+	if t.creatorID.Equal(h.FromID) {
+		u.CreatorID = &h.CreatorID
+		if t.visibility == VisibilityOnlyMe && h.OnlyMe == OnlyMeShare {
+			companyWide := VisibilityCompanyWide
+			u.Visibility = &companyWide
+		}
+	}
+	if t.IsAssignee(h.FromID) {
+		u.RemoveAssigneeIDs = []id.UserID{h.FromID}
+		if h.AssigneeID != nil {
+			u.AddAssigneeIDs = []id.UserID{*h.AssigneeID}
+		}
+	}
+	return t.ApplyUpdate(u, now)
+}
@@ -0,0 +1,161 @@
+package task
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+type HistoryAction string
+
+const (
+	HistoryCreated  HistoryAction = "created"
+	HistoryUpdated  HistoryAction = "updated"
+	HistoryDeleted  HistoryAction = "deleted"
+	HistoryRestored HistoryAction = "restored"
+	HistoryPurged   HistoryAction = "purged"
+)
+
+func (a HistoryAction) String() string { return string(a) }
+
+func ParseHistoryAction(s string) (HistoryAction, bool) {
+	switch a := HistoryAction(s); a {
+	case HistoryCreated, HistoryUpdated, HistoryDeleted, HistoryRestored, HistoryPurged:
+		return a, true
+	}
+	return "", false
+}
+
+// FieldChange is one field's value before and after a change, rendered as
+// text: IDs and enums as their string form, due dates in RFC 3339 and labels
+// as sorted, comma-separated IDs. A nil value means the field was unset.
+type FieldChange struct {
+	Field  Field
+	Before *string
+	After  *string
+}
+
+// HistoryEntry is an immutable record of one change to a task. ActorID is nil
+// for changes made by the server, such as generated recurring instances.
+type HistoryEntry struct {
+	ID        id.HistoryID
+	CompanyID id.CompanyID
+	TaskID    id.TaskID
+	ActorID   *id.UserID
+	Action    HistoryAction
+	Version   int
+	Changes   []FieldChange
+	CreatedAt time.Time
+}
+
+// HistoryCursor marks the last entry of a page; history lists newest first.
+type HistoryCursor struct {
+	CreatedAt time.Time
+	ID        id.HistoryID
+}
+
+type HistoryListOptions struct {
+	PageSize int
+	Cursor   *HistoryCursor
+}
+
+type HistoryListResult struct {
+	Entries    []*HistoryEntry
+	NextCursor *HistoryCursor
+}
+
+// HistoryRepo reads the history that Repo writes alongside every Create,
+// Update and Delete.
+type HistoryRepo interface {
+	ListByTask(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, opts HistoryListOptions) (*HistoryListResult, error)
+}
+
+var allFields = []Field{
+	FieldTitle, FieldDescription, FieldAssignees, FieldParent, FieldDueDate,
+	FieldVisibility, FieldTeam, FieldStatus, FieldPriority, FieldLabels,
+	FieldArchived,
+}
+
+// FieldChanges returns the old and new value of every field that differs
+// between two versions of a task. When before is nil the task is new and
+// every field that is set is reported.
+func FieldChanges(before, after *Task) []FieldChange {
+	fields := allFields
+	if before != nil {
+		fields = Diff(before, after)
+	}
+
+	var changes []FieldChange
+	for _, f := range fields {
+		c := FieldChange{Field: f, After: after.fieldValue(f)}
+		if before != nil {
+			c.Before = before.fieldValue(f)
+		} else if c.After == nil {
+			continue
+		}
+		changes = append(changes, c)
+	}
+	return changes
+}
+
+func (t *Task) fieldValue(f Field) *string {
+	var s string
+	switch f {
+	case FieldTitle:
+		s = t.title
+	case FieldDescription:
+		return t.description
+	case FieldCreator:
+		s = t.creatorID.String()
+	case FieldAssignees:
+		if len(t.assigneeIDs) == 0 {
+			return nil
+		}
+		ids := make([]string, len(t.assigneeIDs))
+		for i, a := range t.assigneeIDs {
+			ids[i] = a.String()
+		}
+		sort.Strings(ids)
+		s = strings.Join(ids, ",")
+	case FieldParent:
+		if t.parentID == nil {
+			return nil
+		}
+		s = t.parentID.String()
+	case FieldDueDate:
+		if t.dueDate == nil {
+			return nil
+		}
+		s = t.dueDate.UTC().Format(time.RFC3339Nano)
+	case FieldArchived:
+		if t.archivedAt == nil {
+			return nil
+		}
+		s = t.archivedAt.UTC().Format(time.RFC3339Nano)
+	case FieldVisibility:
+		s = t.visibility.String()
+	case FieldTeam:
+		if t.teamID == nil {
+			return nil
+		}
+		s = t.teamID.String()
+	case FieldStatus:
+		s = t.status.String()
+	case FieldPriority:
+		s = t.priority.String()
+	case FieldLabels:
+		if len(t.labelIDs) == 0 {
+			return nil
+		}
+		ids := make([]string, len(t.labelIDs))
+		for i, l := range t.labelIDs {
+			ids[i] = l.String()
+		}
+		sort.Strings(ids)
+		s = strings.Join(ids, ",")
+	}
+	return &s
+}
@@ -7,14 +7,80 @@ import (
 	"github.com/pyshx/todoapp/pkg/id"
 )
 
+// PageCursor identifies the last row of a page by its sort key and ID. Only
+// the key matching Sort.Field is meaningful; DueDate is nil when that row has
+// no due date.
 type PageCursor struct {
+	Sort      Sort
 	CreatedAt time.Time
+	UpdatedAt time.Time
+	DueDate   *time.Time
+	Title     string
+	Status    Status
+	Priority  Priority
 	ID        id.TaskID
 }
 
+func NewPageCursor(t *Task, sort Sort) *PageCursor {
+	return &PageCursor{
+		Sort:      sort,
+		CreatedAt: t.CreatedAt(),
+		UpdatedAt: t.UpdatedAt(),
+		DueDate:   t.DueDate(),
+		Title:     t.Title(),
+		Status:    t.Status(),
+		Priority:  t.Priority(),
+		ID:        t.ID(),
+	}
+}
+
+// Filter narrows a task listing. Zero-valued fields are ignored; "after"
+// bounds are inclusive and "before" bounds are exclusive.
+type Filter struct {
+	ParentID      *id.TaskID // Direct subtasks of this task
+	Statuses      []Status
+	Priorities    []Priority
+	AssigneeID    *id.UserID // Tasks assigned to this user, possibly among others
+	Unassigned    bool
+	CreatorID     *id.UserID
+	Visibility    *Visibility
+	DueAfter      *time.Time
+	DueBefore     *time.Time
+	OverdueOnly   bool
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	UpdatedAfter  *time.Time
+	UpdatedBefore *time.Time
+	LabelIDs      []id.LabelID // Tasks carrying all of these labels
+	// IncludeArchived lists archived tasks alongside the others; they are
+	// left out by default.
+	IncludeArchived bool
+}
+
+func (f Filter) IsEmpty() bool {
+	return f.ParentID == nil &&
+		len(f.Statuses) == 0 &&
+		len(f.Priorities) == 0 &&
+		f.AssigneeID == nil &&
+		!f.Unassigned &&
+		f.CreatorID == nil &&
+		f.Visibility == nil &&
+		f.DueAfter == nil &&
+		f.DueBefore == nil &&
+		!f.OverdueOnly &&
+		f.CreatedAfter == nil &&
+		f.CreatedBefore == nil &&
+		f.UpdatedAfter == nil &&
+		f.UpdatedBefore == nil &&
+		len(f.LabelIDs) == 0 &&
+		!f.IncludeArchived
+}
+
 type ListOptions struct {
 	PageSize int
 	Cursor   *PageCursor
+	Filter   Filter
+	Sort     Sort
 }
 
 type ListResult struct {
@@ -22,12 +88,45 @@ type ListResult struct {
 	NextCursor *PageCursor
 }
 
+// Repo stores tasks. Create, Update, Delete, Restore and Purge each append a
+// HistoryEntry in the same transaction; Create attributes it to the task's
+// creator.
+//
+// Delete moves a task to the trash. Every method other than ListDeleted,
+// FindDeletedByIDForCompany, Restore and Purge ignores tasks in the trash.
 type Repo interface {
 	Create(ctx context.Context, task *Task) error
 	FindByID(ctx context.Context, id id.TaskID) (*Task, error)
 	FindByIDForCompany(ctx context.Context, taskID id.TaskID, companyID id.CompanyID) (*Task, error)
 	ListByCompany(ctx context.Context, companyID id.CompanyID, opts ListOptions) (*ListResult, error)
+	ListVisibleByCompany(ctx context.Context, companyID id.CompanyID, viewerID id.UserID, opts ListOptions) (*ListResult, error)
+	// ListByAssignee lists tasks that have assigneeID among their assignees.
 	ListByAssignee(ctx context.Context, companyID id.CompanyID, assigneeID id.UserID, opts ListOptions) (*ListResult, error)
-	Update(ctx context.Context, task *Task, expectedVersion int) error
-	Delete(ctx context.Context, taskID id.TaskID, companyID id.CompanyID) error
+	Search(ctx context.Context, companyID id.CompanyID, viewerID id.UserID, opts SearchOptions) (*SearchResult, error)
+	ListSubtree(ctx context.Context, companyID id.CompanyID, viewerID id.UserID, rootID id.TaskID) (*Subtree, error)
+	// ListAncestorIDs returns the parent chain of a task, nearest first.
+	ListAncestorIDs(ctx context.Context, companyID id.CompanyID, taskID id.TaskID) ([]id.TaskID, error)
+	// Update saves a task produced by ApplyUpdate, recording its Changes.
+	// A new parent that is the task itself or one of its descendants fails
+	// with invalid input; the check is atomic with respect to concurrent
+	// Updates.
+	Update(ctx context.Context, task *Task, expectedVersion int, actorID id.UserID) error
+	Delete(ctx context.Context, taskID id.TaskID, companyID id.CompanyID, actorID id.UserID) error
+	// ListDeleted lists the tasks in the trash that viewerID can see.
+	ListDeleted(ctx context.Context, companyID id.CompanyID, viewerID id.UserID, opts ListOptions) (*ListResult, error)
+	FindDeletedByIDForCompany(ctx context.Context, taskID id.TaskID, companyID id.CompanyID) (*Task, error)
+	Restore(ctx context.Context, taskID id.TaskID, companyID id.CompanyID, actorID id.UserID) error
+	// Purge permanently removes a task from the trash.
+	Purge(ctx context.Context, taskID id.TaskID, companyID id.CompanyID, actorID id.UserID) error
+	// PurgeDeletedBefore permanently removes every task moved to the trash
+	// before cutoff and returns how many were removed.
+	PurgeDeletedBefore(ctx context.Context, cutoff time.Time) (int, error)
+	// ArchiveCompleted archives tasks that have been done for longer than
+	// their company's auto-archive period and returns how many it archived.
+	ArchiveCompleted(ctx context.Context, now time.Time) (int, error)
+	// HandOver applies h to every task of the company, trashed and archived
+	// ones included, and to the series h.FromID created or is assigned.
+	// Each changed task gets a history entry attributed to actorID; the
+	// changes are returned.
+	HandOver(ctx context.Context, h Handover, actorID id.UserID, now time.Time) ([]Change, error)
 }
@@ -0,0 +1,34 @@
+package task
+
+import "github.com/pyshx/todoapp/pkg/id"
+
+// Highlighted fragments wrap matched terms in these markers.
+const (
+	HighlightStart = "<mark>"
+	HighlightStop  = "</mark>"
+)
+
+// SearchCursor identifies the last hit of a page. Hits are ordered by score
+// descending, then by ID descending.
+type SearchCursor struct {
+	Score float64
+	ID    id.TaskID
+}
+
+type SearchOptions struct {
+	Query    string
+	PageSize int
+	Cursor   *SearchCursor
+}
+
+type SearchHit struct {
+	Task                 *Task
+	Score                float64
+	TitleHighlight       string
+	DescriptionHighlight *string
+}
+
+type SearchResult struct {
+	Hits       []*SearchHit
+	NextCursor *SearchCursor
+}
@@ -0,0 +1,368 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/recurrence"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+// Series generates the instances of a recurring task. Each instance is an
+// ordinary Task linked by SeriesID and numbered by Occurrence. The series
+// holds the rule and the template that new instances are created from, so
+// editing one instance never changes the ones that follow it.
+type Series struct {
+	id               id.SeriesID
+	companyID        id.CompanyID
+	creatorID        id.UserID
+	rule             recurrence.Rule
+	timeZone         *time.Location
+	startsAt         time.Time
+	lastOccurrenceAt time.Time
+	occurrences      int
+	ended            bool
+	title            string
+	description      *string
+	assigneeID       *id.UserID
+	visibility       Visibility
+	teamID           *id.TeamID
+	priority         Priority
+	version          int
+	createdAt        time.Time
+	updatedAt        time.Time
+}
+
+func (s *Series) ID() id.SeriesID             { return s.id }
+func (s *Series) CompanyID() id.CompanyID     { return s.companyID }
+func (s *Series) CreatorID() id.UserID        { return s.creatorID }
+func (s *Series) Rule() recurrence.Rule       { return s.rule }
+func (s *Series) TimeZone() *time.Location    { return s.timeZone }
+func (s *Series) StartsAt() time.Time         { return s.startsAt }
+func (s *Series) LastOccurrenceAt() time.Time { return s.lastOccurrenceAt }
+func (s *Series) Occurrences() int            { return s.occurrences }
+func (s *Series) Ended() bool                 { return s.ended }
+func (s *Series) Title() string               { return s.title }
+func (s *Series) Description() *string        { return s.description }
+func (s *Series) AssigneeID() *id.UserID      { return s.assigneeID }
+func (s *Series) Visibility() Visibility      { return s.visibility }
+func (s *Series) TeamID() *id.TeamID          { return s.teamID }
+func (s *Series) Priority() Priority          { return s.priority }
+func (s *Series) Version() int                { return s.version }
+func (s *Series) CreatedAt() time.Time        { return s.createdAt }
+func (s *Series) UpdatedAt() time.Time        { return s.updatedAt }
+
+// CanBeViewedBy applies the task visibility rule to the series template.
+func (s *Series) CanBeViewedBy(u *user.User) bool {
+	if !s.companyID.Equal(u.CompanyID()) {
+		return false
+	}
+	if s.visibility == VisibilityCompanyWide {
+		return true
+	}
+	if s.visibility == VisibilityTeam && s.teamID != nil && u.IsTeamMember(*s.teamID) {
+		return true
+	}
+	if s.creatorID.Equal(u.ID()) {
+		return true
+	}
+	return s.assigneeID != nil && s.assigneeID.Equal(u.ID())
+}
+
+// NextOccurrence returns when the occurrence after the latest one is due.
+func (s *Series) NextOccurrence() (time.Time, bool) {
+	if s.ended {
+		return time.Time{}, false
+	}
+	return s.rule.Next(s.startsAt, s.lastOccurrenceAt, s.occurrences, s.timeZone)
+}
+
+// IsLatest reports whether t is the most recent instance of the series.
+func (s *Series) IsLatest(t *Task) bool {
+	return t.seriesID != nil && t.seriesID.Equal(s.id) && t.occurrence == s.occurrences
+}
+
+// Advance records a new occurrence due at dueAt and returns the instance for
+// it, starting in status. Labels and the parent task are carried over from
+// prev when given; everything else comes from the series template. A series
+// has a single assignee, so assignees added to one instance stay on it and
+// the next instance is assigned to the template's assignee only.
+func (s *Series) Advance(prev *Task, taskID id.TaskID, dueAt time.Time, status WorkflowStatus, now time.Time) (*Series, *Task) {
+	next := *s
+	next.lastOccurrenceAt = dueAt
+	next.occurrences++
+	next.version++
+	next.updatedAt = now
+
+	seriesID := s.id
+	dueDate := dueAt
+	b := NewBuilder().
+		ID(taskID).
+		CompanyID(s.companyID).
+		CreatorID(s.creatorID).
+		AssigneeID(s.assigneeID).
+		Title(s.title).
+		Description(s.description).
+		DueDate(&dueDate).
+		Visibility(s.visibility).
+		TeamID(s.teamID).
+		Priority(s.priority).
+		Status(status.Key).
+		Category(status.Category).
+		SeriesID(&seriesID).
+		Occurrence(next.occurrences).
+		Version(1).
+		CreatedAt(now).
+		UpdatedAt(now)
+	if prev != nil {
+		b = b.LabelIDs(prev.labelIDs).ParentID(prev.parentID)
+	}
+
+	return &next, b.MustBuild()
+}
+
+// End marks the series as exhausted so that no further instances are made.
+func (s *Series) End(now time.Time) *Series {
+	next := *s
+	next.ended = true
+	next.version++
+	next.updatedAt = now
+	return &next
+}
+
+type SeriesBuilder struct {
+	s   *Series
+	err error
+}
+
+func NewSeriesBuilder() *SeriesBuilder {
+	return &SeriesBuilder{s: &Series{timeZone: time.UTC, priority: PriorityNone, occurrences: 1, version: 1}}
+}
+
+func (b *SeriesBuilder) ID(id id.SeriesID) *SeriesBuilder {
+	if b.err == nil {
+		b.s.id = id
+	}
+	return b
+}
+
+func (b *SeriesBuilder) CompanyID(companyID id.CompanyID) *SeriesBuilder {
+	if b.err == nil {
+		b.s.companyID = companyID
+	}
+	return b
+}
+
+func (b *SeriesBuilder) CreatorID(creatorID id.UserID) *SeriesBuilder {
+	if b.err == nil {
+		b.s.creatorID = creatorID
+	}
+	return b
+}
+
+func (b *SeriesBuilder) Rule(rule recurrence.Rule) *SeriesBuilder {
+	if b.err == nil {
+		b.s.rule = rule
+	}
+	return b
+}
+
+func (b *SeriesBuilder) TimeZone(loc *time.Location) *SeriesBuilder {
+	if b.err == nil {
+		b.s.timeZone = loc
+	}
+	return b
+}
+
+func (b *SeriesBuilder) StartsAt(t time.Time) *SeriesBuilder {
+	if b.err == nil {
+		b.s.startsAt = t
+	}
+	return b
+}
+
+func (b *SeriesBuilder) LastOccurrenceAt(t time.Time) *SeriesBuilder {
+	if b.err == nil {
+		b.s.lastOccurrenceAt = t
+	}
+	return b
+}
+
+func (b *SeriesBuilder) Occurrences(n int) *SeriesBuilder {
+	if b.err == nil {
+		b.s.occurrences = n
+	}
+	return b
+}
+
+func (b *SeriesBuilder) Ended(ended bool) *SeriesBuilder {
+	if b.err == nil {
+		b.s.ended = ended
+	}
+	return b
+}
+
+func (b *SeriesBuilder) Title(title string) *SeriesBuilder {
+	if b.err == nil {
+		b.s.title = title
+	}
+	return b
+}
+
+func (b *SeriesBuilder) Description(description *string) *SeriesBuilder {
+	if b.err == nil {
+		b.s.description = description
+	}
+	return b
+}
+
+func (b *SeriesBuilder) AssigneeID(assigneeID *id.UserID) *SeriesBuilder {
+	if b.err == nil {
+		b.s.assigneeID = assigneeID
+	}
+	return b
+}
+
+func (b *SeriesBuilder) Visibility(visibility Visibility) *SeriesBuilder {
+	if b.err == nil {
+		b.s.visibility = visibility
+	}
+	return b
+}
+
+func (b *SeriesBuilder) TeamID(teamID *id.TeamID) *SeriesBuilder {
+	if b.err == nil {
+		b.s.teamID = teamID
+	}
+	return b
+}
+
+func (b *SeriesBuilder) Priority(priority Priority) *SeriesBuilder {
+	if b.err == nil {
+		b.s.priority = priority
+	}
+	return b
+}
+
+func (b *SeriesBuilder) Version(version int) *SeriesBuilder {
+	if b.err == nil {
+		b.s.version = version
+	}
+	return b
+}
+
+func (b *SeriesBuilder) CreatedAt(t time.Time) *SeriesBuilder {
+	if b.err == nil {
+		b.s.createdAt = t
+	}
+	return b
+}
+
+func (b *SeriesBuilder) UpdatedAt(t time.Time) *SeriesBuilder {
+	if b.err == nil {
+		b.s.updatedAt = t
+	}
+	return b
+}
+
+func (b *SeriesBuilder) Build() (*Series, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.s, nil
+}
+
+func (b *SeriesBuilder) MustBuild() *Series {
+	s, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// SeriesUpdate edits the template and schedule of a series. Changing the
+// rule or time zone re-anchors the series at its latest occurrence, which
+// then counts as the first occurrence for COUNT. AssigneeID sets the
+// template's single assignee and, through TaskUpdate, replaces every assignee
+// of the open instances.
+type SeriesUpdate struct {
+	Title       *string
+	Description **string
+	AssigneeID  **id.UserID
+	Visibility  *Visibility
+	Priority    *Priority
+	Rule        *recurrence.Rule
+	TimeZone    *time.Location
+	// TeamID works as in Update.
+	TeamID *id.TeamID
+}
+
+func (s *Series) ApplyUpdate(u SeriesUpdate, now time.Time) *Series {
+	next := *s
+	next.version++
+	next.updatedAt = now
+
+	if u.Title != nil {
+		next.title = *u.Title
+	}
+	if u.Description != nil {
+		next.description = *u.Description
+	}
+	if u.AssigneeID != nil {
+		next.assigneeID = *u.AssigneeID
+	}
+	if u.Visibility != nil {
+		next.visibility = *u.Visibility
+		if next.visibility != VisibilityTeam {
+			next.teamID = nil
+		}
+	}
+	if u.TeamID != nil {
+		next.teamID = u.TeamID
+	}
+	if u.Priority != nil {
+		next.priority = *u.Priority
+	}
+	if u.Rule != nil || u.TimeZone != nil {
+		if u.Rule != nil {
+			next.rule = *u.Rule
+		}
+		if u.TimeZone != nil {
+			next.timeZone = u.TimeZone
+		}
+		next.startsAt = s.lastOccurrenceAt
+		next.occurrences = 1
+		next.ended = false
+	}
+
+	return &next
+}
+
+// TaskUpdate returns the template changes as an update for open instances.
+func (u SeriesUpdate) TaskUpdate() Update {
+	return Update{
+		Title:       u.Title,
+		Description: u.Description,
+		AssigneeID:  u.AssigneeID,
+		Visibility:  u.Visibility,
+		Priority:    u.Priority,
+		TeamID:      u.TeamID,
+	}
+}
+
+type SeriesRepo interface {
+	// Create stores a new series together with its first instance.
+	Create(ctx context.Context, s *Series, first *Task) error
+	FindByIDForCompany(ctx context.Context, seriesID id.SeriesID, companyID id.CompanyID) (*Series, error)
+	// Update stores s and applies each instance change, checked against the
+	// version of its Before task, in one transaction.
+	Update(ctx context.Context, s *Series, expectedVersion int, instances []Change, actorID id.UserID) error
+	// Advance stores s and creates next, when given, in one transaction.
+	Advance(ctx context.Context, s *Series, expectedVersion int, next *Task) error
+	// ListDue returns series whose latest occurrence is due at or before now.
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*Series, error)
+	FindInstance(ctx context.Context, companyID id.CompanyID, seriesID id.SeriesID, occurrence int) (*Task, error)
+	// ListOpenInstances returns the instances of a series that are not done.
+	ListOpenInstances(ctx context.Context, companyID id.CompanyID, seriesID id.SeriesID) ([]*Task, error)
+}
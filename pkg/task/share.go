@@ -0,0 +1,77 @@
+package task
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+// ShareRole is what a share lets its user do with the task.
+type ShareRole string
+
+const (
+	// ShareRoleViewer lets the user see the task.
+	ShareRoleViewer ShareRole = "viewer"
+	// ShareRoleEditor also lets the user change and delete the task, as if
+	// they had the editor role, but not change who can see it: its
+	// visibility, team and assignees.
+	ShareRoleEditor ShareRole = "editor"
+)
+
+func (r ShareRole) IsValid() bool {
+	return r == ShareRoleViewer || r == ShareRoleEditor
+}
+
+func (r ShareRole) String() string { return string(r) }
+
+func ParseShareRole(s string) (ShareRole, bool) {
+	r := ShareRole(s)
+	if !r.IsValid() {
+		return "", false
+	}
+	return r, true
+}
+
+// Share grants one user of the task's company access to a task they could
+// not otherwise see, whatever its visibility.
+type Share struct {
+	UserID id.UserID
+	Role   ShareRole
+}
+
+// ShareRepo stores the users a task is shared with. Shares go away with the
+// task or the user.
+type ShareRepo interface {
+	// Save shares the task with s.UserID, replacing the role of an existing
+	// share.
+	Save(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, s Share) error
+	// Delete fails with not found when the task is not shared with the user.
+	Delete(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, userID id.UserID) error
+}
+
+// Shares lists the users the task is shared with, oldest share first.
+func (t *Task) Shares() []Share { return t.shares }
+
+// SharedRole returns the role the task is shared with userID, if it is.
+func (t *Task) SharedRole(userID id.UserID) (ShareRole, bool) {
+	for _, s := range t.shares {
+		if s.UserID.Equal(userID) {
+			return s.Role, true
+		}
+	}
+	return "", false
+}
+
+// IsSharedEditor reports whether the task is shared with userID as an
+// editor.
+func (t *Task) IsSharedEditor(userID id.UserID) bool {
+	role, ok := t.SharedRole(userID)
+	return ok && role == ShareRoleEditor
+}
+
+func (b *Builder) Shares(shares []Share) *Builder {
+	if b.err == nil {
+		b.t.shares = shares
+	}
+	return b
+}
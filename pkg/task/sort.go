@@ -0,0 +1,83 @@
+package task
+
+type SortField string
+
+const (
+	SortByCreatedAt SortField = "created_at"
+	SortByDueDate   SortField = "due_date"
+	SortByUpdatedAt SortField = "updated_at"
+	SortByTitle     SortField = "title"
+	SortByStatus    SortField = "status"
+	SortByPriority  SortField = "priority"
+)
+
+func (f SortField) IsValid() bool {
+	switch f {
+	case SortByCreatedAt, SortByDueDate, SortByUpdatedAt, SortByTitle, SortByStatus, SortByPriority:
+		return true
+	}
+	return false
+}
+
+func (f SortField) String() string { return string(f) }
+
+// DefaultDirection is newest-first for timestamps of past events, most
+// urgent first for priority, and ascending for everything else, so "due
+// soon" and A-Z are the defaults.
+func (f SortField) DefaultDirection() SortDirection {
+	if f == SortByCreatedAt || f == SortByUpdatedAt || f == SortByPriority {
+		return SortDesc
+	}
+	return SortAsc
+}
+
+func ParseSortField(s string) (SortField, bool) {
+	f := SortField(s)
+	if !f.IsValid() {
+		return "", false
+	}
+	return f, true
+}
+
+type SortDirection string
+
+const (
+	SortAsc  SortDirection = "asc"
+	SortDesc SortDirection = "desc"
+)
+
+func (d SortDirection) IsValid() bool  { return d == SortAsc || d == SortDesc }
+func (d SortDirection) String() string { return string(d) }
+
+func ParseSortDirection(s string) (SortDirection, bool) {
+	d := SortDirection(s)
+	if !d.IsValid() {
+		return "", false
+	}
+	return d, true
+}
+
+// Sort orders a task listing. Ties are always broken by task ID in the same
+// direction, and tasks without a due date sort last in either direction.
+type Sort struct {
+	Field     SortField
+	Direction SortDirection
+}
+
+var DefaultSort = Sort{Field: SortByCreatedAt, Direction: SortDesc}
+
+func (s Sort) IsZero() bool { return s.Field == "" && s.Direction == "" }
+
+// OrDefault returns DefaultSort for the zero value and fills in the field's
+// default direction when only the field is set.
+func (s Sort) OrDefault() Sort {
+	if s.IsZero() {
+		return DefaultSort
+	}
+	if s.Direction == "" {
+		s.Direction = s.Field.DefaultDirection()
+	}
+	return s
+}
+
+func (s Sort) IsValid() bool { return s.Field.IsValid() && s.Direction.IsValid() }
@@ -1,5 +1,7 @@
 package task
 
+// Status is the key of a status in the company's workflow. The three
+// constants are the statuses of the default workflow.
 type Status string
 
 const (
@@ -8,7 +10,28 @@ const (
 	StatusDone       Status = "done"
 )
 
-func (s Status) IsValid() bool  { return s == StatusTodo || s == StatusInProgress || s == StatusDone }
+// MaxStatusKeyLength caps status keys so they stay usable in URLs and
+// filters.
+const MaxStatusKeyLength = 32
+
+// IsValid reports whether s is well formed: lowercase letters, digits and
+// underscores, starting with a letter. Whether a company's workflow knows
+// the status is checked against the workflow.
+func (s Status) IsValid() bool {
+	if len(s) == 0 || len(s) > MaxStatusKeyLength {
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z':
+		case i > 0 && (r >= '0' && r <= '9' || r == '_'):
+		default:
+			return false
+		}
+	}
+	return true
+}
+
 func (s Status) String() string { return string(s) }
 
 func ParseStatus(str string) (Status, bool) {
@@ -18,3 +41,28 @@ func ParseStatus(str string) (Status, bool) {
 	}
 	return s, true
 }
+
+// Category groups workflow statuses by how far along a task is. Rules that
+// care whether a task is finished, such as blockers, recurrence and
+// auto-archiving, look at the category rather than the status.
+type Category string
+
+const (
+	CategoryTodo   Category = "todo"
+	CategoryActive Category = "active"
+	CategoryDone   Category = "done"
+)
+
+func (c Category) IsValid() bool {
+	return c == CategoryTodo || c == CategoryActive || c == CategoryDone
+}
+
+func (c Category) String() string { return string(c) }
+
+func ParseCategory(str string) (Category, bool) {
+	c := Category(str)
+	if !c.IsValid() {
+		return "", false
+	}
+	return c, true
+}
@@ -0,0 +1,18 @@
+package task
+
+// MaxSubtreeSize caps how many descendants a single subtree lookup returns.
+const MaxSubtreeSize = 500
+
+// Progress counts a task's direct subtasks and how many of them are done.
+// It covers every subtask, including ones the viewer cannot see.
+type Progress struct {
+	Done  int
+	Total int
+}
+
+// Subtree is a task's visible descendants ordered by depth, then creation
+// time. Descendants of a hidden subtask are left out along with it.
+type Subtree struct {
+	Tasks     []*Task
+	Truncated bool // More than MaxSubtreeSize descendants were found
+}
@@ -11,30 +11,92 @@ type Task struct {
 	id          id.TaskID
 	companyID   id.CompanyID
 	creatorID   id.UserID
-	assigneeID  *id.UserID
+	assigneeIDs []id.UserID
+	parentID    *id.TaskID
 	title       string
 	description *string
 	dueDate     *time.Time
 	visibility  Visibility
+	teamID      *id.TeamID
 	status      Status
+	category    Category
+	priority    Priority
+	labelIDs    []id.LabelID
+	shares      []Share
+	progress    Progress
+	seriesID    *id.SeriesID
+	occurrence  int
 	version     int
 	createdAt   time.Time
 	updatedAt   time.Time
+	completedAt *time.Time
+	archivedAt  *time.Time
+	deletedAt   *time.Time
+	changes     []FieldChange
 }
 
 func (t *Task) ID() id.TaskID             { return t.id }
 func (t *Task) CompanyID() id.CompanyID   { return t.companyID }
 func (t *Task) CreatorID() id.UserID      { return t.creatorID }
-func (t *Task) AssigneeID() *id.UserID    { return t.assigneeID }
+func (t *Task) ParentID() *id.TaskID      { return t.parentID }
 func (t *Task) Title() string             { return t.title }
 func (t *Task) Description() *string      { return t.description }
 func (t *Task) DueDate() *time.Time       { return t.dueDate }
 func (t *Task) Visibility() Visibility    { return t.visibility }
+func (t *Task) TeamID() *id.TeamID        { return t.teamID }
 func (t *Task) Status() Status            { return t.status }
+func (t *Task) Priority() Priority        { return t.priority }
+func (t *Task) LabelIDs() []id.LabelID    { return t.labelIDs }
+func (t *Task) SubtaskProgress() Progress { return t.progress }
+func (t *Task) SeriesID() *id.SeriesID    { return t.seriesID }
+func (t *Task) Occurrence() int           { return t.occurrence }
 func (t *Task) Version() int              { return t.version }
 func (t *Task) CreatedAt() time.Time      { return t.createdAt }
 func (t *Task) UpdatedAt() time.Time      { return t.updatedAt }
 
+// Category is the workflow category of the task's status.
+func (t *Task) Category() Category { return t.category }
+
+// IsDone reports whether the task's status is in the done category.
+func (t *Task) IsDone() bool { return t.category == CategoryDone }
+
+// CompletedAt is when the task last moved to a done status, or nil while it
+// is not done.
+func (t *Task) CompletedAt() *time.Time { return t.completedAt }
+
+// ArchivedAt is when the task was archived. Archiving is independent of the
+// status and only hides the task from listings that do not ask for it.
+func (t *Task) ArchivedAt() *time.Time { return t.archivedAt }
+func (t *Task) IsArchived() bool       { return t.archivedAt != nil }
+
+// DeletedAt is when the task was moved to the trash, or nil while it is live.
+func (t *Task) DeletedAt() *time.Time { return t.deletedAt }
+func (t *Task) IsDeleted() bool       { return t.deletedAt != nil }
+
+// Changes lists the fields changed by the ApplyUpdate call that produced t,
+// with their old and new values. It is nil for tasks that were built or
+// loaded rather than updated.
+func (t *Task) Changes() []FieldChange { return t.changes }
+
+// AssigneeIDs lists everyone the task is assigned to, primary assignee
+// first.
+func (t *Task) AssigneeIDs() []id.UserID { return t.assigneeIDs }
+
+// AssigneeID is the primary assignee: the first one, or nil when the task
+// is unassigned. It predates multiple assignees and is kept for callers that
+// only deal with one.
+func (t *Task) AssigneeID() *id.UserID {
+	if len(t.assigneeIDs) == 0 {
+		return nil
+	}
+	return &t.assigneeIDs[0]
+}
+
+// IsAssignee reports whether the task is assigned to userID.
+func (t *Task) IsAssignee(userID id.UserID) bool {
+	return containsUser(t.assigneeIDs, userID)
+}
+
 func (t *Task) CanBeViewedBy(u *user.User) bool {
 	if !t.companyID.Equal(u.CompanyID()) {
 		return false
@@ -42,13 +104,37 @@ func (t *Task) CanBeViewedBy(u *user.User) bool {
 	if t.visibility == VisibilityCompanyWide {
 		return true
 	}
+	if t.visibility == VisibilityTeam && t.teamID != nil && u.IsTeamMember(*t.teamID) {
+		return true
+	}
 	if t.creatorID.Equal(u.ID()) {
 		return true
 	}
-	if t.assigneeID != nil && t.assigneeID.Equal(u.ID()) {
+	if _, ok := t.SharedRole(u.ID()); ok {
 		return true
 	}
-	return false
+	return t.IsAssignee(u.ID())
+}
+
+// CanUpdateField reports whether u may change field f. Editors may change
+// every field, and users the task is shared with as editors every field but
+// who can see it (the visibility, team and assignees); viewers may only move
+// tasks assigned to them between statuses.
+func (t *Task) CanUpdateField(u *user.User, f Field) bool {
+	if !t.companyID.Equal(u.CompanyID()) {
+		return false
+	}
+	if u.Can(user.PermissionEditTasks) {
+		return true
+	}
+	if t.IsSharedEditor(u.ID()) {
+		return f != FieldVisibility && f != FieldTeam && f != FieldAssignees
+	}
+	return f == FieldStatus && t.IsAssignee(u.ID())
+}
+
+func (t *Task) HasLabel(labelID id.LabelID) bool {
+	return containsLabel(t.labelIDs, labelID)
 }
 
 func (t *Task) BelongsToCompany(companyID id.CompanyID) bool {
@@ -61,7 +147,7 @@ type Builder struct {
 }
 
 func NewBuilder() *Builder {
-	return &Builder{t: &Task{status: StatusTodo, version: 1}}
+	return &Builder{t: &Task{status: StatusTodo, priority: PriorityNone, version: 1}}
 }
 
 func (b *Builder) ID(id id.TaskID) *Builder {
@@ -85,9 +171,27 @@ func (b *Builder) CreatorID(creatorID id.UserID) *Builder {
 	return b
 }
 
+// AssigneeID assigns the task to a single user, or to nobody when nil.
 func (b *Builder) AssigneeID(assigneeID *id.UserID) *Builder {
 	if b.err == nil {
-		b.t.assigneeID = assigneeID
+		b.t.assigneeIDs = nil
+		if assigneeID != nil {
+			b.t.assigneeIDs = []id.UserID{*assigneeID}
+		}
+	}
+	return b
+}
+
+func (b *Builder) AssigneeIDs(assigneeIDs []id.UserID) *Builder {
+	if b.err == nil {
+		b.t.assigneeIDs = assigneeIDs
+	}
+	return b
+}
+
+func (b *Builder) ParentID(parentID *id.TaskID) *Builder {
+	if b.err == nil {
+		b.t.parentID = parentID
 	}
 	return b
 }
@@ -120,6 +224,13 @@ func (b *Builder) Visibility(visibility Visibility) *Builder {
 	return b
 }
 
+func (b *Builder) TeamID(teamID *id.TeamID) *Builder {
+	if b.err == nil {
+		b.t.teamID = teamID
+	}
+	return b
+}
+
 func (b *Builder) Status(status Status) *Builder {
 	if b.err == nil {
 		b.t.status = status
@@ -127,6 +238,50 @@ func (b *Builder) Status(status Status) *Builder {
 	return b
 }
 
+// Category sets the workflow category of the status. When it is not set,
+// the category the status has in the default workflow is used.
+func (b *Builder) Category(category Category) *Builder {
+	if b.err == nil {
+		b.t.category = category
+	}
+	return b
+}
+
+func (b *Builder) Priority(priority Priority) *Builder {
+	if b.err == nil {
+		b.t.priority = priority
+	}
+	return b
+}
+
+func (b *Builder) LabelIDs(labelIDs []id.LabelID) *Builder {
+	if b.err == nil {
+		b.t.labelIDs = labelIDs
+	}
+	return b
+}
+
+func (b *Builder) SubtaskProgress(progress Progress) *Builder {
+	if b.err == nil {
+		b.t.progress = progress
+	}
+	return b
+}
+
+func (b *Builder) SeriesID(seriesID *id.SeriesID) *Builder {
+	if b.err == nil {
+		b.t.seriesID = seriesID
+	}
+	return b
+}
+
+func (b *Builder) Occurrence(occurrence int) *Builder {
+	if b.err == nil {
+		b.t.occurrence = occurrence
+	}
+	return b
+}
+
 func (b *Builder) Version(version int) *Builder {
 	if b.err == nil {
 		b.t.version = version
@@ -148,10 +303,34 @@ func (b *Builder) UpdatedAt(t time.Time) *Builder {
 	return b
 }
 
+func (b *Builder) CompletedAt(t *time.Time) *Builder {
+	if b.err == nil {
+		b.t.completedAt = t
+	}
+	return b
+}
+
+func (b *Builder) ArchivedAt(t *time.Time) *Builder {
+	if b.err == nil {
+		b.t.archivedAt = t
+	}
+	return b
+}
+
+func (b *Builder) DeletedAt(t *time.Time) *Builder {
+	if b.err == nil {
+		b.t.deletedAt = t
+	}
+	return b
+}
+
 func (b *Builder) Build() (*Task, error) {
 	if b.err != nil {
 		return nil, b.err
 	}
+	if b.t.category == "" {
+		b.t.category = defaultCategory(b.t.status)
+	}
 	return b.t, nil
 }
 
@@ -166,10 +345,24 @@ func (b *Builder) MustBuild() *Task {
 type Update struct {
 	Title       *string
 	Description **string
-	AssigneeID  **id.UserID
-	DueDate     **time.Time
-	Visibility  *Visibility
-	Status      *Status
+	// AssigneeID replaces every assignee with the given user, or unassigns
+	// the task when it points to nil. It is applied before the additions and
+	// removals.
+	AssigneeID        **id.UserID
+	AddAssigneeIDs    []id.UserID
+	RemoveAssigneeIDs []id.UserID
+	ParentID          **id.TaskID
+	DueDate           **time.Time
+	Visibility        *Visibility
+	Status            *WorkflowStatus
+	Priority          *Priority
+	AddLabelIDs       []id.LabelID
+	RemoveLabelIDs    []id.LabelID
+	Archived          *bool
+	CreatorID         *id.UserID
+	// TeamID picks the team of a team-visible task. Changing the visibility
+	// to anything else clears the team.
+	TeamID *id.TeamID
 }
 
 func (t *Task) ApplyUpdate(u Update, now time.Time) *Task {
@@ -183,18 +376,97 @@ func (t *Task) ApplyUpdate(u Update, now time.Time) *Task {
 	if u.Description != nil {
 		newTask.description = *u.Description
 	}
-	if u.AssigneeID != nil {
-		newTask.assigneeID = *u.AssigneeID
+	if u.AssigneeID != nil || len(u.AddAssigneeIDs) > 0 || len(u.RemoveAssigneeIDs) > 0 {
+		assigneeIDs := t.assigneeIDs
+		if u.AssigneeID != nil {
+			assigneeIDs = nil
+			if *u.AssigneeID != nil {
+				assigneeIDs = []id.UserID{**u.AssigneeID}
+			}
+		}
+		newTask.assigneeIDs = nil
+		for _, a := range assigneeIDs {
+			if !containsUser(u.RemoveAssigneeIDs, a) {
+				newTask.assigneeIDs = append(newTask.assigneeIDs, a)
+			}
+		}
+		for _, a := range u.AddAssigneeIDs {
+			if !containsUser(newTask.assigneeIDs, a) && !containsUser(u.RemoveAssigneeIDs, a) {
+				newTask.assigneeIDs = append(newTask.assigneeIDs, a)
+			}
+		}
+	}
+	if u.ParentID != nil {
+		newTask.parentID = *u.ParentID
 	}
 	if u.DueDate != nil {
 		newTask.dueDate = *u.DueDate
 	}
 	if u.Visibility != nil {
 		newTask.visibility = *u.Visibility
+		if newTask.visibility != VisibilityTeam {
+			newTask.teamID = nil
+		}
+	}
+	if u.TeamID != nil {
+		newTask.teamID = u.TeamID
 	}
-	if u.Status != nil {
-		newTask.status = *u.Status
+	if u.Status != nil && u.Status.Key != t.status {
+		newTask.status = u.Status.Key
+		newTask.category = u.Status.Category
+		if !newTask.IsDone() {
+			newTask.completedAt = nil
+		} else if !t.IsDone() {
+			newTask.completedAt = &now
+		}
+	}
+	if u.Priority != nil {
+		newTask.priority = *u.Priority
+	}
+	if len(u.AddLabelIDs) > 0 || len(u.RemoveLabelIDs) > 0 {
+		newTask.labelIDs = nil
+		for _, l := range t.labelIDs {
+			if !containsLabel(u.RemoveLabelIDs, l) {
+				newTask.labelIDs = append(newTask.labelIDs, l)
+			}
+		}
+		for _, l := range u.AddLabelIDs {
+			if !containsLabel(newTask.labelIDs, l) && !containsLabel(u.RemoveLabelIDs, l) {
+				newTask.labelIDs = append(newTask.labelIDs, l)
+			}
+		}
 	}
 
+	if u.Archived != nil && *u.Archived != t.IsArchived() {
+		newTask.archivedAt = nil
+		if *u.Archived {
+			newTask.archivedAt = &now
+		}
+	}
+
+	if u.CreatorID != nil {
+		newTask.creatorID = *u.CreatorID
+	}
+
+	newTask.changes = FieldChanges(t, &newTask)
+
 	return &newTask
 }
+
+func containsUser(userIDs []id.UserID, userID id.UserID) bool {
+	for _, u := range userIDs {
+		if u.Equal(userID) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsLabel(labelIDs []id.LabelID, labelID id.LabelID) bool {
+	for _, l := range labelIDs {
+		if l.Equal(labelID) {
+			return true
+		}
+	}
+	return false
+}
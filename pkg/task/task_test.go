@@ -1,10 +1,12 @@
 package task_test
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/recurrence"
 	"github.com/pyshx/todoapp/pkg/task"
 	"github.com/pyshx/todoapp/pkg/user"
 )
@@ -15,6 +17,7 @@ func TestTask_CanBeViewedBy(t *testing.T) {
 	creatorID := id.NewUserID()
 	assigneeID := id.NewUserID()
 	otherUserID := id.NewUserID()
+	teamID := id.NewTeamID()
 
 	now := time.Now()
 
@@ -40,6 +43,14 @@ func TestTask_CanBeViewedBy(t *testing.T) {
 		Role(user.RoleViewer).
 		MustBuild()
 
+	teamMember := user.NewBuilder().
+		ID(id.NewUserID()).
+		CompanyID(companyID).
+		Email("member@test.com").
+		Role(user.RoleViewer).
+		TeamIDs([]id.TeamID{teamID}).
+		MustBuild()
+
 	otherCompanyUser := user.NewBuilder().
 		ID(id.NewUserID()).
 		CompanyID(otherCompanyID).
@@ -96,6 +107,21 @@ func TestTask_CanBeViewedBy(t *testing.T) {
 			user:       assignee,
 			wantAccess: true,
 		},
+		{
+			name: "only-me task visible to every assignee",
+			task: task.NewBuilder().
+				ID(id.NewTaskID()).
+				CompanyID(companyID).
+				CreatorID(creatorID).
+				AssigneeIDs([]id.UserID{otherUserID, assigneeID}).
+				Title("Test").
+				Visibility(task.VisibilityOnlyMe).
+				CreatedAt(now).
+				UpdatedAt(now).
+				MustBuild(),
+			user:       assignee,
+			wantAccess: true,
+		},
 		{
 			name: "only-me task not visible to other users",
 			task: task.NewBuilder().
@@ -110,6 +136,66 @@ func TestTask_CanBeViewedBy(t *testing.T) {
 			user:       otherUser,
 			wantAccess: false,
 		},
+		{
+			name: "team task visible to team members",
+			task: task.NewBuilder().
+				ID(id.NewTaskID()).
+				CompanyID(companyID).
+				CreatorID(creatorID).
+				Title("Test").
+				Visibility(task.VisibilityTeam).
+				TeamID(&teamID).
+				CreatedAt(now).
+				UpdatedAt(now).
+				MustBuild(),
+			user:       teamMember,
+			wantAccess: true,
+		},
+		{
+			name: "team task visible to creator outside the team",
+			task: task.NewBuilder().
+				ID(id.NewTaskID()).
+				CompanyID(companyID).
+				CreatorID(creatorID).
+				Title("Test").
+				Visibility(task.VisibilityTeam).
+				TeamID(&teamID).
+				CreatedAt(now).
+				UpdatedAt(now).
+				MustBuild(),
+			user:       creator,
+			wantAccess: true,
+		},
+		{
+			name: "team task not visible to other users",
+			task: task.NewBuilder().
+				ID(id.NewTaskID()).
+				CompanyID(companyID).
+				CreatorID(creatorID).
+				Title("Test").
+				Visibility(task.VisibilityTeam).
+				TeamID(&teamID).
+				CreatedAt(now).
+				UpdatedAt(now).
+				MustBuild(),
+			user:       otherUser,
+			wantAccess: false,
+		},
+		{
+			name: "private task visible to users it is shared with",
+			task: task.NewBuilder().
+				ID(id.NewTaskID()).
+				CompanyID(companyID).
+				CreatorID(creatorID).
+				Title("Test").
+				Visibility(task.VisibilityOnlyMe).
+				Shares([]task.Share{{UserID: otherUserID, Role: task.ShareRoleViewer}}).
+				CreatedAt(now).
+				UpdatedAt(now).
+				MustBuild(),
+			user:       otherUser,
+			wantAccess: true,
+		},
 		{
 			name: "task not visible to users from other companies",
 			task: task.NewBuilder().
@@ -155,7 +241,7 @@ func TestTask_ApplyUpdate(t *testing.T) {
 		MustBuild()
 
 	newTitle := "Updated Title"
-	newStatus := task.StatusInProgress
+	newStatus := task.WorkflowStatus{Key: task.StatusInProgress, Category: task.CategoryActive}
 	newNow := now.Add(time.Hour)
 
 	update := task.Update{
@@ -176,8 +262,8 @@ func TestTask_ApplyUpdate(t *testing.T) {
 	}
 
 	// Check status updated
-	if updated.Status() != newStatus {
-		t.Errorf("Status = %s, want %s", updated.Status(), newStatus)
+	if updated.Status() != newStatus.Key {
+		t.Errorf("Status = %s, want %s", updated.Status(), newStatus.Key)
 	}
 
 	// Check unchanged fields
@@ -206,6 +292,7 @@ func TestVisibility_IsValid(t *testing.T) {
 	}{
 		{task.VisibilityOnlyMe, true},
 		{task.VisibilityCompanyWide, true},
+		{task.VisibilityTeam, true},
 		{task.Visibility("invalid"), false},
 		{task.Visibility(""), false},
 	}
@@ -227,7 +314,11 @@ func TestStatus_IsValid(t *testing.T) {
 		{task.StatusTodo, true},
 		{task.StatusInProgress, true},
 		{task.StatusDone, true},
-		{task.Status("invalid"), false},
+		{task.Status("in_review"), true},
+		{task.Status("In Review"), false},
+		{task.Status("1st"), false},
+		{task.Status("_draft"), false},
+		{task.Status(strings.Repeat("a", task.MaxStatusKeyLength+1)), false},
 		{task.Status(""), false},
 	}
 
@@ -239,3 +330,514 @@ func TestStatus_IsValid(t *testing.T) {
 		})
 	}
 }
+
+func TestPriority_IsValid(t *testing.T) {
+	tests := []struct {
+		p    task.Priority
+		want bool
+	}{
+		{task.PriorityNone, true},
+		{task.PriorityLow, true},
+		{task.PriorityMedium, true},
+		{task.PriorityHigh, true},
+		{task.PriorityUrgent, true},
+		{task.Priority("p0"), false},
+		{task.Priority(""), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.p), func(t *testing.T) {
+			if got := tt.p.IsValid(); got != tt.want {
+				t.Errorf("IsValid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTask_ApplyUpdatePriority(t *testing.T) {
+	now := time.Now()
+	original := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(id.NewCompanyID()).
+		CreatorID(id.NewUserID()).
+		Title("Triage").
+		Visibility(task.VisibilityCompanyWide).
+		CreatedAt(now).
+		UpdatedAt(now).
+		MustBuild()
+
+	if original.Priority() != task.PriorityNone {
+		t.Fatalf("default Priority = %s, want %s", original.Priority(), task.PriorityNone)
+	}
+
+	urgent := task.PriorityUrgent
+	updated := original.ApplyUpdate(task.Update{Priority: &urgent}, now.Add(time.Minute))
+
+	if updated.Priority() != task.PriorityUrgent {
+		t.Errorf("Priority = %s, want %s", updated.Priority(), task.PriorityUrgent)
+	}
+	if original.Priority() != task.PriorityNone {
+		t.Errorf("Original priority was modified")
+	}
+
+	unchanged := updated.ApplyUpdate(task.Update{}, now.Add(2*time.Minute))
+	if unchanged.Priority() != task.PriorityUrgent {
+		t.Errorf("Priority = %s, want %s after empty update", unchanged.Priority(), task.PriorityUrgent)
+	}
+}
+
+func TestSort_OrDefault(t *testing.T) {
+	tests := []struct {
+		name string
+		sort task.Sort
+		want task.Sort
+	}{
+		{"zero value", task.Sort{}, task.DefaultSort},
+		{"due date defaults ascending", task.Sort{Field: task.SortByDueDate}, task.Sort{Field: task.SortByDueDate, Direction: task.SortAsc}},
+		{"updated_at defaults descending", task.Sort{Field: task.SortByUpdatedAt}, task.Sort{Field: task.SortByUpdatedAt, Direction: task.SortDesc}},
+		{"explicit direction kept", task.Sort{Field: task.SortByTitle, Direction: task.SortDesc}, task.Sort{Field: task.SortByTitle, Direction: task.SortDesc}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.sort.OrDefault(); got != tt.want {
+				t.Errorf("OrDefault() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTask_ApplyUpdateLabels(t *testing.T) {
+	now := time.Now()
+	keep := id.NewLabelID()
+	drop := id.NewLabelID()
+	add := id.NewLabelID()
+
+	original := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(id.NewCompanyID()).
+		CreatorID(id.NewUserID()).
+		Title("Labelled").
+		Visibility(task.VisibilityCompanyWide).
+		LabelIDs([]id.LabelID{keep, drop}).
+		CreatedAt(now).
+		UpdatedAt(now).
+		MustBuild()
+
+	updated := original.ApplyUpdate(task.Update{
+		AddLabelIDs:    []id.LabelID{add, keep},
+		RemoveLabelIDs: []id.LabelID{drop},
+	}, now)
+
+	if len(updated.LabelIDs()) != 2 {
+		t.Fatalf("LabelIDs() = %v, want 2 labels", updated.LabelIDs())
+	}
+	if !updated.HasLabel(keep) || !updated.HasLabel(add) {
+		t.Errorf("expected kept and added labels, got %v", updated.LabelIDs())
+	}
+	if updated.HasLabel(drop) {
+		t.Error("removed label still attached")
+	}
+	if !original.HasLabel(drop) {
+		t.Error("original labels were modified")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	now := time.Now()
+	assigneeID := id.NewUserID()
+	labelA, labelB := id.NewLabelID(), id.NewLabelID()
+
+	base := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(id.NewCompanyID()).
+		CreatorID(id.NewUserID()).
+		Title("Task").
+		DueDate(&now).
+		Visibility(task.VisibilityCompanyWide).
+		Status(task.StatusTodo).
+		LabelIDs([]id.LabelID{labelA, labelB}).
+		MustBuild()
+
+	title := "Task"
+	later := now.Add(time.Hour)
+	laterRef := &later
+	sameDue := now.In(time.UTC)
+	sameDueRef := &sameDue
+	done := task.WorkflowStatus{Key: task.StatusDone, Category: task.CategoryDone}
+	ref := &assigneeID
+
+	tests := []struct {
+		name   string
+		update task.Update
+		want   []task.Field
+	}{
+		{"no change", task.Update{}, nil},
+		{"same values", task.Update{Title: &title, DueDate: &sameDueRef, AddLabelIDs: []id.LabelID{labelB}}, nil},
+		{"label removed", task.Update{RemoveLabelIDs: []id.LabelID{labelA}}, []task.Field{task.FieldLabels}},
+		{"several fields", task.Update{Status: &done, DueDate: &laterRef, AssigneeID: &ref}, []task.Field{task.FieldAssignees, task.FieldDueDate, task.FieldStatus}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := task.Diff(base, base.ApplyUpdate(tt.update, now))
+			if len(got) != len(tt.want) {
+				t.Fatalf("Diff() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Diff() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestTask_ApplyUpdateChanges(t *testing.T) {
+	now := time.Now()
+	base := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(id.NewCompanyID()).
+		CreatorID(id.NewUserID()).
+		Title("Old").
+		Visibility(task.VisibilityCompanyWide).
+		Status(task.StatusTodo).
+		MustBuild()
+
+	title := "New"
+	var noDescription *string
+	updated := base.ApplyUpdate(task.Update{Title: &title, Description: &noDescription}, now)
+
+	changes := updated.Changes()
+	if len(changes) != 1 {
+		t.Fatalf("Changes() = %v, want only the title", changes)
+	}
+	c := changes[0]
+	if c.Field != task.FieldTitle || c.Before == nil || *c.Before != "Old" || c.After == nil || *c.After != "New" {
+		t.Errorf("Changes()[0] = %+v, want title Old -> New", c)
+	}
+
+	for _, c := range task.FieldChanges(nil, base) {
+		if c.Before != nil || c.After == nil {
+			t.Errorf("FieldChanges(nil, t) %s = %+v, want only an after value", c.Field, c)
+		}
+		if c.Field == task.FieldDescription || c.Field == task.FieldAssignees {
+			t.Errorf("FieldChanges(nil, t) reported unset field %s", c.Field)
+		}
+	}
+}
+
+func TestTask_ApplyUpdateAssignees(t *testing.T) {
+	alice, bob, carol := id.NewUserID(), id.NewUserID(), id.NewUserID()
+	base := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(id.NewCompanyID()).
+		CreatorID(id.NewUserID()).
+		AssigneeID(&alice).
+		Title("Task").
+		Visibility(task.VisibilityOnlyMe).
+		MustBuild()
+
+	bobRef := &bob
+	tests := []struct {
+		name   string
+		update task.Update
+		want   []id.UserID
+	}{
+		{"add", task.Update{AddAssigneeIDs: []id.UserID{bob, alice}}, []id.UserID{alice, bob}},
+		{"remove", task.Update{RemoveAssigneeIDs: []id.UserID{alice}}, nil},
+		{"replace", task.Update{AssigneeID: &bobRef}, []id.UserID{bob}},
+		{"replace then add", task.Update{AssigneeID: &bobRef, AddAssigneeIDs: []id.UserID{carol}}, []id.UserID{bob, carol}},
+		{"add and remove the same user", task.Update{AddAssigneeIDs: []id.UserID{carol}, RemoveAssigneeIDs: []id.UserID{carol}}, []id.UserID{alice}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := base.ApplyUpdate(tt.update, time.Now())
+			got := updated.AssigneeIDs()
+			if len(got) != len(tt.want) {
+				t.Fatalf("AssigneeIDs() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("AssigneeIDs() = %v, want %v", got, tt.want)
+				}
+			}
+			if len(tt.want) > 0 && !updated.AssigneeID().Equal(tt.want[0]) {
+				t.Errorf("AssigneeID() = %v, want %s", updated.AssigneeID(), tt.want[0])
+			}
+		})
+	}
+
+	added := base.ApplyUpdate(task.Update{AddAssigneeIDs: []id.UserID{bob}}, time.Now())
+	change := task.NewChange(base, added)
+	if !change.Has(task.FieldAssignees) {
+		t.Error("expected the assignee change to be recorded")
+	}
+	if newIDs := change.NewAssigneeIDs(); len(newIDs) != 1 || !newIDs[0].Equal(bob) {
+		t.Errorf("NewAssigneeIDs() = %v, want [%s]", newIDs, bob)
+	}
+}
+
+func TestTask_ApplyUpdateArchived(t *testing.T) {
+	now := time.Now()
+	base := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(id.NewCompanyID()).
+		CreatorID(id.NewUserID()).
+		Title("Task").
+		Visibility(task.VisibilityCompanyWide).
+		Status(task.StatusTodo).
+		MustBuild()
+
+	done := task.WorkflowStatus{Key: task.StatusDone, Category: task.CategoryDone}
+	completed := base.ApplyUpdate(task.Update{Status: &done}, now)
+	if completed.CompletedAt() == nil || !completed.CompletedAt().Equal(now) {
+		t.Errorf("CompletedAt() = %v, want %v", completed.CompletedAt(), now)
+	}
+
+	archived := true
+	archivedTask := completed.ApplyUpdate(task.Update{Archived: &archived}, now.Add(time.Hour))
+	if !archivedTask.IsArchived() {
+		t.Fatal("expected task to be archived")
+	}
+	if archivedTask.Status() != task.StatusDone {
+		t.Errorf("archiving changed the status to %s", archivedTask.Status())
+	}
+	if fields := task.Diff(completed, archivedTask); len(fields) != 1 || fields[0] != task.FieldArchived {
+		t.Errorf("Diff() = %v, want [%s]", fields, task.FieldArchived)
+	}
+
+	todo := task.WorkflowStatus{Key: task.StatusTodo, Category: task.CategoryTodo}
+	reopened := archivedTask.ApplyUpdate(task.Update{Status: &todo}, now.Add(2*time.Hour))
+	if reopened.CompletedAt() != nil {
+		t.Errorf("CompletedAt() = %v after reopening, want nil", reopened.CompletedAt())
+	}
+	if !reopened.IsArchived() {
+		t.Error("reopening unarchived the task")
+	}
+
+	unarchived := false
+	if reopened.ApplyUpdate(task.Update{Archived: &unarchived}, now).IsArchived() {
+		t.Error("expected task to be unarchived")
+	}
+}
+
+func TestTask_CanUpdateField(t *testing.T) {
+	companyID := id.NewCompanyID()
+	newUser := func(companyID id.CompanyID, role user.Role) *user.User {
+		return user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email("u@test.com").Role(role).MustBuild()
+	}
+	editor := newUser(companyID, user.RoleEditor)
+	assignee := newUser(companyID, user.RoleViewer)
+	viewer := newUser(companyID, user.RoleViewer)
+	outsider := newUser(id.NewCompanyID(), user.RoleEditor)
+	sharedEditor := newUser(companyID, user.RoleViewer)
+	sharedViewer := newUser(companyID, user.RoleViewer)
+
+	assigneeID := assignee.ID()
+	tk := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(companyID).
+		CreatorID(editor.ID()).
+		AssigneeID(&assigneeID).
+		Title("Task").
+		Visibility(task.VisibilityCompanyWide).
+		Shares([]task.Share{
+			{UserID: sharedEditor.ID(), Role: task.ShareRoleEditor},
+			{UserID: sharedViewer.ID(), Role: task.ShareRoleViewer},
+		}).
+		MustBuild()
+
+	tests := []struct {
+		name  string
+		actor *user.User
+		field task.Field
+		want  bool
+	}{
+		{name: "editor changes title", actor: editor, field: task.FieldTitle, want: true},
+		{name: "editor changes status", actor: editor, field: task.FieldStatus, want: true},
+		{name: "assigned viewer changes status", actor: assignee, field: task.FieldStatus, want: true},
+		{name: "assigned viewer changes title", actor: assignee, field: task.FieldTitle, want: false},
+		{name: "assigned viewer changes assignees", actor: assignee, field: task.FieldAssignees, want: false},
+		{name: "unassigned viewer changes status", actor: viewer, field: task.FieldStatus, want: false},
+		{name: "editor from another company", actor: outsider, field: task.FieldStatus, want: false},
+		{name: "shared editor changes title", actor: sharedEditor, field: task.FieldTitle, want: true},
+		{name: "shared editor changes assignees", actor: sharedEditor, field: task.FieldAssignees, want: false},
+		{name: "shared editor changes visibility", actor: sharedEditor, field: task.FieldVisibility, want: false},
+		{name: "shared editor changes team", actor: sharedEditor, field: task.FieldTeam, want: false},
+		{name: "shared viewer changes status", actor: sharedViewer, field: task.FieldStatus, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tk.CanUpdateField(tt.actor, tt.field); got != tt.want {
+				t.Errorf("CanUpdateField() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTask_HandOver(t *testing.T) {
+	companyID := id.NewCompanyID()
+	departing := id.NewUserID()
+	successor := id.NewUserID()
+	other := id.NewUserID()
+	newAssignee := id.NewUserID()
+
+	newTask := func(creatorID id.UserID, visibility task.Visibility, assigneeIDs ...id.UserID) *task.Task {
+		return task.NewBuilder().
+			ID(id.NewTaskID()).
+			CompanyID(companyID).
+			CreatorID(creatorID).
+			AssigneeIDs(assigneeIDs).
+			Title("Task").
+			Visibility(visibility).
+			MustBuild()
+	}
+
+	tests := []struct {
+		name           string
+		task           *task.Task
+		assigneeID     *id.UserID
+		onlyMe         task.OnlyMeHandover
+		wantCreator    id.UserID
+		wantVisibility task.Visibility
+		wantAssignees  []id.UserID
+		wantChanges    int
+	}{
+		{
+			name:           "created task moves to the successor",
+			task:           newTask(departing, task.VisibilityCompanyWide),
+			onlyMe:         task.OnlyMeKeepPrivate,
+			wantCreator:    successor,
+			wantVisibility: task.VisibilityCompanyWide,
+			wantChanges:    1,
+		},
+		{
+			name:           "only_me task stays private",
+			task:           newTask(departing, task.VisibilityOnlyMe),
+			onlyMe:         task.OnlyMeKeepPrivate,
+			wantCreator:    successor,
+			wantVisibility: task.VisibilityOnlyMe,
+			wantChanges:    1,
+		},
+		{
+			name:           "only_me task is shared",
+			task:           newTask(departing, task.VisibilityOnlyMe),
+			onlyMe:         task.OnlyMeShare,
+			wantCreator:    successor,
+			wantVisibility: task.VisibilityCompanyWide,
+			wantChanges:    2,
+		},
+		{
+			name:           "others' only_me tasks are not shared",
+			task:           newTask(other, task.VisibilityOnlyMe, departing),
+			onlyMe:         task.OnlyMeShare,
+			wantCreator:    other,
+			wantVisibility: task.VisibilityOnlyMe,
+			wantAssignees:  []id.UserID{},
+			wantChanges:    1,
+		},
+		{
+			name:           "assignment is reassigned",
+			task:           newTask(other, task.VisibilityCompanyWide, departing, other),
+			assigneeID:     &newAssignee,
+			onlyMe:         task.OnlyMeKeepPrivate,
+			wantCreator:    other,
+			wantVisibility: task.VisibilityCompanyWide,
+			wantAssignees:  []id.UserID{other, newAssignee},
+			wantChanges:    1,
+		},
+		{
+			name:           "unrelated task is unchanged",
+			task:           newTask(other, task.VisibilityCompanyWide, other),
+			onlyMe:         task.OnlyMeShare,
+			wantCreator:    other,
+			wantVisibility: task.VisibilityCompanyWide,
+			wantAssignees:  []id.UserID{other},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.task.HandOver(task.Handover{
+				CompanyID:  companyID,
+				FromID:     departing,
+				CreatorID:  successor,
+				AssigneeID: tt.assigneeID,
+				OnlyMe:     tt.onlyMe,
+			}, time.Now())
+
+			if !got.CreatorID().Equal(tt.wantCreator) {
+				t.Errorf("CreatorID() = %v, want %v", got.CreatorID(), tt.wantCreator)
+			}
+			if got.Visibility() != tt.wantVisibility {
+				t.Errorf("Visibility() = %v, want %v", got.Visibility(), tt.wantVisibility)
+			}
+			if tt.wantAssignees != nil && len(got.AssigneeIDs()) != len(tt.wantAssignees) {
+				t.Fatalf("AssigneeIDs() = %v, want %v", got.AssigneeIDs(), tt.wantAssignees)
+			}
+			for i, want := range tt.wantAssignees {
+				if !got.AssigneeIDs()[i].Equal(want) {
+					t.Errorf("AssigneeIDs()[%d] = %v, want %v", i, got.AssigneeIDs()[i], want)
+				}
+			}
+			if len(got.Changes()) != tt.wantChanges {
+				t.Errorf("Changes() = %v, want %d changes", got.Changes(), tt.wantChanges)
+			}
+		})
+	}
+}
+
+func TestSeries_AdvanceAssignees(t *testing.T) {
+	companyID := id.NewCompanyID()
+	creatorID := id.NewUserID()
+	primary := id.NewUserID()
+	helper := id.NewUserID()
+	labelID := id.NewLabelID()
+	now := time.Now()
+
+	rule, err := recurrence.Parse("FREQ=WEEKLY")
+	if err != nil {
+		t.Fatalf("failed to parse rule: %v", err)
+	}
+	seriesID := id.NewSeriesID()
+	s := task.NewSeriesBuilder().
+		ID(seriesID).
+		CompanyID(companyID).
+		CreatorID(creatorID).
+		AssigneeID(&primary).
+		Rule(rule).
+		TimeZone(time.UTC).
+		StartsAt(now).
+		LastOccurrenceAt(now).
+		Occurrences(1).
+		Title("Weekly report").
+		Visibility(task.VisibilityCompanyWide).
+		Version(1).
+		MustBuild()
+
+	// A helper was added to the first instance only.
+	prev := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(companyID).
+		CreatorID(creatorID).
+		AssigneeIDs([]id.UserID{primary, helper}).
+		LabelIDs([]id.LabelID{labelID}).
+		Title("Weekly report").
+		Visibility(task.VisibilityCompanyWide).
+		SeriesID(&seriesID).
+		Occurrence(1).
+		MustBuild()
+
+	dueAt, _ := s.NextOccurrence()
+	_, next := s.Advance(prev, id.NewTaskID(), dueAt, task.DefaultWorkflow(companyID).Initial(), now)
+
+	if got := next.AssigneeIDs(); len(got) != 1 || !got[0].Equal(primary) {
+		t.Errorf("AssigneeIDs() = %v, want only the series assignee %s", got, primary)
+	}
+	if got := next.LabelIDs(); len(got) != 1 || got[0] != labelID {
+		t.Errorf("LabelIDs() = %v, want the labels of the previous instance", got)
+	}
+}
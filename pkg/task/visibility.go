@@ -5,9 +5,15 @@ type Visibility string
 const (
 	VisibilityOnlyMe      Visibility = "only_me"
 	VisibilityCompanyWide Visibility = "company_wide"
+	// VisibilityTeam shows the task to the members of its team, besides its
+	// creator and assignees.
+	VisibilityTeam Visibility = "team"
 )
 
-func (v Visibility) IsValid() bool  { return v == VisibilityOnlyMe || v == VisibilityCompanyWide }
+func (v Visibility) IsValid() bool {
+	return v == VisibilityOnlyMe || v == VisibilityCompanyWide || v == VisibilityTeam
+}
+
 func (v Visibility) String() string { return string(v) }
 
 func ParseVisibility(s string) (Visibility, bool) {
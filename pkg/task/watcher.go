@@ -0,0 +1,45 @@
+package task
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+// WatcherRepo stores who follows a task. Watching is only meaningful while
+// the task is visible to the watcher; callers remove watchers that a change
+// hides the task from.
+type WatcherRepo interface {
+	// Add subscribes users to a task. Existing subscriptions are kept.
+	Add(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, userIDs []id.UserID) error
+	// Remove unsubscribes users; users who are not watching are ignored.
+	Remove(ctx context.Context, companyID id.CompanyID, taskID id.TaskID, userIDs []id.UserID) error
+	ListByTask(ctx context.Context, companyID id.CompanyID, taskID id.TaskID) ([]*user.User, error)
+	// ListTeamTasks lists the tasks visible to teamID that userID watches,
+	// trashed and archived ones included.
+	ListTeamTasks(ctx context.Context, companyID id.CompanyID, teamID id.TeamID, userID id.UserID) ([]*Task, error)
+}
+
+// DefaultWatcherIDs returns the users subscribed to a task without asking:
+// its creator and its assignees.
+func (t *Task) DefaultWatcherIDs() []id.UserID {
+	ids := []id.UserID{t.creatorID}
+	for _, a := range t.assigneeIDs {
+		if !a.Equal(t.creatorID) {
+			ids = append(ids, a)
+		}
+	}
+	return ids
+}
+
+// HiddenWatchers returns the watchers who can no longer see t.
+func (t *Task) HiddenWatchers(watchers []*user.User) []id.UserID {
+	var hidden []id.UserID
+	for _, w := range watchers {
+		if !t.CanBeViewedBy(w) {
+			hidden = append(hidden, w.ID())
+		}
+	}
+	return hidden
+}
@@ -0,0 +1,172 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+// MaxWorkflowStatuses caps how many statuses a company can define.
+const MaxWorkflowStatuses = 20
+
+// WorkflowStatus is one status a company's tasks can be in.
+type WorkflowStatus struct {
+	Key      Status
+	Name     string
+	Category Category
+}
+
+// Transition allows tasks to move from one status to another.
+type Transition struct {
+	From Status
+	To   Status
+}
+
+// Workflow is the set of statuses a company uses and the moves allowed
+// between them. New tasks start in the first status of the todo category.
+type Workflow struct {
+	companyID   id.CompanyID
+	statuses    []WorkflowStatus
+	transitions []Transition
+}
+
+// NewWorkflow checks that statuses and transitions form a usable workflow.
+// Statuses are kept in the given order, which is also their sort order.
+func NewWorkflow(companyID id.CompanyID, statuses []WorkflowStatus, transitions []Transition) (*Workflow, error) {
+	if len(statuses) == 0 {
+		return nil, errors.New("at least one status is required")
+	}
+	if len(statuses) > MaxWorkflowStatuses {
+		return nil, fmt.Errorf("at most %d statuses are allowed", MaxWorkflowStatuses)
+	}
+
+	w := &Workflow{companyID: companyID}
+	hasTodo, hasDone := false, false
+	for _, s := range statuses {
+		if !s.Key.IsValid() {
+			return nil, fmt.Errorf("status key %q must be lowercase letters, digits and underscores", s.Key)
+		}
+		if _, ok := w.Status(s.Key); ok {
+			return nil, fmt.Errorf("status %q is given more than once", s.Key)
+		}
+		if s.Name == "" {
+			return nil, fmt.Errorf("status %q needs a name", s.Key)
+		}
+		if !s.Category.IsValid() {
+			return nil, fmt.Errorf("status %q must have category todo, active, or done", s.Key)
+		}
+		hasTodo = hasTodo || s.Category == CategoryTodo
+		hasDone = hasDone || s.Category == CategoryDone
+		w.statuses = append(w.statuses, s)
+	}
+	if !hasTodo {
+		return nil, errors.New("at least one status must have category todo")
+	}
+	if !hasDone {
+		return nil, errors.New("at least one status must have category done")
+	}
+
+	for _, t := range transitions {
+		if _, ok := w.Status(t.From); !ok {
+			return nil, fmt.Errorf("transition from unknown status %q", t.From)
+		}
+		if _, ok := w.Status(t.To); !ok {
+			return nil, fmt.Errorf("transition to unknown status %q", t.To)
+		}
+		if t.From == t.To {
+			return nil, fmt.Errorf("transition from %q to itself", t.From)
+		}
+		if w.CanTransition(t.From, t.To) {
+			return nil, fmt.Errorf("transition from %q to %q is given more than once", t.From, t.To)
+		}
+		w.transitions = append(w.transitions, t)
+	}
+
+	return w, nil
+}
+
+// DefaultWorkflow is todo, in progress and done with every move allowed. It
+// is what companies start with.
+func DefaultWorkflow(companyID id.CompanyID) *Workflow {
+	statuses := []WorkflowStatus{
+		{Key: StatusTodo, Name: "To do", Category: CategoryTodo},
+		{Key: StatusInProgress, Name: "In progress", Category: CategoryActive},
+		{Key: StatusDone, Name: "Done", Category: CategoryDone},
+	}
+	var transitions []Transition
+	for _, from := range statuses {
+		for _, to := range statuses {
+			if from.Key != to.Key {
+				transitions = append(transitions, Transition{From: from.Key, To: to.Key})
+			}
+		}
+	}
+
+	w, err := NewWorkflow(companyID, statuses, transitions)
+	if err != nil {
+		panic(err)
+	}
+	return w
+}
+
+func (w *Workflow) CompanyID() id.CompanyID    { return w.companyID }
+func (w *Workflow) Statuses() []WorkflowStatus { return w.statuses }
+func (w *Workflow) Transitions() []Transition  { return w.transitions }
+
+// Status looks up a status by key.
+func (w *Workflow) Status(key Status) (WorkflowStatus, bool) {
+	for _, s := range w.statuses {
+		if s.Key == key {
+			return s, true
+		}
+	}
+	return WorkflowStatus{}, false
+}
+
+// Initial is the status new tasks start in.
+func (w *Workflow) Initial() WorkflowStatus {
+	for _, s := range w.statuses {
+		if s.Category == CategoryTodo {
+			return s
+		}
+	}
+	return w.statuses[0]
+}
+
+// CanTransition reports whether a task may move from one status to another.
+// Staying in the same status is always allowed.
+func (w *Workflow) CanTransition(from, to Status) bool {
+	if from == to {
+		return true
+	}
+	for _, t := range w.transitions {
+		if t.From == from && t.To == to {
+			return true
+		}
+	}
+	return false
+}
+
+// defaultCategory is the category of s in the default workflow, used when a
+// task is built without an explicit category.
+func defaultCategory(s Status) Category {
+	switch s {
+	case StatusInProgress:
+		return CategoryActive
+	case StatusDone:
+		return CategoryDone
+	default:
+		return CategoryTodo
+	}
+}
+
+// WorkflowRepo stores each company's workflow.
+type WorkflowRepo interface {
+	// FindByCompany returns the company's workflow.
+	FindByCompany(ctx context.Context, companyID id.CompanyID) (*Workflow, error)
+	// Save replaces the company's statuses and transitions. It fails with
+	// a failed precondition when a removed status is still used by tasks.
+	Save(ctx context.Context, w *Workflow) error
+}
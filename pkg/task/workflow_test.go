@@ -0,0 +1,120 @@
+package task_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/task"
+)
+
+func TestNewWorkflow(t *testing.T) {
+	companyID := id.NewCompanyID()
+	todo := task.WorkflowStatus{Key: "todo", Name: "To do", Category: task.CategoryTodo}
+	review := task.WorkflowStatus{Key: "in_review", Name: "In review", Category: task.CategoryActive}
+	done := task.WorkflowStatus{Key: "done", Name: "Done", Category: task.CategoryDone}
+
+	tests := []struct {
+		name        string
+		statuses    []task.WorkflowStatus
+		transitions []task.Transition
+		wantErr     bool
+	}{
+		{"valid", []task.WorkflowStatus{todo, review, done}, []task.Transition{{From: "todo", To: "in_review"}, {From: "in_review", To: "done"}}, false},
+		{"no statuses", nil, nil, true},
+		{"no todo status", []task.WorkflowStatus{review, done}, nil, true},
+		{"no done status", []task.WorkflowStatus{todo, review}, nil, true},
+		{"duplicate key", []task.WorkflowStatus{todo, todo, done}, nil, true},
+		{"malformed key", []task.WorkflowStatus{todo, {Key: "In Review", Name: "In review", Category: task.CategoryActive}, done}, nil, true},
+		{"missing name", []task.WorkflowStatus{todo, {Key: "blocked", Category: task.CategoryActive}, done}, nil, true},
+		{"unknown category", []task.WorkflowStatus{todo, {Key: "blocked", Name: "Blocked", Category: "waiting"}, done}, nil, true},
+		{"unknown transition status", []task.WorkflowStatus{todo, done}, []task.Transition{{From: "todo", To: "blocked"}}, true},
+		{"self transition", []task.WorkflowStatus{todo, done}, []task.Transition{{From: "todo", To: "todo"}}, true},
+		{"duplicate transition", []task.WorkflowStatus{todo, done}, []task.Transition{{From: "todo", To: "done"}, {From: "todo", To: "done"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := task.NewWorkflow(companyID, tt.statuses, tt.transitions)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewWorkflow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWorkflow_Transitions(t *testing.T) {
+	w, err := task.NewWorkflow(id.NewCompanyID(), []task.WorkflowStatus{
+		{Key: "blocked", Name: "Blocked", Category: task.CategoryActive},
+		{Key: "backlog", Name: "Backlog", Category: task.CategoryTodo},
+		{Key: "done", Name: "Done", Category: task.CategoryDone},
+	}, []task.Transition{
+		{From: "backlog", To: "blocked"},
+		{From: "blocked", To: "done"},
+	})
+	if err != nil {
+		t.Fatalf("NewWorkflow() error = %v", err)
+	}
+
+	if got := w.Initial().Key; got != "backlog" {
+		t.Errorf("Initial() = %s, want backlog", got)
+	}
+
+	tests := []struct {
+		from, to task.Status
+		want     bool
+	}{
+		{"backlog", "blocked", true},
+		{"blocked", "done", true},
+		{"backlog", "done", false},
+		{"done", "backlog", false},
+		{"done", "done", true},
+	}
+	for _, tt := range tests {
+		if got := w.CanTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("CanTransition(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestDefaultWorkflow(t *testing.T) {
+	w := task.DefaultWorkflow(id.NewCompanyID())
+
+	for _, s := range []task.Status{task.StatusTodo, task.StatusInProgress, task.StatusDone} {
+		for _, to := range []task.Status{task.StatusTodo, task.StatusInProgress, task.StatusDone} {
+			if !w.CanTransition(s, to) {
+				t.Errorf("CanTransition(%s, %s) = false, want true", s, to)
+			}
+		}
+	}
+
+	if w.Initial().Key != task.StatusTodo {
+		t.Errorf("Initial() = %s, want todo", w.Initial().Key)
+	}
+}
+
+func TestTask_ApplyUpdateStatusCategory(t *testing.T) {
+	now := time.Now()
+	base := task.NewBuilder().
+		ID(id.NewTaskID()).
+		CompanyID(id.NewCompanyID()).
+		CreatorID(id.NewUserID()).
+		Title("Task").
+		Visibility(task.VisibilityCompanyWide).
+		Status("in_review").
+		Category(task.CategoryActive).
+		MustBuild()
+
+	shipped := task.WorkflowStatus{Key: "shipped", Category: task.CategoryDone}
+	completed := base.ApplyUpdate(task.Update{Status: &shipped}, now)
+	if !completed.IsDone() || completed.CompletedAt() == nil {
+		t.Fatalf("IsDone() = %v, CompletedAt() = %v; want a completed task", completed.IsDone(), completed.CompletedAt())
+	}
+
+	// Moving between done statuses keeps the original completion time.
+	verified := task.WorkflowStatus{Key: "verified", Category: task.CategoryDone}
+	moved := completed.ApplyUpdate(task.Update{Status: &verified}, now.Add(time.Hour))
+	if moved.CompletedAt() == nil || !moved.CompletedAt().Equal(now) {
+		t.Errorf("CompletedAt() = %v, want %v", moved.CompletedAt(), now)
+	}
+}
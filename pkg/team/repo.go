@@ -0,0 +1,23 @@
+package team
+
+import (
+	"context"
+
+	"github.com/pyshx/todoapp/pkg/id"
+	"github.com/pyshx/todoapp/pkg/user"
+)
+
+type Repo interface {
+	Create(ctx context.Context, t *Team) error
+	FindByIDForCompany(ctx context.Context, teamID id.TeamID, companyID id.CompanyID) (*Team, error)
+	ListByCompany(ctx context.Context, companyID id.CompanyID) ([]*Team, error)
+	Update(ctx context.Context, t *Team) error
+	// Delete fails while tasks or series are still visible to the team.
+	Delete(ctx context.Context, teamID id.TeamID, companyID id.CompanyID) error
+
+	// AddMember is a no-op when the user already belongs to the team.
+	AddMember(ctx context.Context, companyID id.CompanyID, teamID id.TeamID, userID id.UserID) error
+	RemoveMember(ctx context.Context, companyID id.CompanyID, teamID id.TeamID, userID id.UserID) error
+	// ListMembers returns the team's members ordered by email.
+	ListMembers(ctx context.Context, companyID id.CompanyID, teamID id.TeamID) ([]*user.User, error)
+}
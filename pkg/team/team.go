@@ -0,0 +1,89 @@
+package team
+
+import (
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+const MaxNameLength = 50
+
+// Team is a group of users within a company. Tasks with team visibility
+// are shown to the team's members.
+type Team struct {
+	id        id.TeamID
+	companyID id.CompanyID
+	name      string
+	createdAt time.Time
+}
+
+func (t *Team) ID() id.TeamID           { return t.id }
+func (t *Team) CompanyID() id.CompanyID { return t.companyID }
+func (t *Team) Name() string            { return t.name }
+func (t *Team) CreatedAt() time.Time    { return t.createdAt }
+
+type Builder struct {
+	t   *Team
+	err error
+}
+
+func NewBuilder() *Builder {
+	return &Builder{t: &Team{}}
+}
+
+func (b *Builder) ID(id id.TeamID) *Builder {
+	if b.err == nil {
+		b.t.id = id
+	}
+	return b
+}
+
+func (b *Builder) CompanyID(companyID id.CompanyID) *Builder {
+	if b.err == nil {
+		b.t.companyID = companyID
+	}
+	return b
+}
+
+func (b *Builder) Name(name string) *Builder {
+	if b.err == nil {
+		b.t.name = name
+	}
+	return b
+}
+
+func (b *Builder) CreatedAt(t time.Time) *Builder {
+	if b.err == nil {
+		b.t.createdAt = t
+	}
+	return b
+}
+
+func (b *Builder) Build() (*Team, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.t, nil
+}
+
+func (b *Builder) MustBuild() *Team {
+	t, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+type Update struct {
+	Name *string
+}
+
+func (t *Team) ApplyUpdate(u Update) *Team {
+	newTeam := *t
+
+	if u.Name != nil {
+		newTeam.name = *u.Name
+	}
+
+	return &newTeam
+}
@@ -0,0 +1,155 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"github.com/pyshx/todoapp/pkg/id"
+)
+
+// InviteTTL is how long an invite can be accepted.
+const InviteTTL = 7 * 24 * time.Hour
+
+// Invite asks someone to join a company with a given role. Only a hash of
+// the token is kept; the token itself is handed out once, when the invite
+// is created, and the invite can be accepted once.
+type Invite struct {
+	id         id.InviteID
+	companyID  id.CompanyID
+	email      string
+	role       Role
+	invitedBy  id.UserID
+	tokenHash  string
+	expiresAt  time.Time
+	acceptedAt *time.Time
+	createdAt  time.Time
+}
+
+func (i *Invite) ID() id.InviteID              { return i.id }
+func (i *Invite) CompanyID() id.CompanyID      { return i.companyID }
+func (i *Invite) Email() string                { return i.email }
+func (i *Invite) Role() Role                   { return i.role }
+func (i *Invite) InvitedBy() id.UserID         { return i.invitedBy }
+func (i *Invite) TokenHash() string            { return i.tokenHash }
+func (i *Invite) ExpiresAt() time.Time         { return i.expiresAt }
+func (i *Invite) AcceptedAt() *time.Time       { return i.acceptedAt }
+func (i *Invite) CreatedAt() time.Time         { return i.createdAt }
+func (i *Invite) IsAccepted() bool             { return i.acceptedAt != nil }
+func (i *Invite) IsExpired(now time.Time) bool { return !now.Before(i.expiresAt) }
+
+// NewInviteToken returns a random token to hand to the invitee and the hash
+// to store.
+func NewInviteToken() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(b)
+	return token, HashInviteToken(token), nil
+}
+
+// HashInviteToken returns the hash invites are looked up by.
+func HashInviteToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+type InviteBuilder struct {
+	i   *Invite
+	err error
+}
+
+func NewInviteBuilder() *InviteBuilder {
+	return &InviteBuilder{i: &Invite{}}
+}
+
+func (b *InviteBuilder) ID(id id.InviteID) *InviteBuilder {
+	if b.err == nil {
+		b.i.id = id
+	}
+	return b
+}
+
+func (b *InviteBuilder) CompanyID(companyID id.CompanyID) *InviteBuilder {
+	if b.err == nil {
+		b.i.companyID = companyID
+	}
+	return b
+}
+
+func (b *InviteBuilder) Email(email string) *InviteBuilder {
+	if b.err == nil {
+		b.i.email = email
+	}
+	return b
+}
+
+func (b *InviteBuilder) Role(role Role) *InviteBuilder {
+	if b.err == nil {
+		b.i.role = role
+	}
+	return b
+}
+
+func (b *InviteBuilder) InvitedBy(userID id.UserID) *InviteBuilder {
+	if b.err == nil {
+		b.i.invitedBy = userID
+	}
+	return b
+}
+
+func (b *InviteBuilder) TokenHash(hash string) *InviteBuilder {
+	if b.err == nil {
+		b.i.tokenHash = hash
+	}
+	return b
+}
+
+func (b *InviteBuilder) ExpiresAt(t time.Time) *InviteBuilder {
+	if b.err == nil {
+		b.i.expiresAt = t
+	}
+	return b
+}
+
+func (b *InviteBuilder) AcceptedAt(t *time.Time) *InviteBuilder {
+	if b.err == nil {
+		b.i.acceptedAt = t
+	}
+	return b
+}
+
+func (b *InviteBuilder) CreatedAt(t time.Time) *InviteBuilder {
+	if b.err == nil {
+		b.i.createdAt = t
+	}
+	return b
+}
+
+func (b *InviteBuilder) Build() (*Invite, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	return b.i, nil
+}
+
+func (b *InviteBuilder) MustBuild() *Invite {
+	i, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return i
+}
+
+type InviteRepo interface {
+	Create(ctx context.Context, i *Invite) error
+	// FindByTokenHash returns the invite whatever its state.
+	FindByTokenHash(ctx context.Context, tokenHash string) (*Invite, error)
+	// Accept marks the invite accepted and creates u in one step. It fails
+	// with a failed precondition when the invite was accepted meanwhile.
+	Accept(ctx context.Context, i *Invite, u *User, now time.Time) error
+}
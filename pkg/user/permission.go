@@ -0,0 +1,47 @@
+package user
+
+// Permission is something a role allows a user to do.
+type Permission string
+
+const (
+	// PermissionEditTasks covers creating, changing and deleting tasks and
+	// the labels, dependencies and series around them.
+	PermissionEditTasks Permission = "edit tasks"
+	// PermissionManageCompany covers company settings and the workflow.
+	PermissionManageCompany Permission = "manage the company"
+	// PermissionManageUsers covers inviting users, changing their roles
+	// and deactivating them.
+	PermissionManageUsers Permission = "manage users"
+	// PermissionManageTeams covers creating teams and changing their
+	// members.
+	PermissionManageTeams Permission = "manage teams"
+	// PermissionTransferOwnership covers handing the owner role to
+	// someone else.
+	PermissionTransferOwnership Permission = "transfer ownership"
+)
+
+func (p Permission) String() string { return string(p) }
+
+// Can reports whether the role grants p.
+func (r Role) Can(p Permission) bool {
+	switch p {
+	case PermissionEditTasks:
+		return r.AtLeast(RoleEditor)
+	case PermissionManageCompany, PermissionManageUsers, PermissionManageTeams:
+		return r.AtLeast(RoleAdmin)
+	case PermissionTransferOwnership:
+		return r.AtLeast(RoleOwner)
+	default:
+		return false
+	}
+}
+
+// CanChangeRole reports whether u may give target the role to. Managing
+// users is required, and nobody can act on or grant a role above their
+// own.
+func (u *User) CanChangeRole(target *User, to Role) bool {
+	if !u.companyID.Equal(target.CompanyID()) || !u.Can(PermissionManageUsers) {
+		return false
+	}
+	return u.role.AtLeast(target.Role()) && u.role.AtLeast(to)
+}
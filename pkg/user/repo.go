@@ -8,4 +8,11 @@ import (
 
 type Repo interface {
 	FindByID(ctx context.Context, id id.UserID) (*User, error)
+	// FindByEmailForCompany matches email case-insensitively.
+	FindByEmailForCompany(ctx context.Context, email string, companyID id.CompanyID) (*User, error)
+	// ListByCompany returns the company's users ordered by email. Deactivated
+	// users are left out unless includeDeactivated is set.
+	ListByCompany(ctx context.Context, companyID id.CompanyID, includeDeactivated bool) ([]*User, error)
+	// Update saves the user's role and deactivation.
+	Update(ctx context.Context, u *User) error
 }
@@ -2,15 +2,37 @@ package user
 
 type Role string
 
+// Roles from least to most privileged. Each role can do everything the
+// roles before it can.
 const (
-	RoleEditor Role = "editor"
 	RoleViewer Role = "viewer"
+	RoleEditor Role = "editor"
+	RoleAdmin  Role = "admin"
+	RoleOwner  Role = "owner"
 )
 
-func (r Role) IsValid() bool { return r == RoleEditor || r == RoleViewer }
-func (r Role) CanEdit() bool { return r == RoleEditor }
+func (r Role) IsValid() bool  { return r.rank() > 0 }
+func (r Role) CanEdit() bool  { return r.AtLeast(RoleEditor) }
 func (r Role) String() string { return string(r) }
 
+// AtLeast reports whether r is as privileged as other or more.
+func (r Role) AtLeast(other Role) bool { return r.rank() >= other.rank() }
+
+func (r Role) rank() int {
+	switch r {
+	case RoleViewer:
+		return 1
+	case RoleEditor:
+		return 2
+	case RoleAdmin:
+		return 3
+	case RoleOwner:
+		return 4
+	default:
+		return 0
+	}
+}
+
 func ParseRole(s string) (Role, bool) {
 	r := Role(s)
 	if !r.IsValid() {
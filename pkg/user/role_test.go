@@ -3,6 +3,7 @@ package user_test
 import (
 	"testing"
 
+	"github.com/pyshx/todoapp/pkg/id"
 	"github.com/pyshx/todoapp/pkg/user"
 )
 
@@ -13,7 +14,9 @@ func TestRole_IsValid(t *testing.T) {
 	}{
 		{user.RoleEditor, true},
 		{user.RoleViewer, true},
-		{user.Role("admin"), false},
+		{user.RoleAdmin, true},
+		{user.RoleOwner, true},
+		{user.Role("superuser"), false},
 		{user.Role(""), false},
 	}
 
@@ -33,6 +36,8 @@ func TestRole_CanEdit(t *testing.T) {
 	}{
 		{user.RoleEditor, true},
 		{user.RoleViewer, false},
+		{user.RoleAdmin, true},
+		{user.RoleOwner, true},
 	}
 
 	for _, tt := range tests {
@@ -52,7 +57,9 @@ func TestParseRole(t *testing.T) {
 	}{
 		{"editor", user.RoleEditor, true},
 		{"viewer", user.RoleViewer, true},
-		{"admin", "", false},
+		{"admin", user.RoleAdmin, true},
+		{"owner", user.RoleOwner, true},
+		{"superuser", "", false},
 		{"", "", false},
 	}
 
@@ -68,3 +75,66 @@ func TestParseRole(t *testing.T) {
 		})
 	}
 }
+
+func TestRole_Can(t *testing.T) {
+	tests := []struct {
+		role user.Role
+		want []user.Permission
+	}{
+		{user.RoleViewer, nil},
+		{user.RoleEditor, []user.Permission{user.PermissionEditTasks}},
+		{user.RoleAdmin, []user.Permission{user.PermissionEditTasks, user.PermissionManageCompany, user.PermissionManageUsers, user.PermissionManageTeams}},
+		{user.RoleOwner, []user.Permission{user.PermissionEditTasks, user.PermissionManageCompany, user.PermissionManageUsers, user.PermissionManageTeams, user.PermissionTransferOwnership}},
+	}
+
+	all := []user.Permission{user.PermissionEditTasks, user.PermissionManageCompany, user.PermissionManageUsers, user.PermissionManageTeams, user.PermissionTransferOwnership}
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			for _, p := range all {
+				want := false
+				for _, granted := range tt.want {
+					want = want || granted == p
+				}
+				if got := tt.role.Can(p); got != want {
+					t.Errorf("Can(%s) = %v, want %v", p, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestUser_CanChangeRole(t *testing.T) {
+	companyID := id.NewCompanyID()
+	newUser := func(companyID id.CompanyID, role user.Role) *user.User {
+		return user.NewBuilder().ID(id.NewUserID()).CompanyID(companyID).Email("u@test.com").Role(role).MustBuild()
+	}
+	owner := newUser(companyID, user.RoleOwner)
+	admin := newUser(companyID, user.RoleAdmin)
+	editor := newUser(companyID, user.RoleEditor)
+	viewer := newUser(companyID, user.RoleViewer)
+	foreignViewer := newUser(id.NewCompanyID(), user.RoleViewer)
+
+	tests := []struct {
+		name   string
+		actor  *user.User
+		target *user.User
+		to     user.Role
+		want   bool
+	}{
+		{name: "admin promotes viewer to editor", actor: admin, target: viewer, to: user.RoleEditor, want: true},
+		{name: "admin promotes editor to admin", actor: admin, target: editor, to: user.RoleAdmin, want: true},
+		{name: "admin cannot grant owner", actor: admin, target: editor, to: user.RoleOwner, want: false},
+		{name: "admin cannot demote owner", actor: admin, target: owner, to: user.RoleViewer, want: false},
+		{name: "owner grants owner", actor: owner, target: admin, to: user.RoleOwner, want: true},
+		{name: "editor cannot manage users", actor: editor, target: viewer, to: user.RoleViewer, want: false},
+		{name: "admin in another company", actor: admin, target: foreignViewer, to: user.RoleEditor, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.actor.CanChangeRole(tt.target, tt.to); got != tt.want {
+				t.Errorf("CanChangeRole() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
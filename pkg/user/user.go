@@ -12,6 +12,11 @@ type User struct {
 	email     string
 	role      Role
 	createdAt time.Time
+	// deactivatedAt is set once the user is deactivated; deactivated users
+	// keep their history but can no longer sign in.
+	deactivatedAt *time.Time
+	// teamIDs lists the teams of the user's company they belong to.
+	teamIDs []id.TeamID
 }
 
 func (u *User) ID() id.UserID         { return u.id }
@@ -20,6 +25,20 @@ func (u *User) Email() string         { return u.email }
 func (u *User) Role() Role            { return u.role }
 func (u *User) CreatedAt() time.Time  { return u.createdAt }
 func (u *User) CanEdit() bool         { return u.role.CanEdit() }
+func (u *User) Can(p Permission) bool { return u.role.Can(p) }
+func (u *User) DeactivatedAt() *time.Time { return u.deactivatedAt }
+func (u *User) IsActive() bool            { return u.deactivatedAt == nil }
+func (u *User) TeamIDs() []id.TeamID      { return u.teamIDs }
+
+// IsTeamMember reports whether the user belongs to the team.
+func (u *User) IsTeamMember(teamID id.TeamID) bool {
+	for _, t := range u.teamIDs {
+		if t.Equal(teamID) {
+			return true
+		}
+	}
+	return false
+}
 
 type Builder struct {
 	u   *User
@@ -65,6 +84,20 @@ func (b *Builder) CreatedAt(t time.Time) *Builder {
 	return b
 }
 
+func (b *Builder) DeactivatedAt(t *time.Time) *Builder {
+	if b.err == nil {
+		b.u.deactivatedAt = t
+	}
+	return b
+}
+
+func (b *Builder) TeamIDs(teamIDs []id.TeamID) *Builder {
+	if b.err == nil {
+		b.u.teamIDs = teamIDs
+	}
+	return b
+}
+
 func (b *Builder) Build() (*User, error) {
 	if b.err != nil {
 		return nil, b.err
@@ -79,3 +112,22 @@ func (b *Builder) MustBuild() *User {
 	}
 	return u
 }
+
+type Update struct {
+	Role *Role
+	// Deactivated turns sign-in off; it cannot be undone.
+	Deactivated bool
+}
+
+func (u *User) ApplyUpdate(update Update, now time.Time) *User {
+	newUser := *u
+
+	if update.Role != nil {
+		newUser.role = *update.Role
+	}
+	if update.Deactivated && newUser.deactivatedAt == nil {
+		newUser.deactivatedAt = &now
+	}
+
+	return &newUser
+}